@@ -0,0 +1,30 @@
+package annotations
+
+const (
+	// EndpointPickerMode is an annotation that can be set on an InferencePool to specify how the
+	// endpoint picker (EPP) is used. Supported values are "Normal" (default), where requests are routed
+	// to the endpoint selected by the picker, and "Shadow", where the picker is still consulted for
+	// every request but its selection is ignored and requests are load balanced across all pool endpoints
+	// using round robin. Failures of the picker never fail requests in Shadow mode.
+	EndpointPickerMode = "kgateway.dev/endpoint-picker-mode"
+
+	// EndpointPickerTimeout is an annotation that can be set on an InferencePool to specify the timeout
+	// of the gRPC stream to the endpoint picker, e.g. "500ms". Defaults to 10s.
+	EndpointPickerTimeout = "kgateway.dev/endpoint-picker-timeout"
+
+	// EndpointPickerFailureStatus is an annotation that can be set on an InferencePool whose
+	// endpointPickerRef.failureMode is FailClose to specify the HTTP status returned to clients when the
+	// endpoint picker is unavailable. Supported values are "500" (default) and "503".
+	EndpointPickerFailureStatus = "kgateway.dev/endpoint-picker-failure-status"
+)
+
+// EndpointPickerModeValue is the value for the EndpointPickerMode annotation
+type EndpointPickerModeValue string
+
+const (
+	// EndpointPickerModeNormal routes requests to the endpoint selected by the endpoint picker
+	EndpointPickerModeNormal EndpointPickerModeValue = "Normal"
+
+	// EndpointPickerModeShadow consults the endpoint picker but routes requests using round robin
+	EndpointPickerModeShadow EndpointPickerModeValue = "Shadow"
+)
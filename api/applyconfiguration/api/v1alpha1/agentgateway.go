@@ -9,14 +9,16 @@ import (
 // AgentgatewayApplyConfiguration represents a declarative configuration of the Agentgateway type for use
 // with apply.
 type AgentgatewayApplyConfiguration struct {
-	Enabled             *bool                    `json:"enabled,omitempty"`
-	LogLevel            *string                  `json:"logLevel,omitempty"`
-	Image               *ImageApplyConfiguration `json:"image,omitempty"`
-	SecurityContext     *v1.SecurityContext      `json:"securityContext,omitempty"`
-	Resources           *v1.ResourceRequirements `json:"resources,omitempty"`
-	Env                 []v1.EnvVar              `json:"env,omitempty"`
-	CustomConfigMapName *string                  `json:"customConfigMapName,omitempty"`
-	ExtraVolumeMounts   []v1.VolumeMount         `json:"extraVolumeMounts,omitempty"`
+	Enabled             *bool                                 `json:"enabled,omitempty"`
+	LogLevel            *string                               `json:"logLevel,omitempty"`
+	Image               *ImageApplyConfiguration              `json:"image,omitempty"`
+	SecurityContext     *v1.SecurityContext                   `json:"securityContext,omitempty"`
+	Resources           *v1.ResourceRequirements              `json:"resources,omitempty"`
+	Env                 []v1.EnvVar                           `json:"env,omitempty"`
+	CustomConfigMapName *string                               `json:"customConfigMapName,omitempty"`
+	ExtraVolumeMounts   []v1.VolumeMount                      `json:"extraVolumeMounts,omitempty"`
+	McpSessionAffinity  *McpSessionAffinityApplyConfiguration `json:"mcpSessionAffinity,omitempty"`
+	Config              *AgentgatewayConfigApplyConfiguration `json:"config,omitempty"`
 }
 
 // AgentgatewayApplyConfiguration constructs a declarative configuration of the Agentgateway type for use with
@@ -92,3 +94,19 @@ func (b *AgentgatewayApplyConfiguration) WithExtraVolumeMounts(values ...v1.Volu
 	}
 	return b
 }
+
+// WithMcpSessionAffinity sets the McpSessionAffinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the McpSessionAffinity field is set to the value of the last call.
+func (b *AgentgatewayApplyConfiguration) WithMcpSessionAffinity(value *McpSessionAffinityApplyConfiguration) *AgentgatewayApplyConfiguration {
+	b.McpSessionAffinity = value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *AgentgatewayApplyConfiguration) WithConfig(value *AgentgatewayConfigApplyConfiguration) *AgentgatewayApplyConfiguration {
+	b.Config = value
+	return b
+}
@@ -0,0 +1,81 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AgentgatewayConfigApplyConfiguration represents a declarative configuration of the AgentgatewayConfig type for use
+// with apply.
+type AgentgatewayConfigApplyConfiguration struct {
+	AdminAddress                     *string                                `json:"adminAddress,omitempty"`
+	StatsAddress                     *string                                `json:"statsAddress,omitempty"`
+	WorkerThreads                    *int32                                 `json:"workerThreads,omitempty"`
+	ConnectionTerminationDeadline    *v1.Duration                           `json:"connectionTerminationDeadline,omitempty"`
+	ConnectionMinTerminationDeadline *v1.Duration                           `json:"connectionMinTerminationDeadline,omitempty"`
+	Logging                          *AgentgatewayLoggingApplyConfiguration `json:"logging,omitempty"`
+	Tracing                          *AgentgatewayTracingApplyConfiguration `json:"tracing,omitempty"`
+}
+
+// AgentgatewayConfigApplyConfiguration constructs a declarative configuration of the AgentgatewayConfig type for use with
+// apply.
+func AgentgatewayConfig() *AgentgatewayConfigApplyConfiguration {
+	return &AgentgatewayConfigApplyConfiguration{}
+}
+
+// WithAdminAddress sets the AdminAddress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdminAddress field is set to the value of the last call.
+func (b *AgentgatewayConfigApplyConfiguration) WithAdminAddress(value string) *AgentgatewayConfigApplyConfiguration {
+	b.AdminAddress = &value
+	return b
+}
+
+// WithStatsAddress sets the StatsAddress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StatsAddress field is set to the value of the last call.
+func (b *AgentgatewayConfigApplyConfiguration) WithStatsAddress(value string) *AgentgatewayConfigApplyConfiguration {
+	b.StatsAddress = &value
+	return b
+}
+
+// WithWorkerThreads sets the WorkerThreads field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkerThreads field is set to the value of the last call.
+func (b *AgentgatewayConfigApplyConfiguration) WithWorkerThreads(value int32) *AgentgatewayConfigApplyConfiguration {
+	b.WorkerThreads = &value
+	return b
+}
+
+// WithConnectionTerminationDeadline sets the ConnectionTerminationDeadline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnectionTerminationDeadline field is set to the value of the last call.
+func (b *AgentgatewayConfigApplyConfiguration) WithConnectionTerminationDeadline(value v1.Duration) *AgentgatewayConfigApplyConfiguration {
+	b.ConnectionTerminationDeadline = &value
+	return b
+}
+
+// WithConnectionMinTerminationDeadline sets the ConnectionMinTerminationDeadline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnectionMinTerminationDeadline field is set to the value of the last call.
+func (b *AgentgatewayConfigApplyConfiguration) WithConnectionMinTerminationDeadline(value v1.Duration) *AgentgatewayConfigApplyConfiguration {
+	b.ConnectionMinTerminationDeadline = &value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *AgentgatewayConfigApplyConfiguration) WithLogging(value *AgentgatewayLoggingApplyConfiguration) *AgentgatewayConfigApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithTracing sets the Tracing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tracing field is set to the value of the last call.
+func (b *AgentgatewayConfigApplyConfiguration) WithTracing(value *AgentgatewayTracingApplyConfiguration) *AgentgatewayConfigApplyConfiguration {
+	b.Tracing = value
+	return b
+}
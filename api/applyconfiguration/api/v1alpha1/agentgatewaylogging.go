@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// AgentgatewayLoggingApplyConfiguration represents a declarative configuration of the AgentgatewayLogging type for use
+// with apply.
+type AgentgatewayLoggingApplyConfiguration struct {
+	Format *apiv1alpha1.AgentgatewayLogFormat `json:"format,omitempty"`
+	Filter *string                            `json:"filter,omitempty"`
+}
+
+// AgentgatewayLoggingApplyConfiguration constructs a declarative configuration of the AgentgatewayLogging type for use with
+// apply.
+func AgentgatewayLogging() *AgentgatewayLoggingApplyConfiguration {
+	return &AgentgatewayLoggingApplyConfiguration{}
+}
+
+// WithFormat sets the Format field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Format field is set to the value of the last call.
+func (b *AgentgatewayLoggingApplyConfiguration) WithFormat(value apiv1alpha1.AgentgatewayLogFormat) *AgentgatewayLoggingApplyConfiguration {
+	b.Format = &value
+	return b
+}
+
+// WithFilter sets the Filter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Filter field is set to the value of the last call.
+func (b *AgentgatewayLoggingApplyConfiguration) WithFilter(value string) *AgentgatewayLoggingApplyConfiguration {
+	b.Filter = &value
+	return b
+}
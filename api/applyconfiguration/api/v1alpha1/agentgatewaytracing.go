@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AgentgatewayTracingApplyConfiguration represents a declarative configuration of the AgentgatewayTracing type for use
+// with apply.
+type AgentgatewayTracingApplyConfiguration struct {
+	OtlpEndpoint   *string `json:"otlpEndpoint,omitempty"`
+	OtlpProtocol   *string `json:"otlpProtocol,omitempty"`
+	RandomSampling *int32  `json:"randomSampling,omitempty"`
+}
+
+// AgentgatewayTracingApplyConfiguration constructs a declarative configuration of the AgentgatewayTracing type for use with
+// apply.
+func AgentgatewayTracing() *AgentgatewayTracingApplyConfiguration {
+	return &AgentgatewayTracingApplyConfiguration{}
+}
+
+// WithOtlpEndpoint sets the OtlpEndpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OtlpEndpoint field is set to the value of the last call.
+func (b *AgentgatewayTracingApplyConfiguration) WithOtlpEndpoint(value string) *AgentgatewayTracingApplyConfiguration {
+	b.OtlpEndpoint = &value
+	return b
+}
+
+// WithOtlpProtocol sets the OtlpProtocol field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OtlpProtocol field is set to the value of the last call.
+func (b *AgentgatewayTracingApplyConfiguration) WithOtlpProtocol(value string) *AgentgatewayTracingApplyConfiguration {
+	b.OtlpProtocol = &value
+	return b
+}
+
+// WithRandomSampling sets the RandomSampling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RandomSampling field is set to the value of the last call.
+func (b *AgentgatewayTracingApplyConfiguration) WithRandomSampling(value int32) *AgentgatewayTracingApplyConfiguration {
+	b.RandomSampling = &value
+	return b
+}
@@ -7,6 +7,7 @@ package v1alpha1
 type AIBackendApplyConfiguration struct {
 	LLM            *LLMProviderApplyConfiguration    `json:"llm,omitempty"`
 	PriorityGroups []PriorityGroupApplyConfiguration `json:"priorityGroups,omitempty"`
+	Fallback       *AIFallbackApplyConfiguration     `json:"fallback,omitempty"`
 }
 
 // AIBackendApplyConfiguration constructs a declarative configuration of the AIBackend type for use with
@@ -35,3 +36,11 @@ func (b *AIBackendApplyConfiguration) WithPriorityGroups(values ...*PriorityGrou
 	}
 	return b
 }
+
+// WithFallback sets the Fallback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Fallback field is set to the value of the last call.
+func (b *AIBackendApplyConfiguration) WithFallback(value *AIFallbackApplyConfiguration) *AIBackendApplyConfiguration {
+	b.Fallback = value
+	return b
+}
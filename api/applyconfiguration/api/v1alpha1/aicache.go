@@ -0,0 +1,47 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// AICacheApplyConfiguration represents a declarative configuration of the AICache type for use
+// with apply.
+type AICacheApplyConfiguration struct {
+	TTL      *v1.Duration                       `json:"ttl,omitempty"`
+	Semantic *AISemanticCacheApplyConfiguration `json:"semantic,omitempty"`
+	Disable  *apiv1alpha1.PolicyDisable         `json:"disable,omitempty"`
+}
+
+// AICacheApplyConfiguration constructs a declarative configuration of the AICache type for use with
+// apply.
+func AICache() *AICacheApplyConfiguration {
+	return &AICacheApplyConfiguration{}
+}
+
+// WithTTL sets the TTL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTL field is set to the value of the last call.
+func (b *AICacheApplyConfiguration) WithTTL(value v1.Duration) *AICacheApplyConfiguration {
+	b.TTL = &value
+	return b
+}
+
+// WithSemantic sets the Semantic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Semantic field is set to the value of the last call.
+func (b *AICacheApplyConfiguration) WithSemantic(value *AISemanticCacheApplyConfiguration) *AICacheApplyConfiguration {
+	b.Semantic = value
+	return b
+}
+
+// WithDisable sets the Disable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disable field is set to the value of the last call.
+func (b *AICacheApplyConfiguration) WithDisable(value apiv1alpha1.PolicyDisable) *AICacheApplyConfiguration {
+	b.Disable = &value
+	return b
+}
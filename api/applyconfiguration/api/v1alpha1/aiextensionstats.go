@@ -5,7 +5,9 @@ package v1alpha1
 // AiExtensionStatsApplyConfiguration represents a declarative configuration of the AiExtensionStats type for use
 // with apply.
 type AiExtensionStatsApplyConfiguration struct {
-	CustomLabels []CustomLabelApplyConfiguration `json:"customLabels,omitempty"`
+	CustomLabels []CustomLabelApplyConfiguration  `json:"customLabels,omitempty"`
+	Pricing      []ModelPricingApplyConfiguration `json:"pricing,omitempty"`
+	Tenant       *AiTenantApplyConfiguration      `json:"tenant,omitempty"`
 }
 
 // AiExtensionStatsApplyConfiguration constructs a declarative configuration of the AiExtensionStats type for use with
@@ -26,3 +28,24 @@ func (b *AiExtensionStatsApplyConfiguration) WithCustomLabels(values ...*CustomL
 	}
 	return b
 }
+
+// WithPricing adds the given value to the Pricing field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Pricing field.
+func (b *AiExtensionStatsApplyConfiguration) WithPricing(values ...*ModelPricingApplyConfiguration) *AiExtensionStatsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPricing")
+		}
+		b.Pricing = append(b.Pricing, *values[i])
+	}
+	return b
+}
+
+// WithTenant sets the Tenant field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tenant field is set to the value of the last call.
+func (b *AiExtensionStatsApplyConfiguration) WithTenant(value *AiTenantApplyConfiguration) *AiExtensionStatsApplyConfiguration {
+	b.Tenant = value
+	return b
+}
@@ -13,6 +13,7 @@ type AIPolicyApplyConfiguration struct {
 	PromptGuard      *AIPromptGuardApplyConfiguration      `json:"promptGuard,omitempty"`
 	Defaults         []FieldDefaultApplyConfiguration      `json:"defaults,omitempty"`
 	RouteType        *apiv1alpha1.RouteType                `json:"routeType,omitempty"`
+	Cache            *AICacheApplyConfiguration            `json:"cache,omitempty"`
 }
 
 // AIPolicyApplyConfiguration constructs a declarative configuration of the AIPolicy type for use with
@@ -57,3 +58,11 @@ func (b *AIPolicyApplyConfiguration) WithRouteType(value apiv1alpha1.RouteType)
 	b.RouteType = &value
 	return b
 }
+
+// WithCache sets the Cache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cache field is set to the value of the last call.
+func (b *AIPolicyApplyConfiguration) WithCache(value *AICacheApplyConfiguration) *AIPolicyApplyConfiguration {
+	b.Cache = value
+	return b
+}
@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// AISemanticCacheApplyConfiguration represents a declarative configuration of the AISemanticCache type for use
+// with apply.
+type AISemanticCacheApplyConfiguration struct {
+	Embeddings          *v1.LocalObjectReference         `json:"embeddings,omitempty"`
+	VectorStore         *AIVectorStoreApplyConfiguration `json:"vectorStore,omitempty"`
+	SimilarityThreshold *int32                           `json:"similarityThreshold,omitempty"`
+}
+
+// AISemanticCacheApplyConfiguration constructs a declarative configuration of the AISemanticCache type for use with
+// apply.
+func AISemanticCache() *AISemanticCacheApplyConfiguration {
+	return &AISemanticCacheApplyConfiguration{}
+}
+
+// WithEmbeddings sets the Embeddings field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Embeddings field is set to the value of the last call.
+func (b *AISemanticCacheApplyConfiguration) WithEmbeddings(value v1.LocalObjectReference) *AISemanticCacheApplyConfiguration {
+	b.Embeddings = &value
+	return b
+}
+
+// WithVectorStore sets the VectorStore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VectorStore field is set to the value of the last call.
+func (b *AISemanticCacheApplyConfiguration) WithVectorStore(value *AIVectorStoreApplyConfiguration) *AISemanticCacheApplyConfiguration {
+	b.VectorStore = value
+	return b
+}
+
+// WithSimilarityThreshold sets the SimilarityThreshold field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SimilarityThreshold field is set to the value of the last call.
+func (b *AISemanticCacheApplyConfiguration) WithSimilarityThreshold(value int32) *AISemanticCacheApplyConfiguration {
+	b.SimilarityThreshold = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AiTenantMetadataApplyConfiguration represents a declarative configuration of the AiTenantMetadata type for use
+// with apply.
+type AiTenantMetadataApplyConfiguration struct {
+	Namespace *string `json:"namespace,omitempty"`
+	Key       *string `json:"key,omitempty"`
+}
+
+// AiTenantMetadataApplyConfiguration constructs a declarative configuration of the AiTenantMetadata type for use with
+// apply.
+func AiTenantMetadata() *AiTenantMetadataApplyConfiguration {
+	return &AiTenantMetadataApplyConfiguration{}
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *AiTenantMetadataApplyConfiguration) WithNamespace(value string) *AiTenantMetadataApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *AiTenantMetadataApplyConfiguration) WithKey(value string) *AiTenantMetadataApplyConfiguration {
+	b.Key = &value
+	return b
+}
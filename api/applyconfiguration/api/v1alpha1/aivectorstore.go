@@ -0,0 +1,23 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AIVectorStoreApplyConfiguration represents a declarative configuration of the AIVectorStore type for use
+// with apply.
+type AIVectorStoreApplyConfiguration struct {
+	Redis *HostApplyConfiguration `json:"redis,omitempty"`
+}
+
+// AIVectorStoreApplyConfiguration constructs a declarative configuration of the AIVectorStore type for use with
+// apply.
+func AIVectorStore() *AIVectorStoreApplyConfiguration {
+	return &AIVectorStoreApplyConfiguration{}
+}
+
+// WithRedis sets the Redis field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Redis field is set to the value of the last call.
+func (b *AIVectorStoreApplyConfiguration) WithRedis(value *HostApplyConfiguration) *AIVectorStoreApplyConfiguration {
+	b.Redis = value
+	return b
+}
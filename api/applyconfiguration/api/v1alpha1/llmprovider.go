@@ -19,6 +19,7 @@ type LLMProviderApplyConfiguration struct {
 	Port        *v1.PortNumber                       `json:"port,omitempty"`
 	Path        *PathOverrideApplyConfiguration      `json:"path,omitempty"`
 	AuthHeader  *AuthHeaderApplyConfiguration        `json:"authHeader,omitempty"`
+	Models      []ModelAliasApplyConfiguration       `json:"models,omitempty"`
 }
 
 // LLMProviderApplyConfiguration constructs a declarative configuration of the LLMProvider type for use with
@@ -106,3 +107,16 @@ func (b *LLMProviderApplyConfiguration) WithAuthHeader(value *AuthHeaderApplyCon
 	b.AuthHeader = value
 	return b
 }
+
+// WithModels adds the given value to the Models field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Models field.
+func (b *LLMProviderApplyConfiguration) WithModels(values ...*ModelAliasApplyConfiguration) *LLMProviderApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithModels")
+		}
+		b.Models = append(b.Models, *values[i])
+	}
+	return b
+}
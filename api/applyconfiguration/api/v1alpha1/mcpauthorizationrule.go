@@ -0,0 +1,49 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// MCPAuthorizationRuleApplyConfiguration represents a declarative configuration of the MCPAuthorizationRule type for use
+// with apply.
+type MCPAuthorizationRuleApplyConfiguration struct {
+	Kind             *apiv1alpha1.MCPItemKind `json:"kind,omitempty"`
+	Names            []string                 `json:"names,omitempty"`
+	MatchExpressions []string                 `json:"matchExpressions,omitempty"`
+}
+
+// MCPAuthorizationRuleApplyConfiguration constructs a declarative configuration of the MCPAuthorizationRule type for use with
+// apply.
+func MCPAuthorizationRule() *MCPAuthorizationRuleApplyConfiguration {
+	return &MCPAuthorizationRuleApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *MCPAuthorizationRuleApplyConfiguration) WithKind(value apiv1alpha1.MCPItemKind) *MCPAuthorizationRuleApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithNames adds the given value to the Names field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Names field.
+func (b *MCPAuthorizationRuleApplyConfiguration) WithNames(values ...string) *MCPAuthorizationRuleApplyConfiguration {
+	for i := range values {
+		b.Names = append(b.Names, values[i])
+	}
+	return b
+}
+
+// WithMatchExpressions adds the given value to the MatchExpressions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MatchExpressions field.
+func (b *MCPAuthorizationRuleApplyConfiguration) WithMatchExpressions(values ...string) *MCPAuthorizationRuleApplyConfiguration {
+	for i := range values {
+		b.MatchExpressions = append(b.MatchExpressions, values[i])
+	}
+	return b
+}
@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// McpFilterApplyConfiguration represents a declarative configuration of the McpFilter type for use
+// with apply.
+type McpFilterApplyConfiguration struct {
+	Tools     *McpNameFilterApplyConfiguration `json:"tools,omitempty"`
+	Prompts   *McpNameFilterApplyConfiguration `json:"prompts,omitempty"`
+	Resources *McpNameFilterApplyConfiguration `json:"resources,omitempty"`
+}
+
+// McpFilterApplyConfiguration constructs a declarative configuration of the McpFilter type for use with
+// apply.
+func McpFilter() *McpFilterApplyConfiguration {
+	return &McpFilterApplyConfiguration{}
+}
+
+// WithTools sets the Tools field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tools field is set to the value of the last call.
+func (b *McpFilterApplyConfiguration) WithTools(value *McpNameFilterApplyConfiguration) *McpFilterApplyConfiguration {
+	b.Tools = value
+	return b
+}
+
+// WithPrompts sets the Prompts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prompts field is set to the value of the last call.
+func (b *McpFilterApplyConfiguration) WithPrompts(value *McpNameFilterApplyConfiguration) *McpFilterApplyConfiguration {
+	b.Prompts = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *McpFilterApplyConfiguration) WithResources(value *McpNameFilterApplyConfiguration) *McpFilterApplyConfiguration {
+	b.Resources = value
+	return b
+}
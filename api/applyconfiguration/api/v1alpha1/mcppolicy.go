@@ -0,0 +1,37 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MCPPolicyApplyConfiguration represents a declarative configuration of the MCPPolicy type for use
+// with apply.
+type MCPPolicyApplyConfiguration struct {
+	Authorization []MCPAuthorizationRuleApplyConfiguration `json:"authorization,omitempty"`
+	UpstreamAuth  *MCPUpstreamAuthApplyConfiguration       `json:"upstreamAuth,omitempty"`
+}
+
+// MCPPolicyApplyConfiguration constructs a declarative configuration of the MCPPolicy type for use with
+// apply.
+func MCPPolicy() *MCPPolicyApplyConfiguration {
+	return &MCPPolicyApplyConfiguration{}
+}
+
+// WithAuthorization adds the given value to the Authorization field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Authorization field.
+func (b *MCPPolicyApplyConfiguration) WithAuthorization(values ...*MCPAuthorizationRuleApplyConfiguration) *MCPPolicyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAuthorization")
+		}
+		b.Authorization = append(b.Authorization, *values[i])
+	}
+	return b
+}
+
+// WithUpstreamAuth sets the UpstreamAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpstreamAuth field is set to the value of the last call.
+func (b *MCPPolicyApplyConfiguration) WithUpstreamAuth(value *MCPUpstreamAuthApplyConfiguration) *MCPPolicyApplyConfiguration {
+	b.UpstreamAuth = value
+	return b
+}
@@ -0,0 +1,47 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// McpSessionAffinityApplyConfiguration represents a declarative configuration of the McpSessionAffinity type for use
+// with apply.
+type McpSessionAffinityApplyConfiguration struct {
+	Mode                   *apiv1alpha1.McpSessionAffinityMode `json:"mode,omitempty"`
+	SessionKeySecretRef    *v1.LocalObjectReference            `json:"sessionKeySecretRef,omitempty"`
+	ClientIPTimeoutSeconds *int32                              `json:"clientIPTimeoutSeconds,omitempty"`
+}
+
+// McpSessionAffinityApplyConfiguration constructs a declarative configuration of the McpSessionAffinity type for use with
+// apply.
+func McpSessionAffinity() *McpSessionAffinityApplyConfiguration {
+	return &McpSessionAffinityApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *McpSessionAffinityApplyConfiguration) WithMode(value apiv1alpha1.McpSessionAffinityMode) *McpSessionAffinityApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithSessionKeySecretRef sets the SessionKeySecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SessionKeySecretRef field is set to the value of the last call.
+func (b *McpSessionAffinityApplyConfiguration) WithSessionKeySecretRef(value v1.LocalObjectReference) *McpSessionAffinityApplyConfiguration {
+	b.SessionKeySecretRef = &value
+	return b
+}
+
+// WithClientIPTimeoutSeconds sets the ClientIPTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientIPTimeoutSeconds field is set to the value of the last call.
+func (b *McpSessionAffinityApplyConfiguration) WithClientIPTimeoutSeconds(value int32) *McpSessionAffinityApplyConfiguration {
+	b.ClientIPTimeoutSeconds = &value
+	return b
+}
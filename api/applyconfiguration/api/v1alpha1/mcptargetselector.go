@@ -12,6 +12,8 @@ type McpTargetSelectorApplyConfiguration struct {
 	Name     *v1.SectionName                `json:"name,omitempty"`
 	Selector *McpSelectorApplyConfiguration `json:"selector,omitempty"`
 	Static   *McpTargetApplyConfiguration   `json:"static,omitempty"`
+	Prefix   *string                        `json:"prefix,omitempty"`
+	Filter   *McpFilterApplyConfiguration   `json:"filter,omitempty"`
 }
 
 // McpTargetSelectorApplyConfiguration constructs a declarative configuration of the McpTargetSelector type for use with
@@ -43,3 +45,19 @@ func (b *McpTargetSelectorApplyConfiguration) WithStatic(value *McpTargetApplyCo
 	b.Static = value
 	return b
 }
+
+// WithPrefix sets the Prefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prefix field is set to the value of the last call.
+func (b *McpTargetSelectorApplyConfiguration) WithPrefix(value string) *McpTargetSelectorApplyConfiguration {
+	b.Prefix = &value
+	return b
+}
+
+// WithFilter sets the Filter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Filter field is set to the value of the last call.
+func (b *McpTargetSelectorApplyConfiguration) WithFilter(value *McpFilterApplyConfiguration) *McpTargetSelectorApplyConfiguration {
+	b.Filter = value
+	return b
+}
@@ -0,0 +1,27 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// MCPUpstreamAuthApplyConfiguration represents a declarative configuration of the MCPUpstreamAuth type for use
+// with apply.
+type MCPUpstreamAuthApplyConfiguration struct {
+	SecretRef *v1.LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// MCPUpstreamAuthApplyConfiguration constructs a declarative configuration of the MCPUpstreamAuth type for use with
+// apply.
+func MCPUpstreamAuth() *MCPUpstreamAuthApplyConfiguration {
+	return &MCPUpstreamAuthApplyConfiguration{}
+}
+
+// WithSecretRef sets the SecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretRef field is set to the value of the last call.
+func (b *MCPUpstreamAuthApplyConfiguration) WithSecretRef(value v1.LocalObjectReference) *MCPUpstreamAuthApplyConfiguration {
+	b.SecretRef = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ModelAliasApplyConfiguration represents a declarative configuration of the ModelAlias type for use
+// with apply.
+type ModelAliasApplyConfiguration struct {
+	Name   *string `json:"name,omitempty"`
+	Target *string `json:"target,omitempty"`
+}
+
+// ModelAliasApplyConfiguration constructs a declarative configuration of the ModelAlias type for use with
+// apply.
+func ModelAlias() *ModelAliasApplyConfiguration {
+	return &ModelAliasApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ModelAliasApplyConfiguration) WithName(value string) *ModelAliasApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithTarget sets the Target field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Target field is set to the value of the last call.
+func (b *ModelAliasApplyConfiguration) WithTarget(value string) *ModelAliasApplyConfiguration {
+	b.Target = &value
+	return b
+}
@@ -0,0 +1,50 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ModelPricingApplyConfiguration represents a declarative configuration of the ModelPricing type for use
+// with apply.
+type ModelPricingApplyConfiguration struct {
+	Provider        *string `json:"provider,omitempty"`
+	Model           *string `json:"model,omitempty"`
+	InputCostPer1K  *string `json:"inputCostPer1K,omitempty"`
+	OutputCostPer1K *string `json:"outputCostPer1K,omitempty"`
+}
+
+// ModelPricingApplyConfiguration constructs a declarative configuration of the ModelPricing type for use with
+// apply.
+func ModelPricing() *ModelPricingApplyConfiguration {
+	return &ModelPricingApplyConfiguration{}
+}
+
+// WithProvider sets the Provider field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provider field is set to the value of the last call.
+func (b *ModelPricingApplyConfiguration) WithProvider(value string) *ModelPricingApplyConfiguration {
+	b.Provider = &value
+	return b
+}
+
+// WithModel sets the Model field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Model field is set to the value of the last call.
+func (b *ModelPricingApplyConfiguration) WithModel(value string) *ModelPricingApplyConfiguration {
+	b.Model = &value
+	return b
+}
+
+// WithInputCostPer1K sets the InputCostPer1K field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InputCostPer1K field is set to the value of the last call.
+func (b *ModelPricingApplyConfiguration) WithInputCostPer1K(value string) *ModelPricingApplyConfiguration {
+	b.InputCostPer1K = &value
+	return b
+}
+
+// WithOutputCostPer1K sets the OutputCostPer1K field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OutputCostPer1K field is set to the value of the last call.
+func (b *ModelPricingApplyConfiguration) WithOutputCostPer1K(value string) *ModelPricingApplyConfiguration {
+	b.OutputCostPer1K = &value
+	return b
+}
@@ -106,3 +106,16 @@ func (b *NamedLLMProviderApplyConfiguration) WithAuthHeader(value *AuthHeaderApp
 	b.LLMProviderApplyConfiguration.AuthHeader = value
 	return b
 }
+
+// WithModels adds the given value to the Models field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Models field.
+func (b *NamedLLMProviderApplyConfiguration) WithModels(values ...*ModelAliasApplyConfiguration) *NamedLLMProviderApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithModels")
+		}
+		b.LLMProviderApplyConfiguration.Models = append(b.LLMProviderApplyConfiguration.Models, *values[i])
+	}
+	return b
+}
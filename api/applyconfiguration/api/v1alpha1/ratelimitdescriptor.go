@@ -2,10 +2,15 @@
 
 package v1alpha1
 
+import (
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
 // RateLimitDescriptorApplyConfiguration represents a declarative configuration of the RateLimitDescriptor type for use
 // with apply.
 type RateLimitDescriptorApplyConfiguration struct {
 	Entries []RateLimitDescriptorEntryApplyConfiguration `json:"entries,omitempty"`
+	Unit    *apiv1alpha1.RateLimitUnit                   `json:"unit,omitempty"`
 }
 
 // RateLimitDescriptorApplyConfiguration constructs a declarative configuration of the RateLimitDescriptor type for use with
@@ -26,3 +31,11 @@ func (b *RateLimitDescriptorApplyConfiguration) WithEntries(values ...*RateLimit
 	}
 	return b
 }
+
+// WithUnit sets the Unit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Unit field is set to the value of the last call.
+func (b *RateLimitDescriptorApplyConfiguration) WithUnit(value apiv1alpha1.RateLimitUnit) *RateLimitDescriptorApplyConfiguration {
+	b.Unit = &value
+	return b
+}
@@ -3,15 +3,18 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/api/core/v1"
+
 	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 )
 
 // RegexApplyConfiguration represents a declarative configuration of the Regex type for use
 // with apply.
 type RegexApplyConfiguration struct {
-	Matches  []RegexMatchApplyConfiguration `json:"matches,omitempty"`
-	Builtins []apiv1alpha1.BuiltIn          `json:"builtins,omitempty"`
-	Action   *apiv1alpha1.Action            `json:"action,omitempty"`
+	Matches     []RegexMatchApplyConfiguration `json:"matches,omitempty"`
+	Builtins    []apiv1alpha1.BuiltIn          `json:"builtins,omitempty"`
+	PatternSets []v1.LocalObjectReference      `json:"patternSets,omitempty"`
+	Action      *apiv1alpha1.Action            `json:"action,omitempty"`
 }
 
 // RegexApplyConfiguration constructs a declarative configuration of the Regex type for use with
@@ -43,6 +46,16 @@ func (b *RegexApplyConfiguration) WithBuiltins(values ...apiv1alpha1.BuiltIn) *R
 	return b
 }
 
+// WithPatternSets adds the given value to the PatternSets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PatternSets field.
+func (b *RegexApplyConfiguration) WithPatternSets(values ...v1.LocalObjectReference) *RegexApplyConfiguration {
+	for i := range values {
+		b.PatternSets = append(b.PatternSets, values[i])
+	}
+	return b
+}
+
 // WithAction sets the Action field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Action field is set to the value of the last call.
@@ -2,11 +2,17 @@
 
 package v1alpha1
 
+import (
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
 // RegexMatchApplyConfiguration represents a declarative configuration of the RegexMatch type for use
 // with apply.
 type RegexMatchApplyConfiguration struct {
-	Pattern *string `json:"pattern,omitempty"`
-	Name    *string `json:"name,omitempty"`
+	Pattern *string              `json:"pattern,omitempty"`
+	Builtin *apiv1alpha1.BuiltIn `json:"builtin,omitempty"`
+	Name    *string              `json:"name,omitempty"`
+	Action  *apiv1alpha1.Action  `json:"action,omitempty"`
 }
 
 // RegexMatchApplyConfiguration constructs a declarative configuration of the RegexMatch type for use with
@@ -23,6 +29,14 @@ func (b *RegexMatchApplyConfiguration) WithPattern(value string) *RegexMatchAppl
 	return b
 }
 
+// WithBuiltin sets the Builtin field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Builtin field is set to the value of the last call.
+func (b *RegexMatchApplyConfiguration) WithBuiltin(value apiv1alpha1.BuiltIn) *RegexMatchApplyConfiguration {
+	b.Builtin = &value
+	return b
+}
+
 // WithName sets the Name field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Name field is set to the value of the last call.
@@ -30,3 +44,11 @@ func (b *RegexMatchApplyConfiguration) WithName(value string) *RegexMatchApplyCo
 	b.Name = &value
 	return b
 }
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *RegexMatchApplyConfiguration) WithAction(value apiv1alpha1.Action) *RegexMatchApplyConfiguration {
+	b.Action = &value
+	return b
+}
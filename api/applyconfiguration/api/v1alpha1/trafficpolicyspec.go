@@ -20,6 +20,7 @@ type TrafficPolicySpecApplyConfiguration struct {
 	Timeouts        *TimeoutsApplyConfiguration                                   `json:"timeouts,omitempty"`
 	Retry           *RetryApplyConfiguration                                      `json:"retry,omitempty"`
 	RBAC            *RBACApplyConfiguration                                       `json:"rbac,omitempty"`
+	MCP             *MCPPolicyApplyConfiguration                                  `json:"mcp,omitempty"`
 }
 
 // TrafficPolicySpecApplyConfiguration constructs a declarative configuration of the TrafficPolicySpec type for use with
@@ -157,3 +158,11 @@ func (b *TrafficPolicySpecApplyConfiguration) WithRBAC(value *RBACApplyConfigura
 	b.RBAC = value
 	return b
 }
+
+// WithMCP sets the MCP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MCP field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithMCP(value *MCPPolicyApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.MCP = value
+	return b
+}
@@ -26,6 +26,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIBackend
   map:
     fields:
+    - name: fallback
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIFallback
     - name: llm
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LLMProvider
@@ -35,9 +38,36 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PriorityGroup
           elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AICache
+  map:
+    fields:
+    - name: disable
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyDisable
+    - name: semantic
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCache
+    - name: ttl
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIFallback
+  map:
+    fields:
+    - name: attempts
+      type:
+        scalar: numeric
+    - name: statusCodes
+      type:
+        list:
+          elementType:
+            scalar: numeric
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIPolicy
   map:
     fields:
+    - name: cache
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AICache
     - name: defaults
       type:
         list:
@@ -77,6 +107,26 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: response
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PromptguardResponse
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCache
+  map:
+    fields:
+    - name: embeddings
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+      default: {}
+    - name: similarityThreshold
+      type:
+        scalar: numeric
+    - name: vectorStore
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIVectorStore
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIVectorStore
+  map:
+    fields:
+    - name: redis
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Host
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AWSGuardrailConfig
   map:
     fields:
@@ -194,6 +244,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Agentgateway
   map:
     fields:
+    - name: config
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AgentgatewayConfig
     - name: customConfigMapName
       type:
         scalar: string
@@ -218,12 +271,60 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: logLevel
       type:
         scalar: string
+    - name: mcpSessionAffinity
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpSessionAffinity
     - name: resources
       type:
         namedType: io.k8s.api.core.v1.ResourceRequirements
     - name: securityContext
       type:
         namedType: io.k8s.api.core.v1.SecurityContext
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AgentgatewayConfig
+  map:
+    fields:
+    - name: adminAddress
+      type:
+        scalar: string
+    - name: connectionMinTerminationDeadline
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: connectionTerminationDeadline
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: logging
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AgentgatewayLogging
+    - name: statsAddress
+      type:
+        scalar: string
+    - name: tracing
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AgentgatewayTracing
+    - name: workerThreads
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AgentgatewayLogging
+  map:
+    fields:
+    - name: filter
+      type:
+        scalar: string
+    - name: format
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AgentgatewayTracing
+  map:
+    fields:
+    - name: otlpEndpoint
+      type:
+        scalar: string
+    - name: otlpProtocol
+      type:
+        scalar: string
+    - name: randomSampling
+      type:
+        scalar: numeric
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AiExtension
   map:
     fields:
@@ -266,6 +367,15 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CustomLabel
           elementRelationship: atomic
+    - name: pricing
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ModelPricing
+          elementRelationship: atomic
+    - name: tenant
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AiTenant
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AiExtensionTrace
   map:
     fields:
@@ -282,6 +392,35 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: timeout
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AiTenant
+  map:
+    fields:
+    - name: allowedValues
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: header
+      type:
+        scalar: string
+    - name: jwtClaim
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AiTenantMetadata
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AiTenantMetadata
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: namespace
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AlwaysOnConfig
   map:
     elementType:
@@ -1561,6 +1700,14 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: host
       type:
         scalar: string
+    - name: models
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ModelAlias
+          elementRelationship: associative
+          keys:
+          - name
     - name: openai
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OpenAIConfig
@@ -1746,6 +1893,70 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - name
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPAuthorizationRule
+  map:
+    fields:
+    - name: kind
+      type:
+        scalar: string
+    - name: matchExpressions
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: names
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPPolicy
+  map:
+    fields:
+    - name: authorization
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPAuthorizationRule
+          elementRelationship: atomic
+    - name: upstreamAuth
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPUpstreamAuth
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPUpstreamAuth
+  map:
+    fields:
+    - name: secretRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpFilter
+  map:
+    fields:
+    - name: prompts
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpNameFilter
+    - name: resources
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpNameFilter
+    - name: tools
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpNameFilter
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpNameFilter
+  map:
+    fields:
+    - name: allow
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: deny
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpSelector
   map:
     fields:
@@ -1755,6 +1966,18 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: service
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpSessionAffinity
+  map:
+    fields:
+    - name: clientIPTimeoutSeconds
+      type:
+        scalar: numeric
+    - name: mode
+      type:
+        scalar: string
+    - name: sessionKeySecretRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpTarget
   map:
     fields:
@@ -1775,10 +1998,16 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpTargetSelector
   map:
     fields:
+    - name: filter
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpFilter
     - name: name
       type:
         scalar: string
       default: ""
+    - name: prefix
+      type:
+        scalar: string
     - name: selector
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpSelector
@@ -1837,6 +2066,34 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ModelAlias
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: target
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ModelPricing
+  map:
+    fields:
+    - name: inputCostPer1K
+      type:
+        scalar: string
+      default: ""
+    - name: model
+      type:
+        scalar: string
+      default: ""
+    - name: outputCostPer1K
+      type:
+        scalar: string
+      default: ""
+    - name: provider
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Moderation
   map:
     fields:
@@ -1864,6 +2121,14 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: host
       type:
         scalar: string
+    - name: models
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ModelAlias
+          elementRelationship: associative
+          keys:
+          - name
     - name: name
       type:
         scalar: string
@@ -2156,6 +2421,9 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntry
           elementRelationship: atomic
+    - name: unit
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntry
   map:
     fields:
@@ -2230,9 +2498,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RegexMatch
           elementRelationship: atomic
+    - name: patternSets
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.LocalObjectReference
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RegexMatch
   map:
     fields:
+    - name: action
+      type:
+        scalar: string
+    - name: builtin
+      type:
+        scalar: string
     - name: name
       type:
         scalar: string
@@ -2653,6 +2933,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: headerModifiers
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HeaderModifiers
+    - name: mcp
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPPolicy
     - name: rateLimit
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimit
@@ -25,20 +25,38 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.AccessLogGrpcServiceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Agentgateway"):
 		return &apiv1alpha1.AgentgatewayApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AgentgatewayConfig"):
+		return &apiv1alpha1.AgentgatewayConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AgentgatewayLogging"):
+		return &apiv1alpha1.AgentgatewayLoggingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AgentgatewayTracing"):
+		return &apiv1alpha1.AgentgatewayTracingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIBackend"):
 		return &apiv1alpha1.AIBackendApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AICache"):
+		return &apiv1alpha1.AICacheApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AiExtension"):
 		return &apiv1alpha1.AiExtensionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AiExtensionStats"):
 		return &apiv1alpha1.AiExtensionStatsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AiExtensionTrace"):
 		return &apiv1alpha1.AiExtensionTraceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIFallback"):
+		return &apiv1alpha1.AIFallbackApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIPolicy"):
 		return &apiv1alpha1.AIPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIPromptEnrichment"):
 		return &apiv1alpha1.AIPromptEnrichmentApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIPromptGuard"):
 		return &apiv1alpha1.AIPromptGuardApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AISemanticCache"):
+		return &apiv1alpha1.AISemanticCacheApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AiTenant"):
+		return &apiv1alpha1.AiTenantApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AiTenantMetadata"):
+		return &apiv1alpha1.AiTenantMetadataApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIVectorStore"):
+		return &apiv1alpha1.AIVectorStoreApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AnthropicConfig"):
 		return &apiv1alpha1.AnthropicConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AnyValue"):
@@ -213,12 +231,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.LocalRateLimitPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MCP"):
 		return &apiv1alpha1.MCPApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MCPAuthorizationRule"):
+		return &apiv1alpha1.MCPAuthorizationRuleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("McpFilter"):
+		return &apiv1alpha1.McpFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("McpNameFilter"):
+		return &apiv1alpha1.McpNameFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MCPPolicy"):
+		return &apiv1alpha1.MCPPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("McpSelector"):
 		return &apiv1alpha1.McpSelectorApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("McpSessionAffinity"):
+		return &apiv1alpha1.McpSessionAffinityApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("McpTarget"):
 		return &apiv1alpha1.McpTargetApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("McpTargetSelector"):
 		return &apiv1alpha1.McpTargetSelectorApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MCPUpstreamAuth"):
+		return &apiv1alpha1.MCPUpstreamAuthApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Message"):
 		return &apiv1alpha1.MessageApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MetadataKey"):
@@ -229,6 +259,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.MetadataOptionsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MetadataPathSegment"):
 		return &apiv1alpha1.MetadataPathSegmentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ModelAlias"):
+		return &apiv1alpha1.ModelAliasApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ModelPricing"):
+		return &apiv1alpha1.ModelPricingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Moderation"):
 		return &apiv1alpha1.ModerationApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NamedLLMProvider"):
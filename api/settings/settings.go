@@ -74,6 +74,58 @@ func (m *DnsLookupFamily) Decode(value string) error {
 	}
 }
 
+// XdsValidator is an implementation of the validation of the xDS config run in STRICT validation mode.
+type XdsValidator string
+
+const (
+	// XdsValidatorBinary runs the envoy binary of the controller image in validate mode.
+	XdsValidatorBinary XdsValidator = "binary"
+	// XdsValidatorDocker runs envoy in validate mode in a docker container.
+	XdsValidatorDocker XdsValidator = "docker"
+	// XdsValidatorProto validates the config in-process against the validation rules of the
+	// Envoy API, without running envoy. It catches fewer errors than envoy validate mode.
+	XdsValidatorProto XdsValidator = "proto"
+)
+
+// TranslatorMetricsDetail controls the label cardinality of the per-plugin translation metrics.
+type TranslatorMetricsDetail string
+
+const (
+	// TranslatorMetricsDetailNone disables the per-plugin translation metrics and the size metrics of the
+	// generated resources.
+	TranslatorMetricsDetailNone TranslatorMetricsDetail = "none"
+	// TranslatorMetricsDetailPlugin labels the per-plugin translation metrics with the plugin and the
+	// plugin pass only.
+	TranslatorMetricsDetailPlugin TranslatorMetricsDetail = "plugin"
+	// TranslatorMetricsDetailGateway also labels the per-plugin translation metrics with the name and
+	// namespace of the Gateway. This multiplies the number of series by the number of Gateways.
+	TranslatorMetricsDetailGateway TranslatorMetricsDetail = "gateway"
+)
+
+// Decode implements envconfig.Decoder.
+func (d *TranslatorMetricsDetail) Decode(value string) error {
+	detail := TranslatorMetricsDetail(strings.ToLower(strings.TrimSpace(value)))
+	switch detail {
+	case TranslatorMetricsDetailNone, TranslatorMetricsDetailPlugin, TranslatorMetricsDetailGateway:
+		*d = detail
+		return nil
+	default:
+		return fmt.Errorf("invalid translator metrics detail: %q", value)
+	}
+}
+
+// Decode implements envconfig.Decoder.
+func (v *XdsValidator) Decode(value string) error {
+	validator := XdsValidator(strings.ToLower(strings.TrimSpace(value)))
+	switch validator {
+	case XdsValidatorBinary, XdsValidatorDocker, XdsValidatorProto:
+		*v = validator
+		return nil
+	default:
+		return fmt.Errorf("invalid xDS validator: %q", value)
+	}
+}
+
 type Settings struct {
 	// Controls the DnsLookupFamily for all static clusters created via Backend resources.
 	// If not set, kgateway will default to "V4_PREFERRED". Note that this is different
@@ -110,6 +162,11 @@ type Settings struct {
 	// By default, this is enabled.
 	XdsAuth bool `split_words:"true" default:"true"`
 
+	// XdsDelta configures the proxies deployed by kgateway to use incremental (delta) xDS instead of
+	// state-of-the-world xDS, so that only the resources that changed are sent to them on each update.
+	// The control plane serves both, so proxies pick up the change when they are redeployed.
+	XdsDelta bool `split_words:"true" default:"false"`
+
 	// AgentgatewayXdsServicePort is the port of the Kubernetes Service that serves xDS config for agentgateway.
 	// This corresponds to the value of the `grpc-xds-agw` port in the service.
 	AgentgatewayXdsServicePort uint32 `split_words:"true" default:"9978"`
@@ -165,10 +222,28 @@ type Settings struct {
 	// - "STRICT": Builds on STANDARD by running targeted validation
 	ValidationMode ValidationMode `split_words:"true" default:"STANDARD"`
 
+	// XdsValidators are the validators run, in order, on the xDS config in STRICT validation mode.
+	// The config is rejected by the first validator that fails. Defaults to "binary". Supported values are:
+	// - "binary": Runs the envoy binary of the controller image in validate mode
+	// - "docker": Runs envoy in validate mode in a docker container
+	// - "proto": Validates the config in-process, without envoy
+	// For example, "proto,binary" rejects most invalid config without running envoy.
+	XdsValidators []XdsValidator `split_words:"true" default:"binary"`
+
+	// XdsSnapshotHistorySize is the number of xDS snapshots kept per proxy, to show what changed between config
+	// pushes on the admin server. Each snapshot holds the full config of the proxy, so the history is disabled
+	// by default. Set to at least 2 to diff consecutive snapshots.
+	XdsSnapshotHistorySize int `split_words:"true" default:"0"`
+
 	// EnableBuiltinDefaultMetrics enables the default builtin controller-runtime metrics and go runtime metrics.
 	// Since these metrics can be numerous, it is disabled by default.
 	EnableBuiltinDefaultMetrics bool `split_words:"true" default:"false"`
 
+	// TranslatorMetricsDetail controls the per-plugin translation duration histograms and the size gauges
+	// of the generated xDS resources. Supported values are "none", which disables them, "plugin", which
+	// labels the histograms by plugin and plugin pass, and "gateway", which also labels them by Gateway.
+	TranslatorMetricsDetail TranslatorMetricsDetail `split_words:"true" default:"plugin"`
+
 	// GlobalPolicyNamespace is the namespace where policies that can attach to resources
 	// in any namespace are defined.
 	GlobalPolicyNamespace string `split_words:"true"`
@@ -180,6 +255,22 @@ type Settings struct {
 
 	// EnableWaypoint enables kgateway to translate istio waypoints
 	EnableWaypoint bool `split_words:"true" default:"false"`
+
+	// EnableValidationWebhook enables the validating admission webhook that rejects kgateway policies
+	// with an invalid spec. Unresolved references are reported in the policy status instead.
+	EnableValidationWebhook bool `split_words:"true" default:"false"`
+
+	// ValidationWebhookPort is the port the validating admission webhook is served on.
+	// This corresponds to the value of the `webhook` port in the service.
+	ValidationWebhookPort uint32 `split_words:"true" default:"9443"`
+
+	// ValidationWebhookConfigName is the name of the ValidatingWebhookConfiguration whose CA bundle
+	// is kept in sync with the self-managed serving certificate.
+	ValidationWebhookConfigName string `split_words:"true" default:"kgateway"`
+
+	// ValidationWebhookSecretName is the name of the Secret, in the kgateway install namespace,
+	// that stores the self-managed serving certificate of the validating admission webhook.
+	ValidationWebhookSecretName string `split_words:"true" default:"kgateway-webhook-cert"`
 }
 
 // BuildSettings returns a zero-valued Settings obj if error is encountered when parsing env
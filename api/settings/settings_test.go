@@ -40,12 +40,20 @@ func allEnvVarsSet() map[string]string {
 		"KGW_ENABLE_AGENTGATEWAY":            "true",
 		"KGW_WEIGHTED_ROUTE_PRECEDENCE":      "true",
 		"KGW_VALIDATION_MODE":                string(ValidationStrict),
+		"KGW_XDS_VALIDATORS":                 "proto, binary",
+		"KGW_XDS_SNAPSHOT_HISTORY_SIZE":      "5",
 		"KGW_ENABLE_BUILTIN_DEFAULT_METRICS": "true",
+		"KGW_TRANSLATOR_METRICS_DETAIL":      string(TranslatorMetricsDetailGateway),
 		"KGW_GLOBAL_POLICY_NAMESPACE":        "foo",
 		"KGW_DISABLE_LEADER_ELECTION":        "true",
 		"KGW_POLICY_MERGE":                   `{"TrafficPolicy":{"extProc":"DeepMerge"}}`,
 		"KGW_ENABLE_WAYPOINT":                "true",
 		"KGW_XDS_AUTH":                       "false",
+		"KGW_XDS_DELTA":                      "true",
+		"KGW_ENABLE_VALIDATION_WEBHOOK":      "true",
+		"KGW_VALIDATION_WEBHOOK_PORT":        "8443",
+		"KGW_VALIDATION_WEBHOOK_CONFIG_NAME": "custom-webhook",
+		"KGW_VALIDATION_WEBHOOK_SECRET_NAME": "custom-webhook-cert",
 	}
 }
 
@@ -91,12 +99,20 @@ func TestSettings(t *testing.T) {
 				EnableAgentgateway:          false,
 				WeightedRoutePrecedence:     false,
 				ValidationMode:              ValidationStandard,
+				XdsValidators:               []XdsValidator{XdsValidatorBinary},
+				XdsSnapshotHistorySize:      0,
 				EnableBuiltinDefaultMetrics: false,
+				TranslatorMetricsDetail:     TranslatorMetricsDetailPlugin,
 				GlobalPolicyNamespace:       "",
 				DisableLeaderElection:       false,
 				PolicyMerge:                 "{}",
 				EnableWaypoint:              false,
 				XdsAuth:                     true,
+				XdsDelta:                    false,
+				EnableValidationWebhook:     false,
+				ValidationWebhookPort:       9443,
+				ValidationWebhookConfigName: "kgateway",
+				ValidationWebhookSecretName: "kgateway-webhook-cert",
 			},
 		},
 		{
@@ -127,12 +143,20 @@ func TestSettings(t *testing.T) {
 				EnableAgentgateway:          true,
 				WeightedRoutePrecedence:     true,
 				ValidationMode:              ValidationStrict,
+				XdsValidators:               []XdsValidator{XdsValidatorProto, XdsValidatorBinary},
+				XdsSnapshotHistorySize:      5,
 				EnableBuiltinDefaultMetrics: true,
+				TranslatorMetricsDetail:     TranslatorMetricsDetailGateway,
 				GlobalPolicyNamespace:       "foo",
 				DisableLeaderElection:       true,
 				PolicyMerge:                 `{"TrafficPolicy":{"extProc":"DeepMerge"}}`,
 				EnableWaypoint:              true,
 				XdsAuth:                     false,
+				XdsDelta:                    true,
+				EnableValidationWebhook:     true,
+				ValidationWebhookPort:       8443,
+				ValidationWebhookConfigName: "custom-webhook",
+				ValidationWebhookSecretName: "custom-webhook-cert",
 			},
 		},
 		{
@@ -170,6 +194,27 @@ func TestSettings(t *testing.T) {
 			},
 			expectedErrorStr: `invalid validation mode: "invalid"`,
 		},
+		{
+			name: "errors on invalid xds validator",
+			envVars: map[string]string{
+				"KGW_XDS_VALIDATORS": "proto,invalid",
+			},
+			expectedErrorStr: `invalid xDS validator: "invalid"`,
+		},
+		{
+			name: "errors on invalid xds snapshot history size",
+			envVars: map[string]string{
+				"KGW_XDS_SNAPSHOT_HISTORY_SIZE": "many",
+			},
+			expectedErrorStr: `envconfig.Process: assigning KGW_XDS_SNAPSHOT_HISTORY_SIZE to XdsSnapshotHistorySize: converting 'many' to type int. details: strconv.ParseInt: parsing "many": invalid syntax`,
+		},
+		{
+			name: "errors on invalid translator metrics detail",
+			envVars: map[string]string{
+				"KGW_TRANSLATOR_METRICS_DETAIL": "route",
+			},
+			expectedErrorStr: `invalid translator metrics detail: "route"`,
+		},
 		{
 			name: "ignores other env vars",
 			envVars: map[string]string{
@@ -195,8 +240,15 @@ func TestSettings(t *testing.T) {
 				EnableAgentgateway:          false,
 				WeightedRoutePrecedence:     false,
 				ValidationMode:              ValidationStandard,
+				XdsValidators:               []XdsValidator{XdsValidatorBinary},
+				XdsSnapshotHistorySize:      0,
+				TranslatorMetricsDetail:     TranslatorMetricsDetailPlugin,
 				PolicyMerge:                 "{}",
 				XdsAuth:                     true,
+				XdsDelta:                    false,
+				ValidationWebhookPort:       9443,
+				ValidationWebhookConfigName: "kgateway",
+				ValidationWebhookSecretName: "kgateway-webhook-cert",
 			},
 		},
 	}
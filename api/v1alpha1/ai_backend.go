@@ -7,6 +7,7 @@ import (
 
 // AIBackend specifies the AI backend configuration
 // +kubebuilder:validation:ExactlyOneOf=llm;priorityGroups
+// +kubebuilder:validation:XValidation:rule="!has(self.fallback) || has(self.priorityGroups)",message="fallback requires priorityGroups"
 type AIBackend struct {
 	// The LLM configures the AI gateway to use a single LLM provider backend.
 	// +optional
@@ -44,6 +45,37 @@ type AIBackend struct {
 	// +kubebuilder:validation:MaxItems=32
 	// TODO: enable this rule when we don't need to support older k8s versions where this rule breaks // +kubebuilder:validation:XValidation:message="provider names must be unique across groups",rule="self.map(pg, pg.providers.map(pp, pp.name)).map(p, self.map(pg, pg.providers.map(pp, pp.name)).filter(cp, cp != p).exists(cp, p.exists(pn, pn in cp))).exists(p, !p)"
 	PriorityGroups []PriorityGroup `json:"priorityGroups,omitempty"`
+
+	// Fallback retries requests that fail with one of the configured status codes on the
+	// providers of the next priority group.
+	// All the providers of a Backend must be of the same type with the envoy-based AI gateway, which does
+	// not accept a Backend that mixes provider types, as requests are only translated to the schema of one provider.
+	// Note: This field is not supported by agentgateway, which does not accept a Backend that sets it. With
+	// agentgateway, requests fail over to the next priority group based on the health of the providers, and
+	// providers of different types can be mixed across priority groups.
+	// +optional
+	Fallback *AIFallback `json:"fallback,omitempty"`
+}
+
+// AIFallback configures the fallback of failed requests to the next priority group.
+type AIFallback struct {
+	// The HTTP status codes returned by a provider that trigger a fallback.
+	// Defaults to 429, 500, 502, 503 and 504.
+	// +optional
+	// +kubebuilder:default={429,500,502,503,504}
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Minimum=400
+	// +kubebuilder:validation:items:Maximum=599
+	StatusCodes []int32 `json:"statusCodes,omitempty"`
+
+	// The maximum number of fallback attempts for a request. Each attempt is sent to a provider
+	// of a lower priority group than the previous attempts. Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=31
+	Attempts *int32 `json:"attempts,omitempty"`
 }
 
 // LLMProvider specifies the target large language model provider that the backend should route requests to.
@@ -98,6 +130,40 @@ type LLMProvider struct {
 	// For example, OpenAI uses header: "Authorization" and prefix: "Bearer" But Azure OpenAI uses header: "api-key"
 	// and no Bearer.
 	AuthHeader *AuthHeader `json:"authHeader,omitempty"`
+
+	// Models lists the models that clients can request from this provider in the `model` field of
+	// the request body. Each entry can alias the client-facing model name to a different provider model.
+	// When set, requests are dispatched to the providers that serve the requested model, and requests
+	// for a model that no provider serves are rejected with a 404. If models are set on one provider
+	// of a Backend, they must be set on all its providers.
+	// Note: This field is not supported by agentgateway, which does not accept a Backend that sets it.
+	//
+	// Example that serves `gpt-4o` with an Azure OpenAI deployment, and aliases `fast` to `gpt-4o-mini`:
+	// ```yaml
+	// models:
+	//   - name: gpt-4o
+	//     target: gpt-4o-prod
+	//   - name: fast
+	//     target: gpt-4o-mini
+	// ```
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	Models []ModelAlias `json:"models,omitempty"`
+}
+
+// ModelAlias maps a model name requested by clients to the model of an LLM provider.
+type ModelAlias struct {
+	// The model name sent by clients in the `model` field of the request body.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// The model sent to the LLM provider for requests of this model, such as the deployment name
+	// for Azure OpenAI. Defaults to the client-facing name.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Target *string `json:"target,omitempty"`
 }
 
 // NamedLLMProvider wraps an LLMProvider with a name.
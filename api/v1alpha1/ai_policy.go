@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -27,6 +29,90 @@ type AIPolicy struct {
 	// +kubebuilder:validation:Enum=CHAT;CHAT_STREAMING
 	// +kubebuilder:default=CHAT
 	RouteType *RouteType `json:"routeType,omitempty"`
+
+	// Cache responses returned by the LLM provider and serve repeated prompts from the cache.
+	// Note: This is not yet supported for agentgateway.
+	// +optional
+	Cache *AICache `json:"cache,omitempty"`
+}
+
+// AICache configures caching of the responses returned by the LLM provider.
+// By default, responses are cached by exact match on the requested model and the normalized
+// prompt messages. Optionally, semantic caching can be enabled to also serve responses for prompts
+// that are similar to a previously cached prompt.
+//
+// Only non-streaming responses with a 200 status code are cached. Clients can bypass the cache
+// for an individual request by sending the `Cache-Control: no-cache` header.
+// Cache hits are reported in the `ai.kgateway.io:cache_hit` dynamic metadata, which can be used
+// in access logs, and in the AI extension metrics.
+//
+// The following example caches responses for 10 minutes, and looks up semantically similar prompts
+// using the `openai-embeddings` Backend and a Redis vector store.
+// ```yaml
+// cache:
+//
+//	ttl: 10m
+//	semantic:
+//	  embeddings:
+//	    name: openai-embeddings
+//	  vectorStore:
+//	    redis:
+//	      host: redis.kgateway-system.svc.cluster.local
+//	      port: 6379
+//	  similarityThreshold: 90
+//
+// ```
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.disable) && (has(self.ttl) || has(self.semantic)))",message="disable cannot be set together with ttl or semantic"
+type AICache struct {
+	// How long a cached response is served before it expires.
+	// Defaults to `1h`.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="ttl must be at least 1s"
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// Enable semantic caching, which serves the cached response of a similar prompt.
+	// When unset, only prompts that exactly match a cached prompt are served from the cache.
+	// +optional
+	Semantic *AISemanticCache `json:"semantic,omitempty"`
+
+	// Disable caching for the targeted routes, for example to opt a single route out of a cache
+	// configured for all routes of a Gateway.
+	// +optional
+	Disable *PolicyDisable `json:"disable,omitempty"`
+}
+
+// AISemanticCache configures semantic caching, which compares the embeddings of prompts to
+// find cached responses for similar prompts.
+type AISemanticCache struct {
+	// Reference to a Backend of type `AI` in the same namespace that is used to compute the embeddings of prompts.
+	// The Backend must use the `openai` or `azureopenai` LLM provider, and its model must be an embeddings model,
+	// such as `text-embedding-3-small`.
+	// The auth token of the Backend must be `inline` or a `secretRef`.
+	// +required
+	Embeddings corev1.LocalObjectReference `json:"embeddings"`
+
+	// The vector store that holds the embeddings of cached prompts.
+	// +required
+	VectorStore AIVectorStore `json:"vectorStore"`
+
+	// The minimum similarity, as a percentage, between a prompt and a cached prompt for the cached
+	// response to be served. Defaults to 95.
+	// +optional
+	// +kubebuilder:default=95
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	SimilarityThreshold *int32 `json:"similarityThreshold,omitempty"`
+}
+
+// AIVectorStore configures the vector store used for semantic caching.
+// +kubebuilder:validation:ExactlyOneOf=redis
+type AIVectorStore struct {
+	// Use a Redis server with the RediSearch module as vector store.
+	// Note: TLS is not currently supported for the vector store.
+	// +optional
+	Redis *Host `json:"redis,omitempty"`
 }
 
 // AIPromptEnrichment defines the config to enrich requests sent to the LLM provider by appending and prepending system prompts.
@@ -94,7 +180,7 @@ type Message struct {
 // BuiltIn regex patterns for specific types of strings in prompts.
 // For example, if you specify `CREDIT_CARD`, any credit card numbers
 // in the request or response are matched.
-// +kubebuilder:validation:Enum=SSN;CREDIT_CARD;PHONE_NUMBER;EMAIL
+// +kubebuilder:validation:Enum=SSN;CREDIT_CARD;PHONE_NUMBER;EMAIL;IBAN;IP_ADDRESS;US_PASSPORT;DE_PASSPORT;IN_PASSPORT;API_KEY;PRIVATE_KEY;JWT;UK_NINO;CA_SIN;IN_AADHAAR;IN_PAN;SG_NRIC;ES_DNI;IT_FISCAL_CODE;BR_CPF;AU_TFN
 type BuiltIn string
 
 const (
@@ -109,19 +195,88 @@ const (
 
 	// Default regex matching for email addresses.
 	EMAIL BuiltIn = "EMAIL"
+
+	// Regex matching for International Bank Account Numbers.
+	IBAN BuiltIn = "IBAN"
+
+	// Regex matching for IPv4 and IPv6 addresses.
+	IP_ADDRESS BuiltIn = "IP_ADDRESS"
+
+	// Regex matching for United States passport numbers that follow the `passport` keyword,
+	// for example `passport number: A12345678`. The keyword is masked with the number.
+	US_PASSPORT BuiltIn = "US_PASSPORT"
+
+	// Regex matching for German passport numbers.
+	DE_PASSPORT BuiltIn = "DE_PASSPORT"
+
+	// Regex matching for Indian passport numbers.
+	IN_PASSPORT BuiltIn = "IN_PASSPORT"
+
+	// Regex matching for well-known API key formats, such as AWS access keys,
+	// GitHub tokens, Google API keys, OpenAI keys, Slack tokens and Stripe keys.
+	API_KEY BuiltIn = "API_KEY"
+
+	// Regex matching for PEM encoded private keys.
+	PRIVATE_KEY BuiltIn = "PRIVATE_KEY"
+
+	// Regex matching for JSON Web Tokens.
+	JWT BuiltIn = "JWT"
+
+	// Regex matching for United Kingdom National Insurance numbers.
+	UK_NINO BuiltIn = "UK_NINO"
+
+	// Regex matching for Canadian Social Insurance numbers that follow the `SIN` or `social insurance` keyword,
+	// for example `SIN 146-454-286`. The keyword is masked with the number.
+	CA_SIN BuiltIn = "CA_SIN"
+
+	// Regex matching for Indian Aadhaar numbers.
+	IN_AADHAAR BuiltIn = "IN_AADHAAR"
+
+	// Regex matching for Indian Permanent Account Numbers.
+	IN_PAN BuiltIn = "IN_PAN"
+
+	// Regex matching for Singapore National Registration Identity Card numbers.
+	SG_NRIC BuiltIn = "SG_NRIC"
+
+	// Regex matching for Spanish national identity document numbers.
+	ES_DNI BuiltIn = "ES_DNI"
+
+	// Regex matching for Italian fiscal codes.
+	IT_FISCAL_CODE BuiltIn = "IT_FISCAL_CODE"
+
+	// Regex matching for Brazilian individual taxpayer numbers.
+	BR_CPF BuiltIn = "BR_CPF"
+
+	// Regex matching for Australian tax file numbers that follow the `TFN` or `tax file` keyword,
+	// for example `TFN 123 456 782`. The keyword is masked with the number.
+	AU_TFN BuiltIn = "AU_TFN"
 )
 
 // RegexMatch configures the regular expression (regex) matching for prompt guards and data masking.
+// +kubebuilder:validation:XValidation:rule="!(has(self.pattern) && has(self.builtin))",message="only one of pattern or builtin may be set"
 type RegexMatch struct {
 	// The regex pattern to match against the request or response.
 	Pattern *string `json:"pattern,omitempty"`
 
+	// A built-in regex pattern to match against the request or response.
+	// This can be used instead of `builtins` to set an action for a single built-in.
+	// +optional
+	Builtin *BuiltIn `json:"builtin,omitempty"`
+
 	// An optional name for this match, which can be used for debugging purposes.
 	Name *string `json:"name,omitempty"`
+
+	// The action to take if this match is found, which overrides the action of the regex.
+	// Matches in responses are never rejected, the `REJECT` action masks them instead.
+	// Note: agentgateway uses a single action for all the matches of a regex. A match with a different
+	// action is reported as unsupported, and a `REJECT` match makes the regex reject all its matches.
+	// +optional
+	Action *Action `json:"action,omitempty"`
 }
 
 // Action to take if a regex pattern is matched in a request or response.
 // This setting applies only to request matches. PromptguardResponse matches are always masked by default.
+// +kubebuilder:validation:Enum=MASK;REJECT;LOG
 type Action string
 
 const (
@@ -130,6 +285,11 @@ const (
 
 	// Reject the request if the regex matches content in the request.
 	REJECT Action = "REJECT"
+
+	// Only report the match in the logs and metrics of the AI extension,
+	// without modifying the request or response.
+	// Note: This action is not supported when using agentgateway.
+	LOG Action = "LOG"
 )
 
 // Regex configures the regular expression (regex) matching for prompt guards and data masking.
@@ -142,6 +302,25 @@ type Regex struct {
 	// Matches and built-ins are additive.
 	Builtins []BuiltIn `json:"builtins,omitempty"`
 
+	// A list of ConfigMaps in the namespace of the policy holding regex patterns
+	// to match against the request or response, in addition to matches and built-ins.
+	// Each key of the ConfigMap data is the name of a match, and its value is the pattern.
+	// This allows sharing a set of patterns across policies.
+	//
+	// Example:
+	// ```yaml
+	// apiVersion: v1
+	// kind: ConfigMap
+	// metadata:
+	//   name: internal-identifiers
+	// data:
+	//   EMPLOYEE_ID: "EMP-[0-9]{6}"
+	//   PROJECT_CODE: "PRJ-[A-Z]{3}-[0-9]{4}"
+	// ```
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	PatternSets []corev1.LocalObjectReference `json:"patternSets,omitempty"`
+
 	// The action to take if a regex pattern is matched in a request or response.
 	// This setting applies only to request matches. PromptguardResponse matches are always masked by default.
 	// Defaults to `MASK`.
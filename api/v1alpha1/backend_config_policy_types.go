@@ -56,16 +56,19 @@ type BackendConfigPolicySpec struct {
 
 	// Soft limit on the size of the cluster's connections read and write buffers.
 	// If unspecified, an implementation-defined default is applied (1MiB).
+	// Note: This field is not applicable when using agentgateway
 	// +optional
 	// +kubebuilder:validation:Minimum=0
 	PerConnectionBufferLimitBytes *int32 `json:"perConnectionBufferLimitBytes,omitempty"`
 
 	// Configure OS-level TCP keepalive checks.
+	// Note: This field is not applicable when using agentgateway
 	// +optional
 	TCPKeepalive *TCPKeepalive `json:"tcpKeepalive,omitempty"`
 
 	// Additional options when handling HTTP requests upstream, applicable to
 	// both HTTP1 and HTTP2 requests.
+	// Note: This field is not applicable when using agentgateway
 	// +optional
 	CommonHttpProtocolOptions *CommonHttpProtocolOptions `json:"commonHttpProtocolOptions,omitempty"`
 
@@ -89,6 +92,7 @@ type BackendConfigPolicySpec struct {
 	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty"`
 
 	// HealthCheck contains the options necessary to configure the health check.
+	// Note: This field is not applicable when using agentgateway
 	// +optional
 	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
 
@@ -790,6 +790,33 @@ type AiExtensionStats struct {
 	// These will be added on each request which goes through the AI Extension.
 	// +optional
 	CustomLabels []CustomLabel `json:"customLabels,omitempty"`
+
+	// Pricing of the models served through the AI Extension. When the model of a request
+	// matches an entry, its estimated cost is added to the `ai_cost` metric and to the
+	// `input_cost`, `output_cost` and `total_cost` fields of the `ai.kgateway.io` dynamic metadata,
+	// which can be used in access logs.
+	// The model returned by the provider is matched first, then the model of the request.
+	// If several entries match a model, the last one is used.
+	//
+	// Example:
+	// ```yaml
+	// pricing:
+	//   - provider: openai
+	//     model: gpt-4o
+	//     inputCostPer1K: "0.0025"
+	//     outputCostPer1K: "0.01"
+	// ```
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=256
+	Pricing []ModelPricing `json:"pricing,omitempty"`
+
+	// Tenant selects the value of the `tenant` label of the request metrics, which attributes
+	// token usage and cost to a team or customer.
+	// Every distinct tenant value creates new time series for each request metric, so the tenant must
+	// come from a bounded set of values. Values that are not in `allowedValues` are counted as `other`.
+	// +optional
+	Tenant *AiTenant `json:"tenant,omitempty"`
 }
 
 func (in *AiExtensionStats) GetCustomLabels() []CustomLabel {
@@ -799,6 +826,160 @@ func (in *AiExtensionStats) GetCustomLabels() []CustomLabel {
 	return in.CustomLabels
 }
 
+func (in *AiExtensionStats) GetPricing() []ModelPricing {
+	if in == nil {
+		return nil
+	}
+	return in.Pricing
+}
+
+func (in *AiExtensionStats) GetTenant() *AiTenant {
+	if in == nil {
+		return nil
+	}
+	return in.Tenant
+}
+
+// ModelPricing is the price of the tokens of a model, in the currency used for chargeback.
+type ModelPricing struct {
+	// The LLM provider serving the model. If not specified, the price applies to the model
+	// regardless of the provider.
+	// +optional
+	// +kubebuilder:validation:Enum=openai;azure_openai;anthropic;gemini;vertex-ai
+	Provider *string `json:"provider,omitempty"`
+
+	// The name of the model, as sent in the request or returned by the provider.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Model string `json:"model"`
+
+	// The price of 1000 input (prompt) tokens, as a decimal number.
+	//
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	InputCostPer1K string `json:"inputCostPer1K"`
+
+	// The price of 1000 output (completion) tokens, as a decimal number.
+	//
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	OutputCostPer1K string `json:"outputCostPer1K"`
+}
+
+func (in *ModelPricing) GetProvider() *string {
+	if in == nil {
+		return nil
+	}
+	return in.Provider
+}
+
+func (in *ModelPricing) GetModel() string {
+	if in == nil {
+		return ""
+	}
+	return in.Model
+}
+
+func (in *ModelPricing) GetInputCostPer1K() string {
+	if in == nil {
+		return ""
+	}
+	return in.InputCostPer1K
+}
+
+func (in *ModelPricing) GetOutputCostPer1K() string {
+	if in == nil {
+		return ""
+	}
+	return in.OutputCostPer1K
+}
+
+// AiTenant selects the value identifying the tenant of a request.
+// Requests without a value are attributed to the `unknown` tenant.
+// +kubebuilder:validation:ExactlyOneOf=header;jwtClaim;metadata
+// +kubebuilder:validation:XValidation:message="allowedValues must be set when the tenant is read from a header",rule="!has(self.header) || has(self.allowedValues)"
+type AiTenant struct {
+	// The name of a request header holding the tenant, for example `x-team`.
+	// Clients can set any value in a header, so `allowedValues` must be set with a header.
+	// +optional
+	Header *gwv1.HeaderName `json:"header,omitempty"`
+
+	// The name of a claim of the JWT of the request holding the tenant, for example `team`.
+	// The claim is read from the payload stored by the JWT filter under the `principal` key of
+	// the `envoy.filters.http.jwt_authn` dynamic metadata namespace.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	JWTClaim *string `json:"jwtClaim,omitempty"`
+
+	// A dynamic metadata key holding the tenant, for example the name of the API key
+	// of the request set by an external auth service.
+	// +optional
+	Metadata *AiTenantMetadata `json:"metadata,omitempty"`
+
+	// The tenants that are reported in the `tenant` label. Requests of any other tenant are
+	// reported with the `other` tenant, which bounds the cardinality of the request metrics.
+	// Required when the tenant is read from a header.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=256
+	AllowedValues []string `json:"allowedValues,omitempty"`
+}
+
+func (in *AiTenant) GetHeader() *gwv1.HeaderName {
+	if in == nil {
+		return nil
+	}
+	return in.Header
+}
+
+func (in *AiTenant) GetJWTClaim() *string {
+	if in == nil {
+		return nil
+	}
+	return in.JWTClaim
+}
+
+func (in *AiTenant) GetMetadata() *AiTenantMetadata {
+	if in == nil {
+		return nil
+	}
+	return in.Metadata
+}
+
+func (in *AiTenant) GetAllowedValues() []string {
+	if in == nil {
+		return nil
+	}
+	return in.AllowedValues
+}
+
+// AiTenantMetadata is a key of the dynamic metadata of a request.
+type AiTenantMetadata struct {
+	// The dynamic metadata namespace to get the tenant from.
+	//
+	// +kubebuilder:validation:Enum=envoy.filters.http.jwt_authn;envoy.filters.http.ext_authz;io.solo.transformation
+	Namespace string `json:"namespace"`
+
+	// The key to use to get the tenant from the metadata namespace, using `:` as the delimiter
+	// of nested keys, for example `principal:team`.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+func (in *AiTenantMetadata) GetNamespace() string {
+	if in == nil {
+		return ""
+	}
+	return in.Namespace
+}
+
+func (in *AiTenantMetadata) GetKey() string {
+	if in == nil {
+		return ""
+	}
+	return in.Key
+}
+
 type CustomLabel struct {
 	// Name of the label to use in the prometheus metrics
 	//
@@ -1050,6 +1231,7 @@ func (otelTransportSecurityMode OTLPTransportSecurityMode) String() string {
 }
 
 // Agentgateway configures the agentgateway dataplane integration to be enabled if the `agentgateway` GatewayClass is used.
+// +kubebuilder:validation:XValidation:rule="!(has(self.customConfigMapName) && has(self.config))",message="config cannot be set together with customConfigMapName"
 type Agentgateway struct {
 	// Whether to enable the extension.
 	//
@@ -1110,6 +1292,173 @@ type Agentgateway struct {
 	//
 	// +optional
 	ExtraVolumeMounts []corev1.VolumeMount `json:"extraVolumeMounts,omitempty"`
+
+	// McpSessionAffinity configures how stateful MCP sessions keep working when
+	// the Gateway runs more than one agentgateway replica.
+	//
+	// +optional
+	McpSessionAffinity *McpSessionAffinity `json:"mcpSessionAffinity,omitempty"`
+
+	// Config is merged into the agentgateway configuration file generated for the Gateway,
+	// so a few settings can be changed without replacing the whole file with a custom ConfigMap.
+	// Cannot be set together with customConfigMapName.
+	//
+	// +optional
+	Config *AgentgatewayConfig `json:"config,omitempty"`
+}
+
+// AgentgatewayConfig defines the settings of the agentgateway configuration file
+// that can be overridden.
+type AgentgatewayConfig struct {
+	// The address the admin server listens on, e.g. `localhost:15000`.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	AdminAddress *string `json:"adminAddress,omitempty"`
+
+	// The address the stats server listens on, e.g. `[::]:15020`.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	StatsAddress *string `json:"statsAddress,omitempty"`
+
+	// The number of worker threads. Defaults to the number of CPUs available to the container.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1024
+	WorkerThreads *int32 `json:"workerThreads,omitempty"`
+
+	// The maximum time connections are drained for when agentgateway shuts down.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	ConnectionTerminationDeadline *metav1.Duration `json:"connectionTerminationDeadline,omitempty"`
+
+	// The minimum time connections are drained for when agentgateway shuts down, giving
+	// load balancers time to stop sending new connections.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	ConnectionMinTerminationDeadline *metav1.Duration `json:"connectionMinTerminationDeadline,omitempty"`
+
+	// Logging configures the access logs of agentgateway.
+	//
+	// +optional
+	Logging *AgentgatewayLogging `json:"logging,omitempty"`
+
+	// Tracing configures the default tracing settings of agentgateway.
+	//
+	// +optional
+	Tracing *AgentgatewayTracing `json:"tracing,omitempty"`
+}
+
+// AgentgatewayLogFormat is the format of the agentgateway access logs.
+// +kubebuilder:validation:Enum=text;json
+type AgentgatewayLogFormat string
+
+const (
+	// AgentgatewayLogFormatText writes access logs as plain text.
+	AgentgatewayLogFormatText AgentgatewayLogFormat = "text"
+	// AgentgatewayLogFormatJSON writes access logs as JSON objects.
+	AgentgatewayLogFormatJSON AgentgatewayLogFormat = "json"
+)
+
+// AgentgatewayLogging configures the access logs of agentgateway.
+type AgentgatewayLogging struct {
+	// The format of the access logs. Defaults to text.
+	//
+	// +optional
+	Format *AgentgatewayLogFormat `json:"format,omitempty"`
+
+	// A CEL expression selecting the requests that are logged, e.g. `response.code >= 400`.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Filter *string `json:"filter,omitempty"`
+}
+
+// AgentgatewayTracing configures the default tracing settings of agentgateway.
+type AgentgatewayTracing struct {
+	// The OTLP endpoint traces are exported to, e.g. `http://otel-collector.monitoring:4317`.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	OtlpEndpoint *string `json:"otlpEndpoint,omitempty"`
+
+	// The protocol used to export traces. Defaults to grpc.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=grpc;http
+	OtlpProtocol *string `json:"otlpProtocol,omitempty"`
+
+	// The percentage of requests that are sampled, from 0 to 100.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	RandomSampling *int32 `json:"randomSampling,omitempty"`
+}
+
+// McpSessionAffinityMode selects when MCP session affinity is configured.
+// +kubebuilder:validation:Enum=Auto;Enabled;Disabled
+type McpSessionAffinityMode string
+
+const (
+	// McpSessionAffinityModeAuto configures session affinity when the Gateway has routes
+	// to MCP backends and runs more than one replica, or when its replicas are not set
+	// so that they can be scaled by an autoscaler.
+	McpSessionAffinityModeAuto McpSessionAffinityMode = "Auto"
+	// McpSessionAffinityModeEnabled always configures session affinity.
+	McpSessionAffinityModeEnabled McpSessionAffinityMode = "Enabled"
+	// McpSessionAffinityModeDisabled never configures session affinity.
+	McpSessionAffinityModeDisabled McpSessionAffinityMode = "Disabled"
+)
+
+// McpSessionAffinity configures the affinity of MCP Streamable HTTP and SSE sessions
+// to agentgateway replicas.
+type McpSessionAffinity struct {
+	// Mode selects when session affinity is configured. Defaults to Auto.
+	//
+	// +optional
+	Mode *McpSessionAffinityMode `json:"mode,omitempty"`
+
+	// SessionKeySecretRef references a Secret in the namespace of the Gateway holding the
+	// key shared by all replicas under the `session-key` entry. When set, agentgateway issues
+	// self-describing session IDs encrypted with the key, so that any replica can resume a
+	// session. When unset, the Service of the Gateway pins each client IP to a replica instead.
+	//
+	// +optional
+	SessionKeySecretRef *corev1.LocalObjectReference `json:"sessionKeySecretRef,omitempty"`
+
+	// ClientIPTimeoutSeconds is the maximum session sticky time of a client IP when
+	// SessionKeySecretRef is unset. Defaults to 10800 (3 hours).
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	ClientIPTimeoutSeconds *int32 `json:"clientIPTimeoutSeconds,omitempty"`
+}
+
+func (in *McpSessionAffinity) GetMode() *McpSessionAffinityMode {
+	if in == nil {
+		return nil
+	}
+	return in.Mode
+}
+
+func (in *McpSessionAffinity) GetSessionKeySecretRef() *corev1.LocalObjectReference {
+	if in == nil {
+		return nil
+	}
+	return in.SessionKeySecretRef
+}
+
+func (in *McpSessionAffinity) GetClientIPTimeoutSeconds() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.ClientIPTimeoutSeconds
 }
 
 func (in *Agentgateway) GetEnabled() *bool {
@@ -1160,3 +1509,101 @@ func (in *Agentgateway) GetCustomConfigMapName() *string {
 	}
 	return in.CustomConfigMapName
 }
+
+func (in *Agentgateway) GetMcpSessionAffinity() *McpSessionAffinity {
+	if in == nil {
+		return nil
+	}
+	return in.McpSessionAffinity
+}
+
+func (in *Agentgateway) GetConfig() *AgentgatewayConfig {
+	if in == nil {
+		return nil
+	}
+	return in.Config
+}
+
+func (in *AgentgatewayConfig) GetAdminAddress() *string {
+	if in == nil {
+		return nil
+	}
+	return in.AdminAddress
+}
+
+func (in *AgentgatewayConfig) GetStatsAddress() *string {
+	if in == nil {
+		return nil
+	}
+	return in.StatsAddress
+}
+
+func (in *AgentgatewayConfig) GetWorkerThreads() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.WorkerThreads
+}
+
+func (in *AgentgatewayConfig) GetConnectionTerminationDeadline() *metav1.Duration {
+	if in == nil {
+		return nil
+	}
+	return in.ConnectionTerminationDeadline
+}
+
+func (in *AgentgatewayConfig) GetConnectionMinTerminationDeadline() *metav1.Duration {
+	if in == nil {
+		return nil
+	}
+	return in.ConnectionMinTerminationDeadline
+}
+
+func (in *AgentgatewayConfig) GetLogging() *AgentgatewayLogging {
+	if in == nil {
+		return nil
+	}
+	return in.Logging
+}
+
+func (in *AgentgatewayConfig) GetTracing() *AgentgatewayTracing {
+	if in == nil {
+		return nil
+	}
+	return in.Tracing
+}
+
+func (in *AgentgatewayLogging) GetFormat() *AgentgatewayLogFormat {
+	if in == nil {
+		return nil
+	}
+	return in.Format
+}
+
+func (in *AgentgatewayLogging) GetFilter() *string {
+	if in == nil {
+		return nil
+	}
+	return in.Filter
+}
+
+func (in *AgentgatewayTracing) GetOtlpEndpoint() *string {
+	if in == nil {
+		return nil
+	}
+	return in.OtlpEndpoint
+}
+
+func (in *AgentgatewayTracing) GetOtlpProtocol() *string {
+	if in == nil {
+		return nil
+	}
+	return in.OtlpProtocol
+}
+
+func (in *AgentgatewayTracing) GetRandomSampling() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.RandomSampling
+}
@@ -35,6 +35,46 @@ type McpTargetSelector struct {
 	// and using sectionName to target the specific static target by name.
 	// +optional
 	Static *McpTarget `json:"static,omitempty"`
+
+	// Prefix is prepended to the names of the tools and prompts exposed by this target,
+	// to avoid name collisions between targets. Policies must use the prefixed names.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Filter restricts the tools, prompts and resources exposed by this target.
+	// +optional
+	Filter *McpFilter `json:"filter,omitempty"`
+}
+
+// McpFilter restricts the tools, prompts and resources exposed by an MCP target.
+type McpFilter struct {
+	// Tools filters the tools exposed by the target by name.
+	// +optional
+	Tools *McpNameFilter `json:"tools,omitempty"`
+
+	// Prompts filters the prompts exposed by the target by name.
+	// +optional
+	Prompts *McpNameFilter `json:"prompts,omitempty"`
+
+	// Resources filters the resources exposed by the target by URI.
+	// +optional
+	Resources *McpNameFilter `json:"resources,omitempty"`
+}
+
+// McpNameFilter allows or denies MCP items by name. Names are matched before any
+// target prefix is applied.
+type McpNameFilter struct {
+	// Allow lists the names that are exposed. When set, all other names are hidden.
+	// +optional
+	// +kubebuilder:validation:MaxItems=256
+	Allow []string `json:"allow,omitempty"`
+
+	// Deny lists the names that are hidden. Deny takes precedence over Allow.
+	// +optional
+	// +kubebuilder:validation:MaxItems=256
+	Deny []string `json:"deny,omitempty"`
 }
 
 // McpSelector defines the selector logic to search for MCP targets.
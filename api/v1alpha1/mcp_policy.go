@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MCPPolicy configures policies for the tools, prompts and resources of an MCP Backend.
+type MCPPolicy struct {
+	// Authorization defines CEL authorization rules for individual tools, prompts and resources.
+	// Using an item listed in a rule is denied, and the item is hidden from listings, unless all
+	// the match expressions of the rule evaluate to true. Items without a rule are not affected.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Authorization []MCPAuthorizationRule `json:"authorization,omitempty"`
+
+	// UpstreamAuth injects credentials on the requests sent to the MCP targets. To use different
+	// credentials for each target, select the target with targetRefs[].sectionName.
+	// +optional
+	UpstreamAuth *MCPUpstreamAuth `json:"upstreamAuth,omitempty"`
+}
+
+// MCPUpstreamAuth configures the credentials sent to MCP targets.
+type MCPUpstreamAuth struct {
+	// SecretRef references a Secret in the same namespace as the policy whose `Authorization`
+	// key holds a static token, sent as a bearer token to the MCP targets.
+	// +required
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+}
+
+// MCPAuthorizationRule requires a set of conditions to be satisfied to use MCP items.
+type MCPAuthorizationRule struct {
+	// Kind is the kind of MCP item the rule applies to.
+	// +optional
+	// +kubebuilder:default=Tool
+	Kind MCPItemKind `json:"kind,omitempty"`
+
+	// Names are the names of the items the rule applies to, as exposed by the gateway
+	// including any target prefix. Resources are identified by their URI.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Names []string `json:"names"`
+
+	// MatchExpressions defines a set of Common Expression Language (CEL) conditions that must all be
+	// satisfied to use the items, for example `jwt.sub == "admin"`.
+	// +kubebuilder:validation:MinItems=1
+	MatchExpressions []string `json:"matchExpressions"`
+}
+
+// MCPItemKind is the kind of item exposed by an MCP server.
+// +kubebuilder:validation:Enum=Tool;Prompt;Resource
+type MCPItemKind string
+
+const (
+	// MCPItemKindTool applies to MCP tools.
+	MCPItemKindTool MCPItemKind = "Tool"
+	// MCPItemKindPrompt applies to MCP prompts.
+	MCPItemKindPrompt MCPItemKind = "Prompt"
+	// MCPItemKindResource applies to MCP resources.
+	MCPItemKindResource MCPItemKind = "Resource"
+)
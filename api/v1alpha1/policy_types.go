@@ -12,10 +12,12 @@ const (
 	//
 	// Possible reasons for this condition to be True are:
 	// * Valid
+	// * PartiallyValid
 	//
 	// Possible reasons for this condition to be False are:
 	// * Pending
 	// * Invalid
+	// * Unsupported
 	//
 	PolicyConditionAccepted PolicyConditionType = "Accepted"
 
@@ -35,10 +37,19 @@ const (
 	// has been accepted by the system.
 	PolicyReasonValid PolicyConditionReason = "Valid"
 
+	// PolicyReasonPartiallyValid is used with the "Accepted" condition when the policy
+	// has been accepted, but some of its fields are not supported by the data plane
+	// of the targeted resources and have been ignored.
+	PolicyReasonPartiallyValid PolicyConditionReason = "PartiallyValid"
+
 	// PolicyReasonInvalid is used with the "Accepted" or "Attached" condition when the policy
 	// is syntactically or semantically invalid.
 	PolicyReasonInvalid PolicyConditionReason = "Invalid"
 
+	// PolicyReasonUnsupported is used with the "Accepted" condition when the policy
+	// uses fields that are not supported by the data plane of the targeted resources.
+	PolicyReasonUnsupported PolicyConditionReason = "Unsupported"
+
 	// PolicyReasonAttached is used with the "Attached" condition when the
 	// policy has been successfully attached to all the targeted resources.
 	PolicyReasonAttached PolicyConditionReason = "Attached"
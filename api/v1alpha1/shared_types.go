@@ -7,6 +7,8 @@ import (
 
 // Control-plane RBAC rules not specific to policies:
 // +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=update
 
 // Select the object by Name and Namespace.
 // You can target only one object at a time.
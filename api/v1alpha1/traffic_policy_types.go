@@ -122,6 +122,11 @@ type TrafficPolicySpec struct {
 	// Agentgateway-based Gateway supports cumulative RBAC policies across different attachment points, such that
 	// an RBAC policy attached to a route augments policies applied to the gateway or listener without overriding them.
 	RBAC *RBAC `json:"rbac,omitempty"`
+
+	// MCP specifies policies that apply to the tools, prompts and resources of an MCP Backend.
+	// Note: This field is only applicable when using agentgateway and targeting an MCP Backend.
+	// +optional
+	MCP *MCPPolicy `json:"mcp,omitempty"`
 }
 
 // TransformationPolicy config is used to modify envoy behavior at a route level.
@@ -288,8 +293,32 @@ type RateLimitDescriptor struct {
 	// +required
 	// +kubebuilder:validation:MinItems=1
 	Entries []RateLimitDescriptorEntry `json:"entries"`
+
+	// Unit specifies what is counted against the limits configured for this descriptor
+	// in the rate limit service.
+	// Requests counts one hit per request.
+	// Tokens counts the prompt and completion tokens reported in the LLM response, including
+	// streaming responses, and is only meaningful for routes that target AI backends.
+	// With Tokens, the request is rejected with a 429 once the budget is exhausted, and the
+	// tokens consumed by a request are debited from the budget when the response completes.
+	// Remaining budget headers are returned when enabled on the rate limit GatewayExtension.
+	// +optional
+	// +kubebuilder:default=Requests
+	Unit RateLimitUnit `json:"unit,omitempty"`
 }
 
+// RateLimitUnit defines what a rate limit descriptor counts.
+// +kubebuilder:validation:Enum=Requests;Tokens
+type RateLimitUnit string
+
+const (
+	// RateLimitUnitRequests counts one hit per request.
+	RateLimitUnitRequests RateLimitUnit = "Requests"
+
+	// RateLimitUnitTokens counts the LLM prompt and completion tokens of a request.
+	RateLimitUnitTokens RateLimitUnit = "Tokens"
+)
+
 // RateLimitDescriptorEntryType defines the type of a rate limit descriptor entry.
 // +kubebuilder:validation:Enum=Generic;Header;RemoteAddress;Path
 type RateLimitDescriptorEntryType string
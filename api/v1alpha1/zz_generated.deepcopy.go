@@ -28,6 +28,11 @@ func (in *AIBackend) DeepCopyInto(out *AIBackend) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(AIFallback)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIBackend.
@@ -40,6 +45,61 @@ func (in *AIBackend) DeepCopy() *AIBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AICache) DeepCopyInto(out *AICache) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Semantic != nil {
+		in, out := &in.Semantic, &out.Semantic
+		*out = new(AISemanticCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AICache.
+func (in *AICache) DeepCopy() *AICache {
+	if in == nil {
+		return nil
+	}
+	out := new(AICache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIFallback) DeepCopyInto(out *AIFallback) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIFallback.
+func (in *AIFallback) DeepCopy() *AIFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(AIFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIPolicy) DeepCopyInto(out *AIPolicy) {
 	*out = *in
@@ -63,6 +123,11 @@ func (in *AIPolicy) DeepCopyInto(out *AIPolicy) {
 		*out = new(RouteType)
 		**out = **in
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(AICache)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIPolicy.
@@ -125,6 +190,48 @@ func (in *AIPromptGuard) DeepCopy() *AIPromptGuard {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AISemanticCache) DeepCopyInto(out *AISemanticCache) {
+	*out = *in
+	out.Embeddings = in.Embeddings
+	in.VectorStore.DeepCopyInto(&out.VectorStore)
+	if in.SimilarityThreshold != nil {
+		in, out := &in.SimilarityThreshold, &out.SimilarityThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AISemanticCache.
+func (in *AISemanticCache) DeepCopy() *AISemanticCache {
+	if in == nil {
+		return nil
+	}
+	out := new(AISemanticCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIVectorStore) DeepCopyInto(out *AIVectorStore) {
+	*out = *in
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(Host)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIVectorStore.
+func (in *AIVectorStore) DeepCopy() *AIVectorStore {
+	if in == nil {
+		return nil
+	}
+	out := new(AIVectorStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSGuardrailConfig) DeepCopyInto(out *AWSGuardrailConfig) {
 	*out = *in
@@ -287,6 +394,16 @@ func (in *Agentgateway) DeepCopyInto(out *Agentgateway) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.McpSessionAffinity != nil {
+		in, out := &in.McpSessionAffinity, &out.McpSessionAffinity
+		*out = new(McpSessionAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(AgentgatewayConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Agentgateway.
@@ -299,6 +416,111 @@ func (in *Agentgateway) DeepCopy() *Agentgateway {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentgatewayConfig) DeepCopyInto(out *AgentgatewayConfig) {
+	*out = *in
+	if in.AdminAddress != nil {
+		in, out := &in.AdminAddress, &out.AdminAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatsAddress != nil {
+		in, out := &in.StatsAddress, &out.StatsAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkerThreads != nil {
+		in, out := &in.WorkerThreads, &out.WorkerThreads
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConnectionTerminationDeadline != nil {
+		in, out := &in.ConnectionTerminationDeadline, &out.ConnectionTerminationDeadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ConnectionMinTerminationDeadline != nil {
+		in, out := &in.ConnectionMinTerminationDeadline, &out.ConnectionMinTerminationDeadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(AgentgatewayLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(AgentgatewayTracing)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentgatewayConfig.
+func (in *AgentgatewayConfig) DeepCopy() *AgentgatewayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentgatewayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentgatewayLogging) DeepCopyInto(out *AgentgatewayLogging) {
+	*out = *in
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(AgentgatewayLogFormat)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentgatewayLogging.
+func (in *AgentgatewayLogging) DeepCopy() *AgentgatewayLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentgatewayLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentgatewayTracing) DeepCopyInto(out *AgentgatewayTracing) {
+	*out = *in
+	if in.OtlpEndpoint != nil {
+		in, out := &in.OtlpEndpoint, &out.OtlpEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.OtlpProtocol != nil {
+		in, out := &in.OtlpProtocol, &out.OtlpProtocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.RandomSampling != nil {
+		in, out := &in.RandomSampling, &out.RandomSampling
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentgatewayTracing.
+func (in *AgentgatewayTracing) DeepCopy() *AgentgatewayTracing {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentgatewayTracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AiExtension) DeepCopyInto(out *AiExtension) {
 	*out = *in
@@ -366,6 +588,18 @@ func (in *AiExtensionStats) DeepCopyInto(out *AiExtensionStats) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Pricing != nil {
+		in, out := &in.Pricing, &out.Pricing
+		*out = make([]ModelPricing, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tenant != nil {
+		in, out := &in.Tenant, &out.Tenant
+		*out = new(AiTenant)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiExtensionStats.
@@ -408,6 +642,56 @@ func (in *AiExtensionTrace) DeepCopy() *AiExtensionTrace {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiTenant) DeepCopyInto(out *AiTenant) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(v1.HeaderName)
+		**out = **in
+	}
+	if in.JWTClaim != nil {
+		in, out := &in.JWTClaim, &out.JWTClaim
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(AiTenantMetadata)
+		**out = **in
+	}
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiTenant.
+func (in *AiTenant) DeepCopy() *AiTenant {
+	if in == nil {
+		return nil
+	}
+	out := new(AiTenant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiTenantMetadata) DeepCopyInto(out *AiTenantMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiTenantMetadata.
+func (in *AiTenantMetadata) DeepCopy() *AiTenantMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(AiTenantMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlwaysOnConfig) DeepCopyInto(out *AlwaysOnConfig) {
 	*out = *in
@@ -2827,6 +3111,13 @@ func (in *LLMProvider) DeepCopyInto(out *LLMProvider) {
 		*out = new(AuthHeader)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]ModelAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMProvider.
@@ -3147,6 +3438,129 @@ func (in *MCP) DeepCopy() *MCP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPAuthorizationRule) DeepCopyInto(out *MCPAuthorizationRule) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MatchExpressions != nil {
+		in, out := &in.MatchExpressions, &out.MatchExpressions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuthorizationRule.
+func (in *MCPAuthorizationRule) DeepCopy() *MCPAuthorizationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPAuthorizationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPPolicy) DeepCopyInto(out *MCPPolicy) {
+	*out = *in
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = make([]MCPAuthorizationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpstreamAuth != nil {
+		in, out := &in.UpstreamAuth, &out.UpstreamAuth
+		*out = new(MCPUpstreamAuth)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPolicy.
+func (in *MCPPolicy) DeepCopy() *MCPPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPUpstreamAuth) DeepCopyInto(out *MCPUpstreamAuth) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPUpstreamAuth.
+func (in *MCPUpstreamAuth) DeepCopy() *MCPUpstreamAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPUpstreamAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *McpFilter) DeepCopyInto(out *McpFilter) {
+	*out = *in
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = new(McpNameFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prompts != nil {
+		in, out := &in.Prompts, &out.Prompts
+		*out = new(McpNameFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(McpNameFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new McpFilter.
+func (in *McpFilter) DeepCopy() *McpFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(McpFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *McpNameFilter) DeepCopyInto(out *McpNameFilter) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new McpNameFilter.
+func (in *McpNameFilter) DeepCopy() *McpNameFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(McpNameFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *McpSelector) DeepCopyInto(out *McpSelector) {
 	*out = *in
@@ -3172,6 +3586,36 @@ func (in *McpSelector) DeepCopy() *McpSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *McpSessionAffinity) DeepCopyInto(out *McpSessionAffinity) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(McpSessionAffinityMode)
+		**out = **in
+	}
+	if in.SessionKeySecretRef != nil {
+		in, out := &in.SessionKeySecretRef, &out.SessionKeySecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ClientIPTimeoutSeconds != nil {
+		in, out := &in.ClientIPTimeoutSeconds, &out.ClientIPTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new McpSessionAffinity.
+func (in *McpSessionAffinity) DeepCopy() *McpSessionAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(McpSessionAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *McpTarget) DeepCopyInto(out *McpTarget) {
 	*out = *in
@@ -3210,6 +3654,16 @@ func (in *McpTargetSelector) DeepCopyInto(out *McpTargetSelector) {
 		*out = new(McpTarget)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(McpFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new McpTargetSelector.
@@ -3317,6 +3771,46 @@ func (in *MetadataPathSegment) DeepCopy() *MetadataPathSegment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelAlias) DeepCopyInto(out *ModelAlias) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelAlias.
+func (in *ModelAlias) DeepCopy() *ModelAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelPricing) DeepCopyInto(out *ModelPricing) {
+	*out = *in
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelPricing.
+func (in *ModelPricing) DeepCopy() *ModelPricing {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelPricing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Moderation) DeepCopyInto(out *Moderation) {
 	*out = *in
@@ -4066,6 +4560,11 @@ func (in *Regex) DeepCopyInto(out *Regex) {
 		*out = make([]BuiltIn, len(*in))
 		copy(*out, *in)
 	}
+	if in.PatternSets != nil {
+		in, out := &in.PatternSets, &out.PatternSets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.Action != nil {
 		in, out := &in.Action, &out.Action
 		*out = new(Action)
@@ -4091,11 +4590,21 @@ func (in *RegexMatch) DeepCopyInto(out *RegexMatch) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Builtin != nil {
+		in, out := &in.Builtin, &out.Builtin
+		*out = new(BuiltIn)
+		**out = **in
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(Action)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexMatch.
@@ -4983,6 +5492,11 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(RBAC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MCP != nil {
+		in, out := &in.MCP, &out.MCP
+		*out = new(MCPPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficPolicySpec.
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/admin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+func newDebugBundleCommand() *cobra.Command {
+	var (
+		output       string
+		adminAddress string
+	)
+	cmd := &cobra.Command{
+		Use:   "debug-bundle",
+		Short: "Collects a debug bundle from the admin server of a running controller",
+		Long: `Collects a gzipped tar archive with the krt and xDS snapshots, the kgateway and Gateway API objects
+with their statuses, the settings and the version of a running controller, to attach to support tickets.
+Secrets and sensitive xDS fields are redacted. The admin server only listens on localhost, so run the
+command in the controller pod:
+
+  kubectl exec -n kgateway-system deploy/kgateway -- kgateway debug-bundle > bundle.tar.gz
+
+The archive can be loaded back by the offline translator with kgateway translate -f bundle.tar.gz.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := http.NewRequestWithContext(cmd.Context(), http.MethodGet, "http://"+adminAddress+admin.DebugBundlePath, nil)
+			if err != nil {
+				return err
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("error reaching the admin server: %w", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("admin server returned %s", resp.Status)
+			}
+
+			out := cmd.OutOrStdout()
+			if output != "-" {
+				f, err := os.Create(output) //nolint:gosec // G304: writing the user supplied output file is the purpose of the command
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+			_, err = io.Copy(out, resp.Body)
+			return err
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "-", "File to write the bundle to, - for stdout")
+	cmd.Flags().StringVar(&adminAddress, "admin-address", fmt.Sprintf("localhost:%d", wellknown.KgatewayAdminPort), "Address of the admin server of the controller")
+	return cmd
+}
@@ -34,6 +34,8 @@ func main() {
 		},
 	}
 	cmd.Flags().BoolVarP(&kgatewayVersion, "version", "v", false, "Print the version of kgateway")
+	cmd.AddCommand(newTranslateCommand())
+	cmd.AddCommand(newDebugBundleCommand())
 
 	if err := cmd.Execute(); err != nil {
 		log.Fatal(err)
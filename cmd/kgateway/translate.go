@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/offline"
+	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
+)
+
+func newTranslateCommand() *cobra.Command {
+	var (
+		filenames   []string
+		output      string
+		dataplane   string
+		namespace   string
+		envoyBinary string
+	)
+	cmd := &cobra.Command{
+		Use:   "translate -f FILENAME...",
+		Short: "Translates Gateway API and kgateway manifests without a cluster",
+		Long: `Translates Gateway API and kgateway manifests without a cluster, using the same plugins as the
+controller, and prints the proxy configuration of every Gateway along with the status every object
+would get. Exits with an error if any object would be rejected, has unresolved references or conflicts.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(filenames) == 0 {
+				return errors.New("at least one file, directory or - (stdin) must be given with -f")
+			}
+			if output != "yaml" && output != "json" {
+				return fmt.Errorf("unsupported output format %q", output)
+			}
+
+			loader, err := offline.NewLoader(schemes.InferExtScheme(), namespace, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			objs, err := loader.LoadPaths(filenames)
+			if err != nil {
+				return err
+			}
+			result, err := offline.Translate(cmd.Context(), objs, offline.Options{
+				Dataplane:   offline.Dataplane(dataplane),
+				EnvoyBinary: envoyBinary,
+			})
+			if err != nil {
+				return fmt.Errorf("error translating: %w", err)
+			}
+
+			out, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+			if output == "yaml" {
+				if out, err = yaml.JSONToYAML(out); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+
+			condErrs := result.Statuses.Errors()
+			for _, e := range condErrs {
+				fmt.Fprintln(cmd.ErrOrStderr(), e.String())
+			}
+			if len(condErrs) > 0 {
+				return fmt.Errorf("found %d error conditions", len(condErrs))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVarP(&filenames, "filename", "f", nil, "Files, directories or debug bundles containing the manifests to translate, - for stdin")
+	cmd.Flags().StringVarP(&output, "output", "o", "yaml", "Output format, yaml or json")
+	cmd.Flags().StringVar(&dataplane, "dataplane", string(offline.DataplaneEnvoy), "Dataplane to translate for, envoy or agentgateway")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "default", "Namespace of the manifests that do not specify one")
+	cmd.Flags().StringVar(&envoyBinary, "envoy-binary", "", "Path of the envoy binary used to validate the configuration in strict validation mode")
+	return cmd
+}
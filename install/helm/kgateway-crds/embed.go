@@ -0,0 +1,12 @@
+// Package kgatewaycrds embeds the kgateway CustomResourceDefinitions so that they can be used
+// without access to a cluster, e.g. to apply API defaults when translating offline.
+package kgatewaycrds
+
+import (
+	"embed"
+)
+
+var (
+	//go:embed templates/*.yaml
+	Templates embed.FS
+)
@@ -74,3 +74,10 @@ Supported values: "standard" or "strict" (case-insensitive).
 {{- printf "ERROR: Invalid validation.level '%s'. Must be 'standard' or 'strict' (case-insensitive). Current value: '%s'" $level .Values.validation.level | fail -}}
 {{- end -}}
 {{- end }}
+
+{{/*
+Name of the ValidatingWebhookConfiguration. Cluster-scoped, so it includes the release namespace.
+*/}}
+{{- define "kgateway.webhookConfigName" -}}
+{{- printf "%s-%s" (include "kgateway.fullname" .) .Release.Namespace | trunc 63 | trimSuffix "-" -}}
+{{- end }}
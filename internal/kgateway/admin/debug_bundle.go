@@ -0,0 +1,31 @@
+package admin
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/debugbundle"
+)
+
+// DebugBundlePath is the path of the debug bundle endpoint of the admin server
+const DebugBundlePath = "/debug/bundle"
+
+// The debug bundle streams a gzipped tar archive with the state of the controller, to attach to support
+// tickets. Secrets and sensitive xDS fields are redacted, and the archive can be loaded back by the
+// offline translator (`kgateway translate -f bundle.tar.gz`).
+func addDebugBundleHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, sources debugbundle.Sources) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		filename := fmt.Sprintf("kgateway-debug-bundle-%s.tar.gz", time.Now().UTC().Format("20060102T150405Z"))
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		// the archive is streamed, so errors can only be logged once it has started
+		if err := debugbundle.Write(r.Context(), w, sources); err != nil {
+			slog.Error("error writing debug bundle", "error", err)
+		}
+	})
+	profiles[path] = func() string {
+		return "Archive of the krt and xDS snapshots, kgateway and Gateway API objects and settings, with secrets redacted"
+	}
+}
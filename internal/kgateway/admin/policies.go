@@ -0,0 +1,152 @@
+package admin
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/irtranslator"
+)
+
+// The policies handler explains the effective policy of each plugin on the routes of a Gateway: which
+// policy set each field and why the lower priority policies lost. Without a gateway, it lists the
+// Gateways that can be explained. It returns JSON, or HTML with `format=html`.
+//
+// Query parameters:
+//   - gateway: namespace/name of the Gateway
+//   - listener: name of a listener of the Gateway (optional)
+//   - route: namespace/name of an HTTPRoute (optional)
+//   - rule: name or index of a rule of the route (optional)
+func addPoliciesHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, explorer *irtranslator.PolicyExplorer) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		html := query.Get("format") == "html"
+		if !query.Has("gateway") {
+			gateways := explorer.Gateways()
+			if html {
+				writeHTML(w, policiesTemplate, policiesPage{Path: path, Gateways: gateways})
+				return
+			}
+			writeJSON(w, gateways, r)
+			return
+		}
+
+		q, err := parsePolicyQuery(query.Get("gateway"), query.Get("route"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		q.Listener = query.Get("listener")
+		q.Rule = query.Get("rule")
+		routes, err := explorer.Explain(q)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if html {
+			writeHTML(w, policiesTemplate, policiesPage{Path: path, Gateways: explorer.Gateways(), Query: q, Routes: routes})
+			return
+		}
+		writeJSON(w, routes, r)
+	})
+	profiles[path] = func() string {
+		return fmt.Sprintf(`Effective policies of the routes of a Gateway, and the policy each field comes from.<br/>
+	<a href="%s?format=html">explore</a>`, path)
+	}
+}
+
+func parsePolicyQuery(gateway, route string) (irtranslator.PolicyQuery, error) {
+	var q irtranslator.PolicyQuery
+	gw, err := parseNamespacedName(gateway)
+	if err != nil {
+		return q, fmt.Errorf("invalid gateway: %w", err)
+	}
+	q.Gateway = gw
+	if route != "" {
+		rt, err := parseNamespacedName(route)
+		if err != nil {
+			return q, fmt.Errorf("invalid route: %w", err)
+		}
+		q.Route = &rt
+	}
+	return q, nil
+}
+
+func parseNamespacedName(s string) (types.NamespacedName, error) {
+	ns, name, ok := strings.Cut(s, "/")
+	if !ok || ns == "" || name == "" {
+		return types.NamespacedName{}, fmt.Errorf("expected namespace/name, got %q", s)
+	}
+	return types.NamespacedName{Namespace: ns, Name: name}, nil
+}
+
+func writeHTML(w http.ResponseWriter, t *template.Template, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := t.Execute(w, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+type policiesPage struct {
+	Path     string
+	Gateways []types.NamespacedName
+	Query    irtranslator.PolicyQuery
+	Routes   []irtranslator.RoutePolicies
+}
+
+func (p policiesPage) RouteParam() string {
+	if p.Query.Route == nil {
+		return ""
+	}
+	return p.Query.Route.String()
+}
+
+var policiesTemplate = template.Must(template.New("policies").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<title>Policies</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 2px 6px; text-align: left; vertical-align: top; }
+.lost { color: #a00; }
+</style>
+</head>
+<body>
+<h1>Policies</h1>
+<form action="{{.Path}}">
+<input type="hidden" name="format" value="html">
+Gateway: <select name="gateway">{{range .Gateways}}<option value="{{.}}"{{if eq . $.Query.Gateway}} selected{{end}}>{{.}}</option>{{end}}</select>
+Listener: <input name="listener" value="{{.Query.Listener}}">
+Route: <input name="route" placeholder="namespace/name" value="{{.RouteParam}}">
+Rule: <input name="rule" placeholder="name or index" value="{{.Query.Rule}}">
+<button type="submit">Explain</button>
+</form>
+{{range .Routes}}
+<h2>{{.Name}}</h2>
+<p>route: {{.Route}}, listener: {{.Listener}}, virtual host: {{.VirtualHost}}, match: {{.MatchIndex}}</p>
+{{range .Plugins}}
+<h3>{{.GroupKind}}</h3>
+{{range .Errors}}<p class="lost">not applied: {{.}}</p>
+{{end}}<table>
+<tr><th>field</th><th>scope</th><th>set by</th></tr>
+{{range .Fields}}<tr><td>{{.Field}}</td><td>{{.Scope}}</td><td>{{range .Policies}}{{.}}<br/>{{else}}extensionRef{{end}}</td></tr>
+{{end}}
+</table>
+<table>
+<tr><th>policy</th><th>scope</th><th>level</th><th>weight</th><th>contributed</th><th>lost</th></tr>
+{{range .Policies}}<tr>
+<td>{{.Policy}}</td><td>{{.Scope}}</td><td>{{.Level}}{{if .InheritedPolicyPriority}} ({{.InheritedPolicyPriority}}){{end}}</td><td>{{.Weight}}</td>
+<td>{{range .Contributed}}{{.}}<br/>{{end}}</td>
+<td class="lost">{{range .Errors}}error: {{.}}<br/>{{end}}{{range .Overridden}}{{.Field}}: {{.Reason}}<br/>{{end}}</td>
+</tr>
+{{end}}
+</table>
+{{end}}
+{{end}}
+</body>
+</html>
+`))
@@ -14,12 +14,22 @@ import (
 	"istio.io/istio/pkg/kube/krt"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/controller"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/debugbundle"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/irtranslator"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 func RunAdminServer(ctx context.Context, setupOpts *controller.SetupOpts) error {
 	// serverHandlers defines the custom handlers that the Admin Server will support
-	serverHandlers := getServerHandlers(ctx, setupOpts.KrtDebugger, setupOpts.Cache)
+	serverHandlers := getServerHandlers(ctx, setupOpts.KrtDebugger, setupOpts.Cache, setupOpts.XdsStatus, setupOpts.PolicyExplorer, setupOpts.SnapshotHistory, debugbundle.Sources{
+		Reader:      setupOpts.APIReader,
+		Scheme:      setupOpts.Scheme,
+		KrtDebugger: setupOpts.KrtDebugger,
+		Cache:       setupOpts.Cache,
+		Settings:    setupOpts.GlobalSettings,
+	})
 
 	startHandlers(ctx, serverHandlers)
 
@@ -32,12 +42,35 @@ type dynamicProfileDescription func() string
 
 // getServerHandlers returns the custom handlers for the Admin Server, which will be bound to the http.ServeMux
 // These endpoints serve as the basis for an Admin Interface for the Control Plane (https://github.com/kgateway-dev/kgateway/issues/6494)
-func getServerHandlers(_ context.Context, dbg *krt.DebugHandler, cache envoycache.SnapshotCache) func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
+func getServerHandlers(
+	_ context.Context,
+	dbg *krt.DebugHandler,
+	cache envoycache.SnapshotCache,
+	xdsStatus *krtcollections.XdsStatus,
+	explorer *irtranslator.PolicyExplorer,
+	history *proxy_syncer.SnapshotHistory,
+	bundle debugbundle.Sources,
+) func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
 	return func(m *http.ServeMux, profiles map[string]dynamicProfileDescription) {
 		addXdsSnapshotHandler("/snapshots/xds", m, profiles, cache)
 
+		if history != nil {
+			addXdsHistoryHandler("/snapshots/xds/history", m, profiles, history)
+			addXdsDiffHandler("/snapshots/xds/diff", m, profiles, history)
+		}
+
+		if xdsStatus != nil {
+			addXdsStatusHandler("/xds/status", m, profiles, xdsStatus)
+		}
+
 		addKrtSnapshotHandler("/snapshots/krt", m, profiles, dbg)
 
+		if explorer != nil {
+			addPoliciesHandler("/policies", m, profiles, explorer)
+		}
+
+		addDebugBundleHandler(DebugBundlePath, m, profiles, bundle)
+
 		addLoggingHandler("/logging", m, profiles)
 
 		addPprofHandler("/debug/pprof/", m, profiles)
@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
+)
+
+// The xDS history lists the last snapshots sent to each proxy. Without a proxy, it returns the proxies with
+// a history. With the `proxy` query parameter, it returns the snapshots of the proxy with the hash of each
+// resource.
+func addXdsHistoryHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, history *proxy_syncer.SnapshotHistory) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		proxy := r.URL.Query().Get("proxy")
+		if proxy == "" {
+			writeJSON(w, history.Proxies(), r)
+			return
+		}
+		versions, err := history.Versions(proxy, true)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		writeJSON(w, versions, r)
+	})
+	profiles[path] = func() string { return "Last xDS snapshots sent to each proxy" }
+}
+
+// The xDS diff returns the resources added, removed and modified between two snapshots of a proxy, with the
+// modified fields of each resource. Sensitive fields are redacted.
+//
+// Query parameters:
+//   - proxy: key of the proxy, as listed by the xDS history
+//   - from: version of the older snapshot (optional, defaults to the snapshot before `to`)
+//   - to: version of the newer snapshot (optional, defaults to the latest snapshot)
+func addXdsDiffHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, history *proxy_syncer.SnapshotHistory) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		from, err := parseSnapshotVersion(query.Get("from"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid from: %v", err), http.StatusBadRequest)
+			return
+		}
+		to, err := parseSnapshotVersion(query.Get("to"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid to: %v", err), http.StatusBadRequest)
+			return
+		}
+		diff, err := history.Diff(query.Get("proxy"), from, to)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		writeJSON(w, diff, r)
+	})
+	profiles[path] = func() string { return "Changes between two xDS snapshots of a proxy" }
+}
+
+func parseSnapshotVersion(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
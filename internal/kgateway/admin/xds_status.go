@@ -0,0 +1,33 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+)
+
+// The xDS status returns the last config ACKed and NACKed by every connected proxy, per type URL.
+// With the `rejected` query parameter, only the proxies currently rejecting their config are returned.
+func addXdsStatusHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, xdsStatus *krtcollections.XdsStatus) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		clients := xdsStatus.Clients()
+		if r.URL.Query().Has("rejected") {
+			clients = filterRejected(clients)
+		}
+		writeJSON(w, clients, r)
+	})
+	profiles[path] = func() string { return "xDS ACK/NACK status of the connected proxies" }
+}
+
+func filterRejected(clients []krtcollections.XdsClientStatus) []krtcollections.XdsClientStatus {
+	out := make([]krtcollections.XdsClientStatus, 0, len(clients))
+	for _, c := range clients {
+		for _, ts := range c.Types {
+			if ts.Rejected {
+				out = append(out, c)
+				break
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,339 @@
+package admission
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	certValidity = 365 * 24 * time.Hour
+	caValidity   = 10 * certValidity
+	// certRenewBefore is how long before expiry the serving certificate and the CA are renewed
+	certRenewBefore  = 30 * 24 * time.Hour
+	certResyncPeriod = time.Hour
+
+	// caPrivateKeyKey is the key of the CA private key in the Secret, so that the serving certificate
+	// is renewed with the same CA
+	caPrivateKeyKey = "ca.key"
+)
+
+// CertManager manages the self-signed serving certificate of the validating admission webhook.
+// The certificate is stored in a Secret so that all replicas serve the same certificate,
+// and its CA is injected in the ValidatingWebhookConfiguration. The serving certificate is renewed
+// with the same CA; when the CA itself is renewed, the previous CA stays in the CA bundle until it
+// expires so that certificates signed by either CA are trusted during the rollover.
+type CertManager struct {
+	client      kubernetes.Interface
+	namespace   string
+	secretName  string
+	webhookName string
+	dnsNames    []string
+
+	cert atomic.Pointer[tls.Certificate]
+}
+
+// NewCertManager returns a CertManager for a webhook served by the given Service.
+func NewCertManager(client kubernetes.Interface, namespace, secretName, webhookName, serviceName string) *CertManager {
+	return &CertManager{
+		client:      client,
+		namespace:   namespace,
+		secretName:  secretName,
+		webhookName: webhookName,
+		dnsNames: []string{
+			serviceName,
+			fmt.Sprintf("%s.%s", serviceName, namespace),
+			fmt.Sprintf("%s.%s.svc", serviceName, namespace),
+			fmt.Sprintf("%s.%s.svc.cluster.local", serviceName, namespace),
+		},
+	}
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (m *CertManager) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := m.cert.Load()
+	if cert == nil {
+		return nil, errors.New("webhook serving certificate is not loaded yet")
+	}
+	return cert, nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable; every replica serves the webhook.
+func (m *CertManager) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable. It loads the serving certificate, creating or renewing it as needed,
+// and keeps it and the CA bundle of the webhook configuration up to date until ctx is cancelled.
+func (m *CertManager) Start(ctx context.Context) error {
+	ticker := time.NewTicker(certResyncPeriod)
+	defer ticker.Stop()
+	for {
+		if err := m.sync(ctx); err != nil {
+			logger.Error("failed to sync webhook serving certificate", "secret", m.secretName, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *CertManager) sync(ctx context.Context) error {
+	secret, err := m.ensureSecret(ctx)
+	if err != nil {
+		return err
+	}
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("invalid certificate in secret %s/%s: %w", m.namespace, m.secretName, err)
+	}
+	// the CA bundle is injected first, so that a renewed certificate is only served once its CA is trusted
+	if err := m.injectCABundle(ctx, secret.Data[corev1.ServiceAccountRootCAKey]); err != nil {
+		return err
+	}
+	m.cert.Store(&cert)
+	return nil
+}
+
+// ensureSecret returns the Secret holding a valid serving certificate, creating or renewing it if needed.
+// Replicas racing to create or renew the Secret all end up with the certificate of the winner.
+func (m *CertManager) ensureSecret(ctx context.Context) (*corev1.Secret, error) {
+	secrets := m.client.CoreV1().Secrets(m.namespace)
+	secret, err := secrets.Get(ctx, m.secretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: m.secretName, Namespace: m.namespace},
+			Type:       corev1.SecretTypeTLS,
+		}
+		if secret.Data, err = renewCerts(nil, m.dnsNames, time.Now()); err != nil {
+			return nil, err
+		}
+		created, err := secrets.Create(ctx, secret, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			return secrets.Get(ctx, m.secretName, metav1.GetOptions{})
+		}
+		return created, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !needsRenewal(secret.Data, m.dnsNames, time.Now()) {
+		return secret, nil
+	}
+
+	logger.Info("renewing webhook serving certificate", "secret", m.secretName)
+	secret = secret.DeepCopy()
+	if secret.Data, err = renewCerts(secret.Data, m.dnsNames, time.Now()); err != nil {
+		return nil, err
+	}
+	updated, err := secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) {
+		return secrets.Get(ctx, m.secretName, metav1.GetOptions{})
+	}
+	return updated, err
+}
+
+// injectCABundle sets the CA bundle of every webhook of the webhook configuration, which is checked on
+// every sync as it is reset when the configuration is re-applied.
+func (m *CertManager) injectCABundle(ctx context.Context, ca []byte) error {
+	configs := m.client.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	config, err := configs.Get(ctx, m.webhookName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get webhook configuration %s: %w", m.webhookName, err)
+	}
+	webhooks := make([]map[string]any, 0, len(config.Webhooks))
+	upToDate := true
+	for _, wh := range config.Webhooks {
+		upToDate = upToDate && bytes.Equal(wh.ClientConfig.CABundle, ca)
+		webhooks = append(webhooks, map[string]any{
+			"name":         wh.Name,
+			"clientConfig": map[string]any{"caBundle": ca},
+		})
+	}
+	if upToDate {
+		return nil
+	}
+	patch, err := json.Marshal(map[string]any{"webhooks": webhooks})
+	if err != nil {
+		return err
+	}
+	if _, err := configs.Patch(ctx, m.webhookName, types.StrategicMergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to inject CA bundle in webhook configuration %s: %w", m.webhookName, err)
+	}
+	return nil
+}
+
+// needsRenewal returns true if data does not hold a serving certificate for dnsNames that is valid for
+// longer than certRenewBefore.
+func needsRenewal(data map[string][]byte, dnsNames []string, now time.Time) bool {
+	block, _ := pem.Decode(data[corev1.TLSCertKey])
+	if block == nil || len(data[corev1.TLSPrivateKeyKey]) == 0 || len(data[corev1.ServiceAccountRootCAKey]) == 0 {
+		return true
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return true
+	}
+	for _, name := range dnsNames {
+		if cert.VerifyHostname(name) != nil {
+			return true
+		}
+	}
+	return now.Add(certRenewBefore).After(cert.NotAfter)
+}
+
+// renewCerts returns the data of a kubernetes.io/tls Secret with a new serving certificate for dnsNames,
+// with the CA bundle under ca.crt and the key of its first CA under ca.key. The serving certificate is
+// signed by the CA of data, unless it is missing or expires within certRenewBefore: a new CA is then
+// generated and prepended to the bundle. Expired CAs are dropped from the bundle.
+func renewCerts(data map[string][]byte, dnsNames []string, now time.Time) (map[string][]byte, error) {
+	bundle := validCAs(data[corev1.ServiceAccountRootCAKey], now)
+	caCert, caKey, err := parseCA(data)
+	if err == nil && now.Add(certRenewBefore).After(caCert.NotAfter) {
+		err = errors.New("the CA expires soon")
+	}
+	if err != nil {
+		if data != nil {
+			logger.Info("generating a new webhook CA", "reason", err)
+		}
+		caCert, caKey, err = generateCA(now)
+		if err != nil {
+			return nil, err
+		}
+		bundle = append([]*x509.Certificate{caCert}, bundle...)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: newSerialNumber(),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    now.Add(-time.Hour),
+		// the serving certificate is renewed along with the CA, see needsRenewal
+		NotAfter:    minTime(now.Add(certValidity), caCert.NotAfter),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	caKeyDER, err := x509.MarshalECPrivateKey(caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var bundlePEM []byte
+	for _, ca := range bundle {
+		bundlePEM = append(bundlePEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})...)
+	}
+	return map[string][]byte{
+		corev1.TLSCertKey:              pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		corev1.TLSPrivateKeyKey:        pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		corev1.ServiceAccountRootCAKey: bundlePEM,
+		caPrivateKeyKey:                pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: caKeyDER}),
+	}, nil
+}
+
+// parseCA returns the CA the serving certificate of data is signed with: the first certificate of the
+// CA bundle, whose key is stored under ca.key.
+func parseCA(data map[string][]byte) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode(data[corev1.ServiceAccountRootCAKey])
+	keyBlock, _ := pem.Decode(data[caPrivateKeyKey])
+	if certBlock == nil || keyBlock == nil {
+		return nil, nil, errors.New("no CA certificate and key")
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !key.PublicKey.Equal(cert.PublicKey) {
+		return nil, nil, errors.New("CA key does not match the CA certificate")
+	}
+	return cert, key, nil
+}
+
+// validCAs returns the certificates of the PEM bundle that have not expired at now.
+func validCAs(bundle []byte, now time.Time) []*x509.Certificate {
+	var out []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return out
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil || now.After(cert.NotAfter) {
+			continue
+		}
+		out = append(out, cert)
+	}
+}
+
+func generateCA(now time.Time) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          newSerialNumber(),
+		Subject:               pkix.Name{CommonName: "kgateway-webhook-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, nil, err
+	}
+	return caCert, caKey, nil
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func newSerialNumber() *big.Int {
+	serial, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	return serial
+}
@@ -0,0 +1,120 @@
+package admission
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRenewCerts(t *testing.T) {
+	now := time.Now()
+	dnsNames := []string{"kgateway", "kgateway.kgateway-system.svc"}
+	data, err := renewCerts(nil, dnsNames, now)
+	require.NoError(t, err)
+
+	_, err = tls.X509KeyPair(data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey])
+	require.NoError(t, err)
+	verifyServingCert(t, data[corev1.TLSCertKey], data[corev1.ServiceAccountRootCAKey], now)
+
+	assert.False(t, needsRenewal(data, dnsNames, now))
+	assert.True(t, needsRenewal(data, dnsNames, now.Add(certValidity-certRenewBefore+time.Hour)))
+	assert.True(t, needsRenewal(data, []string{"other.kgateway-system.svc"}, now))
+	assert.True(t, needsRenewal(map[string][]byte{}, dnsNames, now))
+
+	// the serving certificate is renewed with the same CA
+	renewedAt := now.Add(certValidity - certRenewBefore + time.Hour)
+	renewed, err := renewCerts(data, dnsNames, renewedAt)
+	require.NoError(t, err)
+	assert.Equal(t, data[corev1.ServiceAccountRootCAKey], renewed[corev1.ServiceAccountRootCAKey])
+	assert.Equal(t, data[caPrivateKeyKey], renewed[caPrivateKeyKey])
+	assert.NotEqual(t, data[corev1.TLSCertKey], renewed[corev1.TLSCertKey])
+	verifyServingCert(t, renewed[corev1.TLSCertKey], data[corev1.ServiceAccountRootCAKey], renewedAt)
+
+	// when the CA expires soon, a new CA is generated and the previous one stays trusted until it expires
+	rolledAt := now.Add(caValidity - certRenewBefore + time.Hour)
+	previous, err := renewCerts(renewed, dnsNames, rolledAt.Add(-certRenewBefore))
+	require.NoError(t, err)
+	assert.Equal(t, data[caPrivateKeyKey], previous[caPrivateKeyKey])
+	rolled, err := renewCerts(previous, dnsNames, rolledAt)
+	require.NoError(t, err)
+	assert.NotEqual(t, previous[caPrivateKeyKey], rolled[caPrivateKeyKey])
+	assert.Len(t, validCAs(rolled[corev1.ServiceAccountRootCAKey], rolledAt), 2)
+	verifyServingCert(t, rolled[corev1.TLSCertKey], rolled[corev1.ServiceAccountRootCAKey], rolledAt)
+	verifyServingCert(t, previous[corev1.TLSCertKey], rolled[corev1.ServiceAccountRootCAKey], rolledAt)
+
+	// the previous CA is dropped once it has expired
+	expiredAt := now.Add(caValidity + time.Hour)
+	expired, err := renewCerts(rolled, dnsNames, expiredAt)
+	require.NoError(t, err)
+	assert.Len(t, validCAs(expired[corev1.ServiceAccountRootCAKey], expiredAt), 1)
+	assert.Equal(t, rolled[caPrivateKeyKey], expired[caPrivateKeyKey])
+}
+
+func TestRenewCertsWithoutCAKey(t *testing.T) {
+	now := time.Now()
+	dnsNames := []string{"kgateway"}
+	data, err := renewCerts(nil, dnsNames, now)
+	require.NoError(t, err)
+	delete(data, caPrivateKeyKey)
+
+	// the CA cannot sign a new serving certificate, it stays trusted along with the new CA
+	renewed, err := renewCerts(data, dnsNames, now)
+	require.NoError(t, err)
+	assert.Len(t, validCAs(renewed[corev1.ServiceAccountRootCAKey], now), 2)
+	verifyServingCert(t, data[corev1.TLSCertKey], renewed[corev1.ServiceAccountRootCAKey], now)
+	verifyServingCert(t, renewed[corev1.TLSCertKey], renewed[corev1.ServiceAccountRootCAKey], now)
+}
+
+func verifyServingCert(t *testing.T, certPEM, bundle []byte, now time.Time) {
+	t.Helper()
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(bundle))
+	block, _ := pem.Decode(certPEM)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	_, err = cert.Verify(x509.VerifyOptions{DNSName: cert.DNSNames[0], Roots: roots, CurrentTime: now})
+	require.NoError(t, err)
+}
+
+func TestCertManagerSync(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientset(&admissionregv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "kgateway"},
+		Webhooks:   []admissionregv1.ValidatingWebhook{{Name: "policies.kgateway.dev"}},
+	})
+	m := NewCertManager(client, "kgateway-system", "kgateway-webhook-cert", "kgateway", "kgateway")
+
+	_, err := m.GetCertificate(nil)
+	require.Error(t, err)
+
+	require.NoError(t, m.sync(ctx))
+	cert, err := m.GetCertificate(nil)
+	require.NoError(t, err)
+	require.NotNil(t, cert)
+
+	secret, err := client.CoreV1().Secrets("kgateway-system").Get(ctx, "kgateway-webhook-cert", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, corev1.SecretTypeTLS, secret.Type)
+
+	config, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, "kgateway", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, secret.Data[corev1.ServiceAccountRootCAKey], config.Webhooks[0].ClientConfig.CABundle)
+
+	// another replica reuses the same certificate
+	other := NewCertManager(client, "kgateway-system", "kgateway-webhook-cert", "kgateway", "kgateway")
+	require.NoError(t, other.sync(ctx))
+	otherCert, err := other.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, cert.Certificate, otherCert.Certificate)
+}
@@ -0,0 +1,80 @@
+package admission
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
+)
+
+var logger = logging.New("admission")
+
+// ValidatePath is the path the validating admission webhook is served on.
+// This value should stay in sync with the ValidatingWebhookConfiguration in the helm chart.
+const ValidatePath = "/validate"
+
+// Handler validates kgateway policies with the same plugins that translate them, so that policies
+// with an invalid spec are rejected before they are persisted. References to other resources are
+// not resolved: a policy may be created before the resources it references, unresolved references
+// are reported in the policy status.
+type Handler struct {
+	scheme   *runtime.Scheme
+	decoder  admission.Decoder
+	policies map[schema.GroupKind]sdk.PolicyPlugin
+}
+
+var _ admission.Handler = &Handler{}
+
+// NewHandler returns a Handler validating the policies of the given plugin.
+func NewHandler(scheme *runtime.Scheme, plugins sdk.Plugin) *Handler {
+	policies := map[schema.GroupKind]sdk.PolicyPlugin{}
+	for gk, p := range plugins.ContributesPolicies {
+		if p.ValidatePolicy != nil {
+			policies[gk] = p
+		}
+	}
+	return &Handler{
+		scheme:   scheme,
+		decoder:  admission.NewDecoder(scheme),
+		policies: policies,
+	}
+}
+
+func (h *Handler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	gk := schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}
+	policy, ok := h.policies[gk]
+	if !ok {
+		return admission.Allowed("")
+	}
+	obj, err := h.scheme.New(schema.GroupVersionKind{Group: req.Kind.Group, Version: req.Kind.Version, Kind: req.Kind.Kind})
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if err := h.decoder.DecodeRaw(req.Object, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	policyObj, ok := obj.(metav1.Object)
+	if !ok {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unexpected object type %T", obj))
+	}
+	errs := policy.ValidatePolicy(policyObj)
+	if len(errs) == 0 {
+		return admission.Allowed("")
+	}
+
+	logger.Debug("rejecting invalid policy", "kind", gk.Kind, "namespace", req.Namespace, "name", req.Name, "errors", errs)
+	status := apierrors.NewInvalid(gk, req.Name, pluginsdkutils.ToFieldErrors(errs, field.NewPath("spec"))).ErrStatus
+	resp := admission.Denied(status.Message)
+	resp.Result = &status
+	return resp
+}
@@ -0,0 +1,66 @@
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
+)
+
+func TestHandler(t *testing.T) {
+	plugins := sdk.Plugin{
+		ContributesPolicies: sdk.ContributesPolicies{
+			wellknown.TrafficPolicyGVK.GroupKind(): {
+				ValidatePolicy: func(obj metav1.Object) []error {
+					if obj.GetName() == "invalid" {
+						return []error{
+							pluginsdkutils.WithFieldPath(field.NewPath("spec", "rateLimit", "local"), errors.New("tokens must be positive")),
+							errors.New("invalid policy"),
+						}
+					}
+					return nil
+				},
+			},
+		},
+	}
+	request := func(name string) admission.Request {
+		raw, err := json.Marshal(&v1alpha1.TrafficPolicy{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: wellknown.TrafficPolicyGVK.Kind},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		})
+		require.NoError(t, err)
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind(wellknown.TrafficPolicyGVK),
+			Name:      name,
+			Namespace: "default",
+			Object:    runtime.RawExtension{Raw: raw},
+		}}
+	}
+
+	h := NewHandler(schemes.DefaultScheme(), plugins)
+
+	resp := h.Handle(context.Background(), request("valid"))
+	assert.True(t, resp.Allowed)
+
+	resp = h.Handle(context.Background(), request("invalid"))
+	require.False(t, resp.Allowed)
+	assert.Equal(t, int32(http.StatusUnprocessableEntity), resp.Result.Code)
+	require.Len(t, resp.Result.Details.Causes, 2)
+	assert.Equal(t, "spec.rateLimit.local", resp.Result.Details.Causes[0].Field)
+	assert.Equal(t, "spec", resp.Result.Details.Causes[1].Field)
+}
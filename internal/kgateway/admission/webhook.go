@@ -0,0 +1,46 @@
+package admission
+
+import (
+	"crypto/tls"
+
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+)
+
+// Register adds the validating admission webhook, served with a self-managed certificate for the
+// kgateway Service, to mgr.
+func Register(
+	mgr manager.Manager,
+	client kubernetes.Interface,
+	plugins sdk.Plugin,
+	settings *apisettings.Settings,
+	namespace string,
+) error {
+	certs := NewCertManager(
+		client,
+		namespace,
+		settings.ValidationWebhookSecretName,
+		settings.ValidationWebhookConfigName,
+		settings.XdsServiceName,
+	)
+	if err := mgr.Add(certs); err != nil {
+		return err
+	}
+
+	server := webhook.NewServer(webhook.Options{
+		Port: int(settings.ValidationWebhookPort),
+		TLSOpts: []func(*tls.Config){
+			func(c *tls.Config) {
+				c.GetCertificate = certs.GetCertificate
+			},
+		},
+	})
+	server.Register(ValidatePath, &webhook.Admission{
+		Handler: NewHandler(mgr.GetScheme(), plugins),
+	})
+	return mgr.Add(server)
+}
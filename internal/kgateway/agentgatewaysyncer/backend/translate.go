@@ -116,6 +116,10 @@ func translateLLMProviderToProvider(krtctx krt.HandlerContext, llm *v1alpha1.LLM
 		logger.Warn("auth header override is not supported for agentgateway")
 	}
 
+	if len(llm.Models) > 0 {
+		return nil, nil, errors.New("models are not supported by agentgateway")
+	}
+
 	// Extract auth token and model based on provider
 	if llm.OpenAI != nil {
 		openai := &api.AIBackend_OpenAI{}
@@ -240,6 +244,9 @@ func buildAIIr(krtctx krt.HandlerContext, be *v1alpha1.Backend, secrets krt.Coll
 
 		aiBackend.ProviderGroups = append(aiBackend.ProviderGroups, providerGroup)
 	} else {
+		if be.Spec.AI.Fallback != nil {
+			return nil, errors.New("fallback is not supported by agentgateway, providers fail over to the next priority group based on their health")
+		}
 		for _, group := range be.Spec.AI.PriorityGroups {
 			providerGroup := &api.AIBackend_ProviderGroup{}
 
@@ -369,7 +376,9 @@ func buildMCPIr(krtctx krt.HandlerContext, be *v1alpha1.Backend, services krt.Co
 					},
 					Port: uint32(targetSelector.Static.Port), //nolint:gosec // G115: Port is validated by kubebuilder to be 1-65535
 				},
-				Path: ptr.Deref(targetSelector.Static.Path, ""),
+				Path:   ptr.Deref(targetSelector.Static.Path, ""),
+				Prefix: ptr.Deref(targetSelector.Prefix, ""),
+				Filter: toMCPFilter(targetSelector.Filter),
 			}
 
 			// Convert protocol if specified
@@ -477,6 +486,8 @@ func buildMCPIr(krtctx krt.HandlerContext, be *v1alpha1.Backend, services krt.Co
 						},
 						Protocol: toMCPProtocol(appProtocol),
 						Path:     service.Annotations[apiannotations.MCPServiceHTTPPath],
+						Prefix:   ptr.Deref(targetSelector.Prefix, ""),
+						Filter:   toMCPFilter(targetSelector.Filter),
 					}
 
 					mcpTargets = append(mcpTargets, mcpTarget)
@@ -525,6 +536,28 @@ func toMCPProtocol(appProtocol string) api.MCPTarget_Protocol {
 	}
 }
 
+// toMCPFilter converts the tools, prompts and resources filter of an MCP target
+func toMCPFilter(filter *v1alpha1.McpFilter) *api.MCPTarget_Filter {
+	if filter == nil {
+		return nil
+	}
+	return &api.MCPTarget_Filter{
+		Tools:     toMCPNameFilter(filter.Tools),
+		Prompts:   toMCPNameFilter(filter.Prompts),
+		Resources: toMCPNameFilter(filter.Resources),
+	}
+}
+
+func toMCPNameFilter(filter *v1alpha1.McpNameFilter) *api.MCPTarget_NameFilter {
+	if filter == nil {
+		return nil
+	}
+	return &api.MCPTarget_NameFilter{
+		Allow: filter.Allow,
+		Deny:  filter.Deny,
+	}
+}
+
 func buildBedrockAuthPolicy(krtctx krt.HandlerContext, region string, auth *v1alpha1.AwsAuth, secrets krt.Collection[*corev1.Secret], namespace string) (*api.BackendAuthPolicy, error) {
 	var errs []error
 	if auth == nil {
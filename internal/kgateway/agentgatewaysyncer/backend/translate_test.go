@@ -2,6 +2,7 @@ package agentgatewaybackend
 
 import (
 	"context"
+	"reflect"
 	"testing"
 	"time"
 
@@ -86,6 +87,57 @@ func TestBuildMCPIr(t *testing.T) {
 				return true
 			},
 		},
+		{
+			name: "Static MCP target with prefix and filter",
+			backend: &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "filtered-mcp-backend",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeMCP,
+					MCP: &v1alpha1.MCP{
+						Targets: []v1alpha1.McpTargetSelector{
+							{
+								Name: "github",
+								Static: &v1alpha1.McpTarget{
+									Host: "github-mcp.example.com",
+									Port: 8080,
+								},
+								Prefix: ptr.To("gh"),
+								Filter: &v1alpha1.McpFilter{
+									Tools: &v1alpha1.McpNameFilter{
+										Allow: []string{"list_issues", "create_issue"},
+										Deny:  []string{"create_issue"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			services:    createMockServiceCollection(t),
+			namespaces:  createMockNamespaceCollection(t),
+			expectError: false,
+			validate: func(ir *MCPIr) bool {
+				for _, backend := range ir.Backends {
+					if backend.Name != "test-ns/filtered-mcp-backend" {
+						continue
+					}
+					mcp := backend.GetMcp()
+					if mcp == nil || len(mcp.Targets) != 1 {
+						return false
+					}
+					target := mcp.Targets[0]
+					tools := target.GetFilter().GetTools()
+					return target.Prefix == "gh" &&
+						target.GetFilter().GetPrompts() == nil &&
+						reflect.DeepEqual(tools.GetAllow(), []string{"list_issues", "create_issue"}) &&
+						reflect.DeepEqual(tools.GetDeny(), []string{"create_issue"})
+				}
+				return false
+			},
+		},
 		{
 			name: "Service selector MCP backend - same namespace",
 			backend: &v1alpha1.Backend{
@@ -761,6 +813,57 @@ func TestBuildAIBackendIr(t *testing.T) {
 			secrets:     nil,
 			expectError: true,
 		},
+		{
+			// models are only supported by the envoy-based AI gateway, the Backend is not accepted rather than
+			// silently ignoring them
+			name: "Error case - models",
+			backend: &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid-backend-5",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeAI,
+					AI: &v1alpha1.AIBackend{
+						LLM: &v1alpha1.LLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{
+								AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: stringPtr("sk-test-token")},
+							},
+							Models: []v1alpha1.ModelAlias{{Name: "fast", Target: stringPtr("gpt-4o-mini")}},
+						},
+					},
+				},
+			},
+			secrets:     nil,
+			expectError: true,
+		},
+		{
+			name: "Error case - fallback",
+			backend: &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid-backend-6",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeAI,
+					AI: &v1alpha1.AIBackend{
+						PriorityGroups: []v1alpha1.PriorityGroup{{
+							Providers: []v1alpha1.NamedLLMProvider{{
+								Name: "openai",
+								LLMProvider: v1alpha1.LLMProvider{
+									OpenAI: &v1alpha1.OpenAIConfig{
+										AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: stringPtr("sk-test-token")},
+									},
+								},
+							}},
+						}},
+						Fallback: &v1alpha1.AIFallback{},
+					},
+				},
+			},
+			secrets:     nil,
+			expectError: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -78,6 +78,7 @@ type Syncer struct {
 	// Collection status reporting
 	// TODO(npolshak): report these separately from proxy_syncer backends https://github.com/kgateway-dev/kgateway/issues/11966
 	//backendStatuses krt.StatusCollection[*v1alpha1.Backend, v1alpha1.BackendStatus]
+	policyStatuses map[schema.GroupKind]krt.StatusCollection[controllers.Object, gwv1alpha2.PolicyStatus]
 
 	// Synchronization
 	waitForSync []cache.InformerSynced
@@ -147,6 +148,12 @@ func (s *Syncer) PolicyStatusQueue() *status.StatusCollections {
 	return s.policyStatusQueue
 }
 
+// PolicyStatuses returns the status collections of the policies, keyed by policy kind.
+// It must be called only after `Init()`.
+func (s *Syncer) PolicyStatuses() map[schema.GroupKind]krt.StatusCollection[controllers.Object, gwv1alpha2.PolicyStatus] {
+	return s.policyStatuses
+}
+
 func (s *Syncer) buildResourceCollections(krtopts krtutil.KrtOptions) {
 	// Build core collections for irs
 	gatewayClasses := translator.GatewayClassesCollection(s.agwCollections.GatewayClasses, krtopts)
@@ -155,6 +162,7 @@ func (s *Syncer) buildResourceCollections(krtopts krtutil.KrtOptions) {
 
 	// Build Agw resources for gateway
 	agwResources, policyStatuses := s.buildAgwResources(gateways, refGrants, krtopts)
+	s.policyStatuses = policyStatuses
 
 	// Create an agentgateway backend collection from the kgateway backend resources
 	_, agwBackends := s.newAgwBackendCollection(s.agwCollections.Backends, krtopts)
@@ -791,6 +799,12 @@ func (s *Syncer) RouteReportQueue() utils.AsyncQueue[translator.RouteReports] {
 	return s.routeReportQueue
 }
 
+// XDS returns the collection of the translated resources of each Gateway.
+// It must be called only after `Init()`.
+func (s *Syncer) XDS() krt.Collection[translator.AgentGwXdsResources] {
+	return s.xDS
+}
+
 // WaitForSync returns a list of functions that can be used to determine if all its informers have synced.
 // This is useful for determining if caches have synced.
 // It must be called only after `Init()`.
@@ -283,6 +283,31 @@ func (c *controllerBuilder) watchGw(ctx context.Context) error {
 		),
 	)
 
+	// watch for changes in HTTPRoutes and enqueue their parent Gateways, as the agentgateway
+	// deployment configures MCP session affinity when a Gateway has MCP routes
+	buildr.Watches(
+		&apiv1.HTTPRoute{},
+		handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+			route, ok := obj.(*apiv1.HTTPRoute)
+			if !ok {
+				return nil
+			}
+			var reqs []reconcile.Request
+			for _, parentRef := range route.Spec.ParentRefs {
+				if string(ptr.Deref(parentRef.Group, apiv1.GroupName)) != apiv1.GroupName ||
+					string(ptr.Deref(parentRef.Kind, wellknown.GatewayKind)) != wellknown.GatewayKind {
+					continue
+				}
+				reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{
+					Namespace: string(ptr.Deref(parentRef.Namespace, apiv1.Namespace(route.Namespace))),
+					Name:      string(parentRef.Name),
+				}})
+			}
+			return reqs
+		}),
+		builder.WithPredicates(discoveryNamespaceFilterPredicate, predicate.GenerationChangedPredicate{}),
+	)
+
 	// Trigger an event when the gateway changes. This can even be a change in listener sets attached to the gateway
 	c.cfg.CommonCollections.GatewayIndex.Gateways.Register(func(o krt.Event[ir.Gateway]) {
 		gw := o.Latest()
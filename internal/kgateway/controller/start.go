@@ -12,6 +12,7 @@ import (
 	"istio.io/istio/pkg/kube/krt"
 	istiolog "istio.io/istio/pkg/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,6 +20,7 @@ import (
 	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/admission"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/agentgatewaysyncer"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/inferenceextension/endpointpicker"
@@ -28,6 +30,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/irtranslator"
 	agwplugins "github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/plugins"
 	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
@@ -50,6 +53,20 @@ type SetupOpts struct {
 
 	KrtDebugger *krt.DebugHandler
 
+	// XdsStatus tracks the config ACKed and NACKed by the connected proxies
+	XdsStatus *krtcollections.XdsStatus
+
+	// PolicyExplorer explains the policies applied to the routes of the translated Gateways
+	PolicyExplorer *irtranslator.PolicyExplorer
+
+	// SnapshotHistory keeps the last xDS snapshots sent to each proxy
+	SnapshotHistory *proxy_syncer.SnapshotHistory
+
+	// APIReader reads objects of the kinds registered in Scheme from the API server, bypassing the cache,
+	// to collect debug bundles
+	APIReader client.Reader
+	Scheme    *runtime.Scheme
+
 	// static set of global Settings
 	GlobalSettings *apisettings.Settings
 
@@ -162,6 +179,9 @@ func NewControllerBuilder(ctx context.Context, cfg StartConfig) (*ControllerBuil
 		cfg.SetupOpts.Cache,
 		cfg.AgentgatewayClassName,
 		cfg.Validator,
+		cfg.SetupOpts.XdsStatus,
+		cfg.SetupOpts.PolicyExplorer,
+		cfg.SetupOpts.SnapshotHistory,
 	)
 	proxySyncer.Init(ctx, cfg.KrtOptions)
 	if err := cfg.Manager.Add(proxySyncer); err != nil {
@@ -244,6 +264,20 @@ func NewControllerBuilder(ctx context.Context, cfg StartConfig) (*ControllerBuil
 		setupLog.Error(err, "failed setting up healthz")
 	}
 
+	if cfg.SetupOpts.GlobalSettings.EnableValidationWebhook {
+		setupLog.Info("adding the validating admission webhook")
+		if err := admission.Register(
+			cfg.Manager,
+			cfg.Client.Kube(),
+			mergedPlugins,
+			cfg.SetupOpts.GlobalSettings,
+			namespaces.GetPodNamespace(),
+		); err != nil {
+			setupLog.Error(err, "unable to add validating admission webhook")
+			return nil, err
+		}
+	}
+
 	return cb, nil
 }
 
@@ -304,6 +338,7 @@ func (c *ControllerBuilder) Build(ctx context.Context) error {
 			XdsHost:    xdsHost,
 			XdsPort:    xdsPort,
 			AgwXdsPort: agwXdsPort,
+			XdsDelta:   globalSettings.XdsDelta,
 		},
 		IstioAutoMtlsEnabled: istioAutoMtlsEnabled,
 		ImageInfo: &deployer.ImageInfo{
@@ -0,0 +1,346 @@
+package debugbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"cmp"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sversion "k8s.io/apimachinery/pkg/version"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+	"sigs.k8s.io/yaml"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/internal/version"
+	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
+)
+
+// Names of the files of a debug bundle
+const (
+	MetadataFile  = "metadata.json"
+	SettingsFile  = "settings.json"
+	KrtFile       = "krt.json"
+	XdsFile       = "xds.json"
+	ResourcesFile = "resources.yaml"
+)
+
+// RedactedValue replaces the data of the Secrets and the inline auth tokens of a bundle
+const RedactedValue = "[REDACTED]"
+
+// lastAppliedAnnotation holds a copy of the applied object, including the data of Secrets
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// resourceGroups are the groups whose kinds are all included in a bundle
+var resourceGroups = []string{
+	v1alpha1.GroupName,
+	gwv1.GroupName,
+	gwxv1a1.GroupName,
+	inf.GroupName,
+}
+
+// coreKinds are the core kinds referenced by Gateways and routes that are included in a bundle
+var coreKinds = []schema.GroupVersionKind{
+	corev1.SchemeGroupVersion.WithKind("Service"),
+	corev1.SchemeGroupVersion.WithKind("Secret"),
+}
+
+// Sources are the state of the controller collected in a debug bundle
+type Sources struct {
+	// Reader lists the objects of the cluster. It should read from the API server rather than a cache, so
+	// that kinds the controller does not watch are not cached.
+	Reader client.Reader
+	// Scheme has the kinds known to Reader. Kinds of the bundle that are not registered in it are skipped.
+	// Defaults to the scheme of the offline translator.
+	Scheme      *runtime.Scheme
+	KrtDebugger *krt.DebugHandler
+	Cache       envoycache.SnapshotCache
+	Settings    *apisettings.Settings
+}
+
+// Metadata describes a debug bundle
+type Metadata struct {
+	Version   string    `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Errors are the parts of the state that could not be collected
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Write writes a gzipped tar archive with the state of the controller to w: its version and settings, the
+// krt and xDS snapshots, and the kgateway, Gateway API, Service and Secret objects of the cluster with their
+// statuses. The data of Secrets, the inline auth tokens of AI Backends and the sensitive fields of the xDS resources are redacted.
+//
+// The objects are written to ResourcesFile as a multi-document YAML file, so that the archive can be loaded
+// back by the offline translator. As Secrets are redacted, listeners using them are rejected offline.
+func Write(ctx context.Context, w io.Writer, src Sources) error {
+	md := Metadata{
+		Version:   version.String(),
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+
+	files := map[string][]byte{}
+	addJSON := func(name string, v any) {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			md.Errors = append(md.Errors, fmt.Sprintf("%s: %v", name, err))
+			return
+		}
+		files[name] = data
+	}
+
+	addJSON(SettingsFile, src.Settings)
+	if src.KrtDebugger != nil {
+		addJSON(KrtFile, src.KrtDebugger)
+	}
+	if src.Cache != nil {
+		addJSON(XdsFile, xdsSnapshots(src.Cache))
+	}
+	if src.Reader != nil {
+		scheme := src.Scheme
+		if scheme == nil {
+			scheme = schemes.InferExtScheme()
+		}
+		objs, errs := listObjects(ctx, src.Reader, scheme)
+		for _, err := range errs {
+			md.Errors = append(md.Errors, err.Error())
+		}
+		sort.Strings(md.Errors)
+		data, err := marshalObjects(objs)
+		if err != nil {
+			md.Errors = append(md.Errors, fmt.Sprintf("%s: %v", ResourcesFile, err))
+		} else {
+			files[ResourcesFile] = data
+		}
+	}
+	addJSON(MetadataFile, md)
+
+	gz := gzip.NewWriter(w)
+	gz.ModTime = md.CreatedAt
+	tw := tar.NewWriter(gz)
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0o644,
+			Size:    int64(len(files[name])),
+			ModTime: md.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// xdsSnapshots returns the redacted xDS snapshot of each proxy of the cache
+func xdsSnapshots(cache envoycache.SnapshotCache) map[string]any {
+	out := map[string]any{}
+	for _, key := range cache.GetStatusKeys() {
+		snap, err := cache.GetSnapshot(key)
+		if err != nil {
+			out[key] = err.Error()
+			continue
+		}
+		envoySnap, ok := snap.(*envoycache.Snapshot)
+		if !ok {
+			out[key] = fmt.Sprintf("snapshot of type %T is not included", snap)
+			continue
+		}
+		redacted, err := proxy_syncer.RedactedSnapshot(envoySnap)
+		if err != nil {
+			out[key] = err.Error()
+			continue
+		}
+		out[key] = redacted
+	}
+	return out
+}
+
+// listObjects lists the objects of the kinds included in a bundle, using the most recent version of each
+// kind served by the cluster. Kinds whose CRD is not installed are skipped.
+func listObjects(ctx context.Context, reader client.Reader, scheme *runtime.Scheme) ([]client.Object, []error) {
+	var (
+		objs []client.Object
+		errs []error
+	)
+	for _, versions := range bundleKinds(scheme) {
+		for _, gvk := range versions {
+			list, err := scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("listing %s: %w", gvk, err))
+				break
+			}
+			objList, ok := list.(client.ObjectList)
+			if !ok {
+				break
+			}
+			err = reader.List(ctx, objList)
+			if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+				// try the previous version, or skip the kind if none is served
+				continue
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("listing %s: %w", gvk, err))
+				break
+			}
+			items, err := meta.ExtractList(objList)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("listing %s: %w", gvk, err))
+				break
+			}
+			for _, item := range items {
+				obj, ok := item.(client.Object)
+				if !ok {
+					continue
+				}
+				obj.GetObjectKind().SetGroupVersionKind(gvk)
+				objs = append(objs, sanitize(obj))
+			}
+			break
+		}
+	}
+
+	slices.SortFunc(objs, func(a, b client.Object) int {
+		aGvk, bGvk := a.GetObjectKind().GroupVersionKind(), b.GetObjectKind().GroupVersionKind()
+		return cmp.Or(
+			strings.Compare(aGvk.Group, bGvk.Group),
+			strings.Compare(aGvk.Kind, bGvk.Kind),
+			strings.Compare(a.GetNamespace(), b.GetNamespace()),
+			strings.Compare(a.GetName(), b.GetName()),
+		)
+	})
+	return objs, errs
+}
+
+// bundleKinds returns the versions of each kind included in a bundle, most recent first
+func bundleKinds(scheme *runtime.Scheme) map[schema.GroupKind][]schema.GroupVersionKind {
+	kinds := map[schema.GroupKind][]schema.GroupVersionKind{}
+	for gvk := range scheme.AllKnownTypes() {
+		if !slices.Contains(resourceGroups, gvk.Group) || strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		// only kinds with a list are resources, the others are options and events
+		if !scheme.Recognizes(gvk.GroupVersion().WithKind(gvk.Kind + "List")) {
+			continue
+		}
+		kinds[gvk.GroupKind()] = append(kinds[gvk.GroupKind()], gvk)
+	}
+	for _, gvk := range coreKinds {
+		if scheme.Recognizes(gvk) {
+			kinds[gvk.GroupKind()] = []schema.GroupVersionKind{gvk}
+		}
+	}
+	for _, versions := range kinds {
+		slices.SortFunc(versions, func(a, b schema.GroupVersionKind) int {
+			return k8sversion.CompareKubeAwareVersionStrings(b.Version, a.Version)
+		})
+	}
+	return kinds
+}
+
+// sanitize drops the managed fields and resource version of an object, which are not needed to load it back,
+// and redacts the data of Secrets and the inline auth tokens of AI Backends
+func sanitize(obj client.Object) client.Object {
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		for k := range o.Data {
+			o.Data[k] = []byte(RedactedValue)
+		}
+		for k := range o.StringData {
+			o.StringData[k] = RedactedValue
+		}
+	case *v1alpha1.Backend:
+		if o.Spec.AI == nil {
+			return obj
+		}
+		redactLLMProvider(o.Spec.AI.LLM)
+		for i := range o.Spec.AI.PriorityGroups {
+			for j := range o.Spec.AI.PriorityGroups[i].Providers {
+				redactLLMProvider(&o.Spec.AI.PriorityGroups[i].Providers[j].LLMProvider)
+			}
+		}
+	default:
+		return obj
+	}
+	annotations := obj.GetAnnotations()
+	delete(annotations, lastAppliedAnnotation)
+	obj.SetAnnotations(annotations)
+	return obj
+}
+
+// redactLLMProvider redacts the inline auth token of an LLM provider
+func redactLLMProvider(llm *v1alpha1.LLMProvider) {
+	if llm == nil {
+		return
+	}
+	var tokens []*v1alpha1.SingleAuthToken
+	if llm.OpenAI != nil {
+		tokens = append(tokens, &llm.OpenAI.AuthToken)
+	}
+	if llm.AzureOpenAI != nil {
+		tokens = append(tokens, &llm.AzureOpenAI.AuthToken)
+	}
+	if llm.Anthropic != nil {
+		tokens = append(tokens, &llm.Anthropic.AuthToken)
+	}
+	if llm.Gemini != nil {
+		tokens = append(tokens, &llm.Gemini.AuthToken)
+	}
+	if llm.VertexAI != nil {
+		tokens = append(tokens, &llm.VertexAI.AuthToken)
+	}
+	for _, token := range tokens {
+		if token.Inline != nil {
+			token.Inline = ptr.To(RedactedValue)
+		}
+	}
+}
+
+func marshalObjects(objs []client.Object) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, obj := range objs {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			gvk := obj.GetObjectKind().GroupVersionKind()
+			return nil, fmt.Errorf("%s %s/%s: %w", gvk.Kind, obj.GetNamespace(), obj.GetName(), err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,279 @@
+package debugbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyextprocv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/server/stream/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
+)
+
+func TestWrite(t *testing.T) {
+	ctx := context.Background()
+
+	cli := fake.NewClientBuilder().WithScheme(schemes.InferExtScheme()).WithObjects(
+		&gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "kgateway"},
+			Status: gwv1.GatewayStatus{Conditions: []metav1.Condition{{
+				Type:   string(gwv1.GatewayConditionAccepted),
+				Status: metav1.ConditionTrue,
+				Reason: string(gwv1.GatewayReasonAccepted),
+			}}},
+		},
+		&v1alpha1.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "cert",
+				Namespace:   "default",
+				Annotations: map[string]string{lastAppliedAnnotation: `{"data":{"tls.key":"c2VjcmV0"}}`, "team": "a"},
+			},
+			Data: map[string][]byte{"tls.key": []byte("secret")},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "ignored", Namespace: "default"},
+		},
+	).Build()
+
+	tlsContext, err := anypb.New(&envoytlsv3.UpstreamTlsContext{
+		CommonTlsContext: &envoytlsv3.CommonTlsContext{
+			TlsCertificates: []*envoytlsv3.TlsCertificate{{
+				PrivateKey: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_InlineString{InlineString: "private-key"},
+				},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	snap, err := envoycache.NewSnapshot("1", map[string][]envoycachetypes.Resource{
+		resource.ClusterType: {&envoyclusterv3.Cluster{
+			Name: "backend",
+			TransportSocket: &envoycorev3.TransportSocket{
+				Name:       "tls",
+				ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: tlsContext},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	cache := envoycache.NewSnapshotCache(false, envoycache.IDHash{}, nil)
+	require.NoError(t, cache.SetSnapshot(ctx, "proxy", snap))
+	// the cache only lists the proxies that requested their config
+	cancelWatch := cache.CreateWatch(&envoycache.Request{
+		Node:    &envoycorev3.Node{Id: "proxy"},
+		TypeUrl: resource.ClusterType,
+	}, stream.NewStreamState(false, nil), make(chan envoycache.Response, 1))
+	defer cancelWatch()
+
+	var buf bytes.Buffer
+	err = Write(ctx, &buf, Sources{
+		Reader:   cli,
+		Cache:    cache,
+		Settings: &apisettings.Settings{XdsSnapshotHistorySize: 10},
+	})
+	require.NoError(t, err)
+
+	files := readBundle(t, &buf)
+	assert.ElementsMatch(t, []string{MetadataFile, SettingsFile, XdsFile, ResourcesFile}, keys(files))
+
+	var md Metadata
+	require.NoError(t, json.Unmarshal(files[MetadataFile], &md))
+	assert.NotEmpty(t, md.Version)
+	assert.Empty(t, md.Errors)
+
+	assert.Contains(t, string(files[SettingsFile]), `"XdsSnapshotHistorySize": 10`)
+
+	xds := string(files[XdsFile])
+	assert.Contains(t, xds, `"backend"`)
+	assert.NotContains(t, xds, "private-key")
+
+	resources := string(files[ResourcesFile])
+	docs := strings.Split(resources, "---\n")
+	require.Len(t, docs, 3)
+	// objects are sorted by group, kind, namespace and name
+	assert.Contains(t, docs[0], "kind: Secret")
+	assert.Contains(t, docs[1], "kind: TrafficPolicy")
+	assert.Contains(t, docs[2], "kind: Gateway")
+	assert.Contains(t, docs[2], "reason: Accepted")
+	assert.NotContains(t, resources, "resourceVersion")
+	assert.NotContains(t, resources, "c2VjcmV0")
+	assert.NotContains(t, resources, lastAppliedAnnotation)
+	assert.Contains(t, docs[0], "team: a")
+}
+
+func TestWriteRedactsAIAuthTokens(t *testing.T) {
+	ctx := context.Background()
+	const token = "sk-plaintext-token"
+
+	openai := func(token string) v1alpha1.LLMProvider {
+		return v1alpha1.LLMProvider{OpenAI: &v1alpha1.OpenAIConfig{AuthToken: v1alpha1.SingleAuthToken{
+			Kind:   v1alpha1.Inline,
+			Inline: ptr.To(token),
+		}}}
+	}
+	cli := fake.NewClientBuilder().WithScheme(schemes.InferExtScheme()).WithObjects(
+		&v1alpha1.Backend{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "llm",
+				Namespace:   "default",
+				Annotations: map[string]string{lastAppliedAnnotation: `{"spec":{"ai":{"llm":{"openai":{"authToken":{"inline":"` + token + `"}}}}}}`},
+			},
+			Spec: v1alpha1.BackendSpec{
+				Type: v1alpha1.BackendTypeAI,
+				AI: &v1alpha1.AIBackend{
+					LLM: ptr.To(openai(token)),
+				},
+			},
+		},
+		&v1alpha1.Backend{
+			ObjectMeta: metav1.ObjectMeta{Name: "llm-groups", Namespace: "default"},
+			Spec: v1alpha1.BackendSpec{
+				Type: v1alpha1.BackendTypeAI,
+				AI: &v1alpha1.AIBackend{
+					PriorityGroups: []v1alpha1.PriorityGroup{{
+						Providers: []v1alpha1.NamedLLMProvider{{Name: "openai", LLMProvider: openai(token)}},
+					}},
+				},
+			},
+		},
+	).Build()
+
+	extProc, err := anypb.New(&envoyextprocv3.ExtProcPerRoute{
+		Override: &envoyextprocv3.ExtProcPerRoute_Overrides{Overrides: &envoyextprocv3.ExtProcOverrides{
+			GrpcInitialMetadata: []*envoycorev3.HeaderValue{{Key: "x-cache-config", Value: `{"token":"` + token + `"}`}},
+		}},
+	})
+	require.NoError(t, err)
+	snap, err := envoycache.NewSnapshot("1", map[string][]envoycachetypes.Resource{
+		resource.ClusterType: {&envoyclusterv3.Cluster{
+			Name: "backend_default_llm_0",
+			LoadAssignment: &envoyendpointv3.ClusterLoadAssignment{
+				Endpoints: []*envoyendpointv3.LocalityLbEndpoints{{
+					LbEndpoints: []*envoyendpointv3.LbEndpoint{{
+						Metadata: &envoycorev3.Metadata{FilterMetadata: map[string]*structpb.Struct{
+							"io.solo.transformation": {Fields: map[string]*structpb.Value{
+								"auth_token": structpb.NewStringValue(token),
+								"model":      structpb.NewStringValue("gpt-4o"),
+							}},
+						}},
+					}},
+				}},
+			},
+		}},
+		resource.RouteType: {&envoyroutev3.RouteConfiguration{
+			Name: "listener~80",
+			VirtualHosts: []*envoyroutev3.VirtualHost{{
+				Name:    "llm",
+				Domains: []string{"*"},
+				Routes: []*envoyroutev3.Route{{
+					Name:                 "llm-route",
+					TypedPerFilterConfig: map[string]*anypb.Any{"envoy.filters.http.ext_proc": extProc},
+				}},
+			}},
+		}},
+	})
+	require.NoError(t, err)
+	cache := envoycache.NewSnapshotCache(false, envoycache.IDHash{}, nil)
+	require.NoError(t, cache.SetSnapshot(ctx, "proxy", snap))
+	cancelWatch := cache.CreateWatch(&envoycache.Request{
+		Node:    &envoycorev3.Node{Id: "proxy"},
+		TypeUrl: resource.ClusterType,
+	}, stream.NewStreamState(false, nil), make(chan envoycache.Response, 1))
+	defer cancelWatch()
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(ctx, &buf, Sources{Reader: cli, Cache: cache}))
+
+	files := readBundle(t, &buf)
+	for name, data := range files {
+		assert.NotContains(t, string(data), token, name)
+	}
+	assert.Contains(t, string(files[XdsFile]), `"model": "gpt-4o"`)
+	assert.Contains(t, string(files[XdsFile]), "llm-route")
+	assert.Equal(t, 2, strings.Count(string(files[ResourcesFile]), RedactedValue))
+}
+
+func TestWriteReportsErrors(t *testing.T) {
+	cli := fake.NewClientBuilder().WithScheme(schemes.InferExtScheme()).WithInterceptorFuncs(interceptor.Funcs{
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			if _, ok := list.(*corev1.SecretList); ok {
+				return apierrors.NewForbidden(corev1.Resource("secrets"), "", errors.New("denied"))
+			}
+			return c.List(ctx, list, opts...)
+		},
+	}).Build()
+
+	var buf bytes.Buffer
+	err := Write(context.Background(), &buf, Sources{Reader: cli})
+	require.NoError(t, err)
+
+	// the other kinds are still collected
+	files := readBundle(t, &buf)
+	assert.Contains(t, files, ResourcesFile)
+	var md Metadata
+	require.NoError(t, json.Unmarshal(files[MetadataFile], &md))
+	require.Len(t, md.Errors, 1)
+	assert.Contains(t, md.Errors[0], "listing /v1, Kind=Secret")
+	assert.Contains(t, md.Errors[0], "forbidden")
+}
+
+func readBundle(t *testing.T, r io.Reader) map[string][]byte {
+	t.Helper()
+	gz, err := gzip.NewReader(r)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := map[string][]byte{}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		assert.False(t, hdr.ModTime.After(time.Now()))
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = data
+	}
+	return files
+}
+
+func keys(m map[string][]byte) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/helm"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
+	"github.com/kgateway-dev/kgateway/v2/pkg/xds/bootstrap"
 )
 
 // ErrNoValidPorts is returned when no valid ports are found for the Gateway
@@ -214,7 +215,7 @@ func (h *kGatewayParameters) GetValues(ctx context.Context, obj client.Object) (
 	if gwParam != nil && gwParam.Spec.SelfManaged != nil {
 		return nil, nil
 	}
-	vals, err := h.getValues(gw, gwParam)
+	vals, err := h.getValues(ctx, gw, gwParam)
 	if err != nil {
 		return nil, err
 	}
@@ -329,7 +330,7 @@ func (k *kGatewayParameters) getGatewayParametersForGatewayClass(ctx context.Con
 	return mergedGwp, nil
 }
 
-func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.GatewayParameters) (*deployer.HelmConfig, error) {
+func (k *kGatewayParameters) getValues(ctx context.Context, gw *api.Gateway, gwParam *v1alpha1.GatewayParameters) (*deployer.HelmConfig, error) {
 	irGW := deployer.GetGatewayIR(gw, k.inputs.CommonCollections)
 
 	ports := deployer.GetPortsValues(irGW, gwParam)
@@ -348,8 +349,9 @@ func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.Gatewa
 			Xds: &deployer.HelmXds{
 				// The xds host/port MUST map to the Service definition for the Control Plane
 				// This is the socket address that the Proxy will connect to on startup, to receive xds updates
-				Host: &k.inputs.ControlPlane.XdsHost,
-				Port: &k.inputs.ControlPlane.XdsPort,
+				Host:    &k.inputs.ControlPlane.XdsHost,
+				Port:    &k.inputs.ControlPlane.XdsPort,
+				ApiType: ptr.To(bootstrap.AdsApiType(k.inputs.ControlPlane.XdsDelta).String()),
 			},
 			AgwXds: &deployer.HelmXds{
 				// The agentgateway xds host/port MUST map to the Service definition for the Control Plane
@@ -470,6 +472,15 @@ func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.Gatewa
 
 	gateway.Stats = deployer.GetStatsValues(statsConfig)
 
+	// mcp session affinity values
+	mcpSessionAffinity, err := k.shouldConfigureMcpSessionAffinity(ctx, gw, gwParam)
+	if err != nil {
+		return nil, err
+	}
+	if mcpSessionAffinity {
+		applyMcpSessionAffinity(gateway, agwConfig.GetMcpSessionAffinity())
+	}
+
 	return vals, nil
 }
 
@@ -398,6 +398,150 @@ func TestAgentgatewayAndEnvoyContainerDistinctValues(t *testing.T) {
 	assert.Equal(t, "envoy-value", envVar["value"])
 }
 
+func TestMcpSessionAffinity(t *testing.T) {
+	mcpRoute := &api.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "mcp", Namespace: "default"},
+		Spec: api.HTTPRouteSpec{
+			CommonRouteSpec: api.CommonRouteSpec{ParentRefs: []api.ParentReference{{Name: "agw"}}},
+			Rules: []api.HTTPRouteRule{{BackendRefs: []api.HTTPBackendRef{{BackendRef: api.BackendRef{
+				BackendObjectReference: api.BackendObjectReference{
+					Group: ptr.To(api.Group(gw2_v1alpha1.GroupName)),
+					Kind:  ptr.To(api.Kind(wellknown.BackendGVK.Kind)),
+					Name:  "mcp-backend",
+				},
+			}}}}},
+		},
+	}
+	mcpBackend := &gw2_v1alpha1.Backend{
+		ObjectMeta: metav1.ObjectMeta{Name: "mcp-backend", Namespace: "default"},
+		Spec: gw2_v1alpha1.BackendSpec{
+			Type: gw2_v1alpha1.BackendTypeMCP,
+			MCP:  &gw2_v1alpha1.MCP{},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		replicas int32
+		affinity *gw2_v1alpha1.McpSessionAffinity
+		objs     []client.Object
+		check    func(t *testing.T, gateway map[string]any)
+	}{
+		{
+			name:     "client ip affinity with mcp routes and replicas",
+			replicas: 2,
+			objs:     []client.Object{mcpRoute, mcpBackend},
+			check: func(t *testing.T, gateway map[string]any) {
+				service := gateway["service"].(map[string]any)
+				assert.Equal(t, "ClientIP", service["sessionAffinity"])
+				assert.Equal(t, float64(10800), service["sessionAffinityTimeoutSeconds"])
+			},
+		},
+		{
+			name:     "self-describing session ids with a session key",
+			replicas: 2,
+			affinity: &gw2_v1alpha1.McpSessionAffinity{
+				SessionKeySecretRef: &corev1.LocalObjectReference{Name: "mcp-session"},
+			},
+			objs: []client.Object{mcpRoute, mcpBackend},
+			check: func(t *testing.T, gateway map[string]any) {
+				service := gateway["service"].(map[string]any)
+				assert.NotContains(t, service, "sessionAffinity")
+				env := gateway["env"].([]any)
+				assert.Len(t, env, 1)
+				envVar := env[0].(map[string]any)
+				assert.Equal(t, "MCP_SESSION_KEY", envVar["name"])
+				secretRef := envVar["valueFrom"].(map[string]any)["secretKeyRef"].(map[string]any)
+				assert.Equal(t, "mcp-session", secretRef["name"])
+				assert.Equal(t, "session-key", secretRef["key"])
+			},
+		},
+		{
+			name:     "no affinity with a single replica",
+			replicas: 1,
+			objs:     []client.Object{mcpRoute, mcpBackend},
+			check: func(t *testing.T, gateway map[string]any) {
+				assert.NotContains(t, gateway["service"].(map[string]any), "sessionAffinity")
+			},
+		},
+		{
+			name:     "no affinity without mcp routes",
+			replicas: 2,
+			check: func(t *testing.T, gateway map[string]any) {
+				assert.NotContains(t, gateway["service"].(map[string]any), "sessionAffinity")
+			},
+		},
+		{
+			name:     "affinity disabled",
+			replicas: 2,
+			affinity: &gw2_v1alpha1.McpSessionAffinity{Mode: ptr.To(gw2_v1alpha1.McpSessionAffinityModeDisabled)},
+			objs:     []client.Object{mcpRoute, mcpBackend},
+			check: func(t *testing.T, gateway map[string]any) {
+				assert.NotContains(t, gateway["service"].(map[string]any), "sessionAffinity")
+			},
+		},
+		{
+			name:     "affinity enabled",
+			replicas: 1,
+			affinity: &gw2_v1alpha1.McpSessionAffinity{
+				Mode:                   ptr.To(gw2_v1alpha1.McpSessionAffinityModeEnabled),
+				ClientIPTimeoutSeconds: ptr.To(int32(600)),
+			},
+			check: func(t *testing.T, gateway map[string]any) {
+				service := gateway["service"].(map[string]any)
+				assert.Equal(t, "ClientIP", service["sessionAffinity"])
+				assert.Equal(t, float64(600), service["sessionAffinityTimeoutSeconds"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gwParams := &gw2_v1alpha1.GatewayParameters{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       wellknown.GatewayParametersGVK.Kind,
+					APIVersion: gw2_v1alpha1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{Name: "agw-params", Namespace: "default"},
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						Deployment: &gw2_v1alpha1.ProxyDeployment{Replicas: ptr.To(tt.replicas)},
+						Agentgateway: &gw2_v1alpha1.Agentgateway{
+							Enabled:            ptr.To(true),
+							McpSessionAffinity: tt.affinity,
+						},
+					},
+				},
+			}
+			gwc := &api.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: wellknown.DefaultAgwClassName},
+				Spec: api.GatewayClassSpec{
+					ControllerName: wellknown.DefaultAgwControllerName,
+					ParametersRef: &api.ParametersReference{
+						Group:     gw2_v1alpha1.GroupName,
+						Kind:      api.Kind(wellknown.GatewayParametersGVK.Kind),
+						Name:      "agw-params",
+						Namespace: ptr.To(api.Namespace("default")),
+					},
+				},
+			}
+			gw := &api.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "agw", Namespace: "default", UID: "agw"},
+				Spec: api.GatewaySpec{
+					GatewayClassName: wellknown.DefaultAgwClassName,
+					Listeners:        []api.Listener{{Name: "http", Port: 80}},
+				},
+			}
+
+			objs := append([]client.Object{gwc, gwParams, gw}, tt.objs...)
+			gwp := NewGatewayParameters(newFakeClientWithObjs(objs...), defaultInputs(t, gwc, gw))
+			vals, err := gwp.GetValues(context.Background(), gw)
+			assert.NoError(t, err)
+			tt.check(t, vals["gateway"].(map[string]any))
+		})
+	}
+}
+
 func defaultGatewayClass() *api.GatewayClass {
 	return &api.GatewayClass{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,140 @@
+package deployer
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	api "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
+)
+
+const (
+	// mcpSessionKeyEnv is the environment variable agentgateway reads the key used to encrypt
+	// self-describing MCP session IDs from
+	mcpSessionKeyEnv = "MCP_SESSION_KEY"
+	// mcpSessionKeySecretKey is the entry of the session key Secret holding the key
+	mcpSessionKeySecretKey = "session-key"
+
+	defaultMcpSessionAffinityTimeoutSeconds int32 = 10800
+)
+
+// mcpAppProtocols are the Service appProtocols of MCP servers
+var mcpAppProtocols = map[string]bool{
+	"kgateway.dev/mcp":     true,
+	"kgateway.dev/mcp-sse": true,
+}
+
+// shouldConfigureMcpSessionAffinity returns whether MCP session affinity must be configured for the
+// agentgateway deployment of the Gateway.
+func (k *kGatewayParameters) shouldConfigureMcpSessionAffinity(ctx context.Context, gw *api.Gateway, gwParam *v1alpha1.GatewayParameters) (bool, error) {
+	agwConfig := gwParam.Spec.Kube.GetAgentgateway()
+	if !ptr.Deref(agwConfig.GetEnabled(), false) {
+		return false, nil
+	}
+	switch ptr.Deref(agwConfig.GetMcpSessionAffinity().GetMode(), v1alpha1.McpSessionAffinityModeAuto) {
+	case v1alpha1.McpSessionAffinityModeEnabled:
+		return true, nil
+	case v1alpha1.McpSessionAffinityModeDisabled:
+		return false, nil
+	}
+
+	// in Auto mode, affinity is only needed when sessions may land on another replica
+	deployConfig := gwParam.Spec.Kube.GetDeployment()
+	if !ptr.Deref(deployConfig.GetOmitReplicas(), false) && ptr.Deref(deployConfig.GetReplicas(), 1) <= 1 {
+		return false, nil
+	}
+	return gatewayHasMCPRoutes(ctx, k.cli, gw)
+}
+
+// applyMcpSessionAffinity configures the helm values of the Gateway for MCP session affinity. Self-describing
+// session IDs are used when a shared session key is configured, otherwise clients are pinned to a replica by the
+// Service of the Gateway.
+func applyMcpSessionAffinity(gateway *deployer.HelmGateway, config *v1alpha1.McpSessionAffinity) {
+	if secretRef := config.GetSessionKeySecretRef(); secretRef != nil {
+		gateway.Env = append(gateway.Env, corev1.EnvVar{
+			Name: mcpSessionKeyEnv,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: *secretRef,
+					Key:                  mcpSessionKeySecretKey,
+				},
+			},
+		})
+		return
+	}
+
+	gateway.Service.SessionAffinity = ptr.To(string(corev1.ServiceAffinityClientIP))
+	gateway.Service.SessionAffinityTimeoutSeconds = ptr.To(ptr.Deref(config.GetClientIPTimeoutSeconds(), defaultMcpSessionAffinityTimeoutSeconds))
+}
+
+// gatewayHasMCPRoutes returns whether an HTTPRoute attached to the Gateway routes to an MCP Backend
+// or to a Service port exposing an MCP server.
+func gatewayHasMCPRoutes(ctx context.Context, cli client.Client, gw *api.Gateway) (bool, error) {
+	var routes api.HTTPRouteList
+	if err := cli.List(ctx, &routes); err != nil {
+		return false, err
+	}
+	for _, route := range routes.Items {
+		if !routeReferencesGateway(&route, gw) {
+			continue
+		}
+		for _, rule := range route.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				isMCP, err := isMCPBackendRef(ctx, cli, route.Namespace, ref.BackendObjectReference)
+				if err != nil {
+					return false, err
+				}
+				if isMCP {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+func routeReferencesGateway(route *api.HTTPRoute, gw *api.Gateway) bool {
+	for _, parentRef := range route.Spec.ParentRefs {
+		if string(ptr.Deref(parentRef.Group, api.GroupName)) != api.GroupName ||
+			string(ptr.Deref(parentRef.Kind, wellknown.GatewayKind)) != wellknown.GatewayKind {
+			continue
+		}
+		if string(parentRef.Name) == gw.Name && string(ptr.Deref(parentRef.Namespace, api.Namespace(route.Namespace))) == gw.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func isMCPBackendRef(ctx context.Context, cli client.Client, routeNamespace string, ref api.BackendObjectReference) (bool, error) {
+	key := client.ObjectKey{
+		Namespace: string(ptr.Deref(ref.Namespace, api.Namespace(routeNamespace))),
+		Name:      string(ref.Name),
+	}
+	group := string(ptr.Deref(ref.Group, ""))
+	kind := string(ptr.Deref(ref.Kind, wellknown.ServiceKind))
+	switch {
+	case group == wellknown.BackendGVK.Group && kind == wellknown.BackendGVK.Kind:
+		var backend v1alpha1.Backend
+		if err := cli.Get(ctx, key, &backend); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return backend.Spec.Type == v1alpha1.BackendTypeMCP, nil
+	case group == "" && kind == wellknown.ServiceKind:
+		var svc corev1.Service
+		if err := cli.Get(ctx, key, &svc); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		for _, port := range svc.Spec.Ports {
+			if (ref.Port == nil || int32(*ref.Port) == port.Port) && mcpAppProtocols[ptr.Deref(port.AppProtocol, "")] {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -3,23 +3,36 @@ package ai
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
 	"os"
+	"slices"
+	"strings"
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	previouspriorities "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/priority/previous_priorities/v3"
 	envoytransformation "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/trafficpolicy"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
+const (
+	previousPrioritiesRetryName = "envoy.retry_priorities.previous_priorities"
+)
+
+// defaultFallbackStatusCodes are the status codes that trigger a fallback when none are configured.
+var defaultFallbackStatusCodes = []int32{429, 500, 502, 503, 504}
+
 // TODO: envoy-based AI gateway is deprecated in v2.1 and will be removed in v2.2. The files in this folder (and any associated tests) can be removed in v2.2.
 
 // IR is the internal representation of an AI backend.
@@ -28,6 +41,8 @@ type IR struct {
 	AIMultiSecret  map[string]*ir.Secret
 	Transformation *envoytransformation.RouteTransformations
 	Extproc        *envoy_ext_proc_v3.ExtProcPerRoute
+	// RetryPolicy falls back to the next priority group of the backend
+	RetryPolicy *envoyroutev3.RetryPolicy
 }
 
 func (i *IR) Equals(otherAIIr *IR) bool {
@@ -55,6 +70,9 @@ func (i *IR) Equals(otherAIIr *IR) bool {
 		if !proto.Equal(i.Transformation, otherAIIr.Transformation) {
 			return false
 		}
+		if !proto.Equal(i.RetryPolicy, otherAIIr.RetryPolicy) {
+			return false
+		}
 	}
 	return true
 }
@@ -76,6 +94,14 @@ func ApplyAIBackend(ir *IR, pCtx *ir.RouteBackendContext, out *envoyroutev3.Rout
 		routeExtprocSettings := trafficpolicyExtprocSettingsProto.(*envoy_ext_proc_v3.ExtProcPerRoute)
 		copyBackendExtproc.GetOverrides().GrpcInitialMetadata = append(copyBackendExtproc.GetOverrides().GetGrpcInitialMetadata(), routeExtprocSettings.GetOverrides().GetGrpcInitialMetadata()...)
 	}
+	if pCtx.Backend != nil {
+		// The backend is used to attribute the usage and cost of the request in the AI extension metrics
+		copyBackendExtproc.GetOverrides().GrpcInitialMetadata = append(copyBackendExtproc.GetOverrides().GetGrpcInitialMetadata(),
+			&envoycorev3.HeaderValue{
+				Key:   "x-ai-backend",
+				Value: pCtx.Backend.Namespace + "/" + pCtx.Backend.Name,
+			})
+	}
 	pCtx.TypedFilterConfig.AddTypedConfig(wellknown.AIExtProcFilterName, copyBackendExtproc)
 
 	// Add things which require basic AI backend.
@@ -90,6 +116,11 @@ func ApplyAIBackend(ir *IR, pCtx *ir.RouteBackendContext, out *envoyroutev3.Rout
 		AutoHostRewrite: wrapperspb.Bool(true),
 	}
 
+	// A retry policy configured on the route takes precedence over the backend fallback
+	if ir.RetryPolicy != nil && out.GetRoute().GetRetryPolicy() == nil {
+		out.GetRoute().RetryPolicy = proto.Clone(ir.RetryPolicy).(*envoyroutev3.RetryPolicy)
+	}
+
 	return nil
 }
 
@@ -120,8 +151,11 @@ func PreprocessAIBackend(ctx context.Context, aiBackend *v1alpha1.AIBackend, ir
 		}
 	}
 
+	// Requests are transformed to the schema of a single provider type, so mixing provider types is rejected
+	// and reported in the status of the Backend rather than routing requests to a provider that cannot serve them.
 	if len(byType) != 1 {
-		return fmt.Errorf("multiple AI backend types found for single ai route %+v", byType)
+		return fmt.Errorf("providers of different types cannot be mixed in a Backend with the envoy-based AI gateway, got %s: use agentgateway for mixed-provider routing",
+			strings.Join(slices.Sorted(maps.Keys(byType)), ", "))
 	}
 
 	// This is only len(1)
@@ -169,6 +203,49 @@ func PreprocessAIBackend(ctx context.Context, aiBackend *v1alpha1.AIBackend, ir
 			})
 	}
 
+	routeByModel, err := HasModelRouting(aiBackend)
+	if err != nil {
+		return err
+	}
+	if routeByModel {
+		// The AI extension needs the full request body to select the providers serving the requested model
+		// before the upstream host is selected.
+		bin, err := json.Marshal(modelNames(aiBackend))
+		if err != nil {
+			return err
+		}
+		extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(),
+			&envoycorev3.HeaderValue{
+				Key:   "x-llm-models",
+				Value: string(bin),
+			})
+		extProcRouteSettings.GetOverrides().ProcessingMode = &envoy_ext_proc_v3.ProcessingMode{
+			RequestHeaderMode:   envoy_ext_proc_v3.ProcessingMode_SEND,
+			RequestBodyMode:     envoy_ext_proc_v3.ProcessingMode_BUFFERED,
+			RequestTrailerMode:  envoy_ext_proc_v3.ProcessingMode_SKIP,
+			ResponseHeaderMode:  envoy_ext_proc_v3.ProcessingMode_SEND,
+			ResponseBodyMode:    envoy_ext_proc_v3.ProcessingMode_STREAMED,
+			ResponseTrailerMode: envoy_ext_proc_v3.ProcessingMode_SKIP,
+		}
+	}
+
+	if aiBackend.Fallback != nil {
+		retryPolicy, err := buildFallbackRetryPolicy(aiBackend.Fallback)
+		if err != nil {
+			return err
+		}
+		ir.RetryPolicy = retryPolicy
+	}
+
+	// Add the route name to the ext-proc request, which is used to attribute the usage and cost
+	// of the request in the AI extension metrics.
+	extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(),
+		&envoycorev3.HeaderValue{
+			Key:   "x-ai-route",
+			Value: "%ROUTE_NAME%",
+		},
+	)
+
 	// Add the x-request-id header to the ext-proc request.
 	// This is an optimization to allow us to not have to wait for the headers request to
 	// Initialize our logger/handler classes.
@@ -185,6 +262,62 @@ func PreprocessAIBackend(ctx context.Context, aiBackend *v1alpha1.AIBackend, ir
 	return nil
 }
 
+// modelNames returns the unique client-facing model names served by the providers of the backend.
+func modelNames(aiBackend *v1alpha1.AIBackend) []string {
+	var providers []*v1alpha1.LLMProvider
+	if aiBackend.LLM != nil {
+		providers = append(providers, aiBackend.LLM)
+	}
+	for _, group := range aiBackend.PriorityGroups {
+		for i := range group.Providers {
+			providers = append(providers, &group.Providers[i].LLMProvider)
+		}
+	}
+	seen := map[string]struct{}{}
+	var names []string
+	for _, provider := range providers {
+		for _, model := range provider.Models {
+			if _, ok := seen[model.Name]; ok {
+				continue
+			}
+			seen[model.Name] = struct{}{}
+			names = append(names, model.Name)
+		}
+	}
+	return names
+}
+
+// buildFallbackRetryPolicy builds a retry policy that retries failed requests on the next priority group.
+func buildFallbackRetryPolicy(fallback *v1alpha1.AIFallback) (*envoyroutev3.RetryPolicy, error) {
+	statusCodes := fallback.StatusCodes
+	if len(statusCodes) == 0 {
+		statusCodes = defaultFallbackStatusCodes
+	}
+	retriableStatusCodes := make([]uint32, 0, len(statusCodes))
+	for _, code := range statusCodes {
+		retriableStatusCodes = append(retriableStatusCodes, uint32(code)) //nolint:gosec // G115: status codes are validated to be between 400 and 599
+	}
+	attempts := ptr.Deref(fallback.Attempts, 1)
+
+	previousPriorities, err := utils.MessageToAny(&previouspriorities.PreviousPrioritiesConfig{
+		UpdateFrequency: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &envoyroutev3.RetryPolicy{
+		RetryOn:              "retriable-status-codes,reset,connect-failure",
+		NumRetries:           wrapperspb.UInt32(uint32(attempts)), //nolint:gosec // G115: attempts is validated to be between 1 and 31
+		RetriableStatusCodes: retriableStatusCodes,
+		RetryPriority: &envoyroutev3.RetryPolicy_RetryPriority{
+			Name: previousPrioritiesRetryName,
+			ConfigType: &envoyroutev3.RetryPolicy_RetryPriority_TypedConfig{
+				TypedConfig: previousPriorities,
+			},
+		},
+	}, nil
+}
+
 func getBackendModel(provider *v1alpha1.LLMProvider, byType map[string]struct{}) string {
 	llmModel := ""
 	if provider.OpenAI != nil {
@@ -8,6 +8,7 @@ import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	previouspriorities "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/priority/previous_priorities/v3"
 	envoytransformation "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
@@ -62,10 +64,18 @@ func TestApplyAIBackend(t *testing.T) {
 									Key:   "x-llm-model",
 									Value: "gpt-3",
 								},
+								{
+									Key:   "x-ai-route",
+									Value: "%ROUTE_NAME%",
+								},
 								{
 									Key:   "x-request-id",
 									Value: "%REQ(X-REQUEST-ID)%",
 								},
+								{
+									Key:   "x-ai-backend",
+									Value: "test-backend-plugin-ns/test-backend-plugin-us",
+								},
 							},
 						},
 					},
@@ -141,10 +151,18 @@ func TestApplyAIBackend(t *testing.T) {
 									Key:   "x-llm-model",
 									Value: "gpt-3",
 								},
+								{
+									Key:   "x-ai-route",
+									Value: "%ROUTE_NAME%",
+								},
 								{
 									Key:   "x-request-id",
 									Value: "%REQ(X-REQUEST-ID)%",
 								},
+								{
+									Key:   "x-ai-backend",
+									Value: "test-backend-plugin-ns/test-backend-plugin-us",
+								},
 							},
 						},
 					},
@@ -222,7 +240,7 @@ func TestApplyAIBackend(t *testing.T) {
 				},
 			},
 			out:                 outRoute,
-			expectedError:       "multiple AI backend types found for single ai route",
+			expectedError:       "providers of different types cannot be mixed in a Backend with the envoy-based AI gateway, got anthropic, openai: use agentgateway for mixed-provider routing",
 			expectedTypedConfig: nil,
 		},
 	}
@@ -282,3 +300,128 @@ func TestApplyAIBackend(t *testing.T) {
 		})
 	}
 }
+
+func TestPreprocessAIBackend_ModelRouting(t *testing.T) {
+	token := v1alpha1.SingleAuthToken{
+		Kind:   v1alpha1.Inline,
+		Inline: ptr.To("test-token"),
+	}
+	aiBackend := &v1alpha1.AIBackend{
+		PriorityGroups: []v1alpha1.PriorityGroup{
+			{
+				Providers: []v1alpha1.NamedLLMProvider{
+					{
+						Name: "primary",
+						LLMProvider: v1alpha1.LLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{AuthToken: token},
+							Models: []v1alpha1.ModelAlias{{Name: "gpt-4o"}, {Name: "fast", Target: ptr.To("gpt-4o-mini")}},
+						},
+					},
+				},
+			},
+			{
+				Providers: []v1alpha1.NamedLLMProvider{
+					{
+						Name: "backup",
+						LLMProvider: v1alpha1.LLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{AuthToken: token},
+							Models: []v1alpha1.ModelAlias{{Name: "gpt-4o"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	aiIR := &IR{}
+	if err := PreprocessAIBackend(context.Background(), aiBackend, aiIR); err != nil {
+		t.Fatalf("expected no error but got %v", err)
+	}
+
+	var models string
+	for _, header := range aiIR.Extproc.GetOverrides().GetGrpcInitialMetadata() {
+		if header.GetKey() == "x-llm-models" {
+			models = header.GetValue()
+		}
+	}
+	if models != `["gpt-4o","fast"]` {
+		t.Errorf("expected x-llm-models header with the unique model names but got %q", models)
+	}
+	if mode := aiIR.Extproc.GetOverrides().GetProcessingMode().GetRequestBodyMode(); mode != envoy_ext_proc_v3.ProcessingMode_BUFFERED {
+		t.Errorf("expected the request body to be buffered but got %v", mode)
+	}
+	if aiIR.RetryPolicy != nil {
+		t.Errorf("expected no retry policy without fallback but got %v", aiIR.RetryPolicy)
+	}
+}
+
+func TestApplyAIBackend_Fallback(t *testing.T) {
+	token := v1alpha1.SingleAuthToken{
+		Kind:   v1alpha1.Inline,
+		Inline: ptr.To("test-token"),
+	}
+	aiBackend := &v1alpha1.AIBackend{
+		PriorityGroups: []v1alpha1.PriorityGroup{
+			{Providers: []v1alpha1.NamedLLMProvider{{Name: "primary", LLMProvider: v1alpha1.LLMProvider{OpenAI: &v1alpha1.OpenAIConfig{AuthToken: token}}}}},
+			{Providers: []v1alpha1.NamedLLMProvider{{Name: "backup", LLMProvider: v1alpha1.LLMProvider{OpenAI: &v1alpha1.OpenAIConfig{AuthToken: token}}}}},
+		},
+		Fallback: &v1alpha1.AIFallback{
+			StatusCodes: []int32{429, 503},
+			Attempts:    ptr.To(int32(2)),
+		},
+	}
+
+	previousPriorities, _ := utils.MessageToAny(&previouspriorities.PreviousPrioritiesConfig{UpdateFrequency: 1})
+	expectedRetryPolicy := &envoyroutev3.RetryPolicy{
+		RetryOn:              "retriable-status-codes,reset,connect-failure",
+		NumRetries:           wrapperspb.UInt32(2),
+		RetriableStatusCodes: []uint32{429, 503},
+		RetryPriority: &envoyroutev3.RetryPolicy_RetryPriority{
+			Name: "envoy.retry_priorities.previous_priorities",
+			ConfigType: &envoyroutev3.RetryPolicy_RetryPriority_TypedConfig{
+				TypedConfig: previousPriorities,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		route    *envoyroutev3.Route
+		expected *envoyroutev3.RetryPolicy
+	}{
+		{
+			name:     "sets the fallback retry policy",
+			route:    &envoyroutev3.Route{},
+			expected: expectedRetryPolicy,
+		},
+		{
+			name: "keeps the retry policy of the route",
+			route: &envoyroutev3.Route{
+				Action: &envoyroutev3.Route_Route{
+					Route: &envoyroutev3.RouteAction{
+						RetryPolicy: &envoyroutev3.RetryPolicy{RetryOn: "5xx"},
+					},
+				},
+			},
+			expected: &envoyroutev3.RetryPolicy{RetryOn: "5xx"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aiIR := &IR{}
+			if err := PreprocessAIBackend(context.Background(), aiBackend, aiIR); err != nil {
+				t.Fatalf("expected no error but got %v", err)
+			}
+			pCtx := &ir.RouteBackendContext{
+				TypedFilterConfig: ir.TypedFilterConfigMap(map[string]proto.Message{}),
+			}
+			if err := ApplyAIBackend(aiIR, pCtx, tt.route); err != nil {
+				t.Fatalf("expected no error but got %v", err)
+			}
+			if !proto.Equal(tt.expected, tt.route.GetRoute().GetRetryPolicy()) {
+				t.Errorf("expected retry policy %v but got %v", tt.expected, tt.route.GetRoute().GetRetryPolicy())
+			}
+		})
+	}
+}
@@ -140,7 +140,7 @@ func AddExtprocHTTPFilter() ([]plugins.StagedHttpFilter, error) {
 				Typed:   []string{"envoy.filters.ai.solo.io"},
 			},
 			ReceivingNamespaces: &envoy_ext_proc_v3.MetadataOptions_MetadataNamespaces{
-				Untyped: []string{"ai.kgateway.io"},
+				Untyped: []string{"ai.kgateway.io", envoyLbNamespace},
 			},
 		},
 	}
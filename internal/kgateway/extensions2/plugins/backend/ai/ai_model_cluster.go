@@ -10,6 +10,7 @@ import (
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	envoytransformation "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"k8s.io/utils/ptr"
@@ -28,6 +29,12 @@ const (
 	OpenAIHost    = "api.openai.com"
 	GeminiHost    = "generativelanguage.googleapis.com"
 	AnthropicHost = "api.anthropic.com"
+
+	// envoyLbNamespace is the metadata namespace used by the subset load balancer
+	envoyLbNamespace = "envoy.lb"
+	// aiModelSubsetKey is the endpoint metadata key holding the client-facing model served by the endpoint.
+	// The AI extension sets the model requested by the client under this key in the envoy.lb dynamic metadata.
+	aiModelSubsetKey = "ai_model"
 )
 
 func tlsMatch() *structpb.Struct {
@@ -71,6 +78,9 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 		if err != nil {
 			return err
 		}
+		for _, locality := range prioritized {
+			locality.LbEndpoints = expandModelEndpoints(locality.GetLbEndpoints(), aiUs.LLM.Models)
+		}
 	} else {
 		epByType := map[string]struct{}{}
 		prioritized = make([]*envoyendpointv3.LocalityLbEndpoints, 0, len(aiUs.PriorityGroups))
@@ -122,7 +132,7 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 				if err != nil {
 					return err
 				}
-				eps = append(eps, result)
+				eps = append(eps, expandModelEndpoints([]*envoyendpointv3.LbEndpoint{result}, ep.Models)...)
 			}
 			priority := idx
 			prioritized = append(prioritized, &envoyendpointv3.LocalityLbEndpoints{
@@ -174,9 +184,69 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 		Endpoints:   prioritized,
 	}
 
+	routeByModel, err := HasModelRouting(aiUs)
+	if err != nil {
+		return err
+	}
+	if routeByModel {
+		// Select the endpoints serving the model requested by the client. The priorities of the endpoints are
+		// preserved within the subset, so that fallback still happens across priority groups.
+		out.LbSubsetConfig = &envoyclusterv3.Cluster_LbSubsetConfig{
+			FallbackPolicy: envoyclusterv3.Cluster_LbSubsetConfig_NO_FALLBACK,
+			SubsetSelectors: []*envoyclusterv3.Cluster_LbSubsetConfig_LbSubsetSelector{
+				{Keys: []string{aiModelSubsetKey}},
+			},
+		}
+	}
+
 	return nil
 }
 
+// HasModelRouting returns true if the providers of the AI backend list the models they serve, in which case
+// requests are dispatched based on the model in the request body. Returns an error if only some providers list models.
+func HasModelRouting(aiUs *v1alpha1.AIBackend) (bool, error) {
+	if aiUs.LLM != nil {
+		return len(aiUs.LLM.Models) > 0, nil
+	}
+	withModels, total := 0, 0
+	for _, group := range aiUs.PriorityGroups {
+		for _, provider := range group.Providers {
+			total++
+			if len(provider.Models) > 0 {
+				withModels++
+			}
+		}
+	}
+	if withModels > 0 && withModels != total {
+		return false, fmt.Errorf("models must be set on all providers of the backend when set on any of them")
+	}
+	return withModels > 0, nil
+}
+
+// expandModelEndpoints returns one endpoint per model served by the provider. Each endpoint carries the client-facing
+// model in its load balancing metadata, which is used to select it, and the provider model in its transformation
+// metadata, which is used to rewrite the model of the request.
+func expandModelEndpoints(eps []*envoyendpointv3.LbEndpoint, models []v1alpha1.ModelAlias) []*envoyendpointv3.LbEndpoint {
+	if len(models) == 0 {
+		return eps
+	}
+	out := make([]*envoyendpointv3.LbEndpoint, 0, len(eps)*len(models))
+	for _, ep := range eps {
+		for _, model := range models {
+			modelEp := proto.Clone(ep).(*envoyendpointv3.LbEndpoint)
+			filterMetadata := modelEp.GetMetadata().GetFilterMetadata()
+			filterMetadata["io.solo.transformation"].GetFields()["model"] = structpb.NewStringValue(ptr.Deref(model.Target, model.Name))
+			filterMetadata[envoyLbNamespace] = &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					aiModelSubsetKey: structpb.NewStringValue(model.Name),
+				},
+			}
+			out = append(out, modelEp)
+		}
+	}
+	return out
+}
+
 func buildLLMEndpoint(aiUs *v1alpha1.AIBackend, aiSecrets *ir.Secret) ([]*envoyendpointv3.LocalityLbEndpoints, error) {
 	var prioritized []*envoyendpointv3.LocalityLbEndpoints
 	provider := aiUs.LLM
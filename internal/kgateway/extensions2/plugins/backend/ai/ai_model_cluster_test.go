@@ -446,3 +446,113 @@ func findTransportSocketMatchByName(matches []*envoyclusterv3.Cluster_TransportS
 	}
 	return nil
 }
+
+func TestProcessAIBackend_ModelRouting(t *testing.T) {
+	cluster := &envoyclusterv3.Cluster{
+		Name: "model-routing-cluster",
+	}
+
+	token := v1alpha1.SingleAuthToken{
+		Kind:   v1alpha1.Inline,
+		Inline: ptr.To("test-token"),
+	}
+	aiBackend := &v1alpha1.AIBackend{
+		PriorityGroups: []v1alpha1.PriorityGroup{
+			{
+				Providers: []v1alpha1.NamedLLMProvider{
+					{
+						Name: "primary",
+						LLMProvider: v1alpha1.LLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{AuthToken: token},
+							Models: []v1alpha1.ModelAlias{
+								{Name: "gpt-4o"},
+								{Name: "fast", Target: ptr.To("gpt-4o-mini")},
+							},
+						},
+					},
+				},
+			},
+			{
+				Providers: []v1alpha1.NamedLLMProvider{
+					{
+						Name: "backup",
+						LLMProvider: v1alpha1.LLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{AuthToken: token},
+							Host:   ptr.To("backup.example.com"),
+							Port:   ptr.To(gwv1.PortNumber(443)),
+							Models: []v1alpha1.ModelAlias{
+								{Name: "gpt-4o", Target: ptr.To("gpt-4o-2024-08-06")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := ProcessAIBackend(aiBackend, nil, map[string]*ir.Secret{}, cluster)
+	require.NoError(t, err)
+
+	require.NotNil(t, cluster.GetLbSubsetConfig())
+	assert.Equal(t, envoyclusterv3.Cluster_LbSubsetConfig_NO_FALLBACK, cluster.GetLbSubsetConfig().GetFallbackPolicy())
+	require.Len(t, cluster.GetLbSubsetConfig().GetSubsetSelectors(), 1)
+	assert.Equal(t, []string{"ai_model"}, cluster.GetLbSubsetConfig().GetSubsetSelectors()[0].GetKeys())
+
+	require.Len(t, cluster.GetLoadAssignment().GetEndpoints(), 2)
+	type modelEndpoint struct {
+		host, clientModel, providerModel string
+		priority                         uint32
+	}
+	var got []modelEndpoint
+	for _, locality := range cluster.GetLoadAssignment().GetEndpoints() {
+		for _, ep := range locality.GetLbEndpoints() {
+			md := ep.GetMetadata().GetFilterMetadata()
+			got = append(got, modelEndpoint{
+				host:          ep.GetEndpoint().GetAddress().GetSocketAddress().GetAddress(),
+				clientModel:   md["envoy.lb"].GetFields()["ai_model"].GetStringValue(),
+				providerModel: md["io.solo.transformation"].GetFields()["model"].GetStringValue(),
+				priority:      locality.GetPriority(),
+			})
+		}
+	}
+	assert.Equal(t, []modelEndpoint{
+		{host: "api.openai.com", clientModel: "gpt-4o", providerModel: "gpt-4o", priority: 0},
+		{host: "api.openai.com", clientModel: "fast", providerModel: "gpt-4o-mini", priority: 0},
+		{host: "backup.example.com", clientModel: "gpt-4o", providerModel: "gpt-4o-2024-08-06", priority: 1},
+	}, got)
+}
+
+func TestProcessAIBackend_ModelRoutingPartialModels(t *testing.T) {
+	cluster := &envoyclusterv3.Cluster{
+		Name: "model-routing-cluster",
+	}
+
+	token := v1alpha1.SingleAuthToken{
+		Kind:   v1alpha1.Inline,
+		Inline: ptr.To("test-token"),
+	}
+	aiBackend := &v1alpha1.AIBackend{
+		PriorityGroups: []v1alpha1.PriorityGroup{
+			{
+				Providers: []v1alpha1.NamedLLMProvider{
+					{
+						Name: "with-models",
+						LLMProvider: v1alpha1.LLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{AuthToken: token},
+							Models: []v1alpha1.ModelAlias{{Name: "gpt-4o"}},
+						},
+					},
+					{
+						Name: "without-models",
+						LLMProvider: v1alpha1.LLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{AuthToken: token},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := ProcessAIBackend(aiBackend, nil, map[string]*ir.Secret{}, cluster)
+	require.EqualError(t, err, "models must be set on all providers of the backend when set on any of them")
+}
@@ -0,0 +1,46 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+)
+
+func TestMixedAIProvidersNotAccepted(t *testing.T) {
+	inline := v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("token")}
+	backend := &v1alpha1.Backend{
+		ObjectMeta: metav1.ObjectMeta{Name: "mixed", Namespace: "default"},
+		Spec: v1alpha1.BackendSpec{
+			Type: v1alpha1.BackendTypeAI,
+			AI: &v1alpha1.AIBackend{
+				PriorityGroups: []v1alpha1.PriorityGroup{
+					{Providers: []v1alpha1.NamedLLMProvider{{
+						Name:        "openai",
+						LLMProvider: v1alpha1.LLMProvider{OpenAI: &v1alpha1.OpenAIConfig{AuthToken: inline, Model: ptr.To("gpt-4o")}},
+					}}},
+					{Providers: []v1alpha1.NamedLLMProvider{{
+						Name:        "anthropic",
+						LLMProvider: v1alpha1.LLMProvider{Anthropic: &v1alpha1.AnthropicConfig{AuthToken: inline, Model: ptr.To("claude")}},
+					}}},
+				},
+				Fallback: &v1alpha1.AIFallback{},
+			},
+		},
+	}
+
+	backendIr := buildTranslateFunc(context.Background(), nil, nil, nil)(krt.TestingDummyContext{}, backend)
+	require.Len(t, backendIr.Errors, 1)
+
+	condition := pluginutils.BuildCondition("Backend", backendIr.Errors)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, "Invalid", condition.Reason)
+	assert.Contains(t, condition.Message, "providers of different types cannot be mixed in a Backend")
+}
@@ -17,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -130,7 +131,7 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, v
 		commoncol.Client,
 		kclient.Filter{ObjectFilter: commoncol.Client.ObjectFilter()},
 	), commoncol.KrtOpts.ToOptions("BackendConfigPolicy")...)
-	backendConfigPolicyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, b *v1alpha1.BackendConfigPolicy) *ir.PolicyWrapper {
+	buildPolicy := func(krtctx krt.HandlerContext, b *v1alpha1.BackendConfigPolicy) *ir.PolicyWrapper {
 		policyIR, errs := translate(commoncol, krtctx, b)
 		if err := validateXDS(ctx, policyIR, v, commoncol.Settings.ValidationMode); err != nil {
 			errs = append(errs, err)
@@ -148,7 +149,8 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, v
 			TargetRefs: pluginsdkutils.TargetRefsToPolicyRefs(b.Spec.TargetRefs, b.Spec.TargetSelectors),
 			Errors:     errs,
 		}
-	}, commoncol.KrtOpts.ToOptions("BackendConfigPolicyIRs")...)
+	}
+	backendConfigPolicyCol := krt.NewCollection(col, buildPolicy, commoncol.KrtOpts.ToOptions("BackendConfigPolicyIRs")...)
 	return sdk.Plugin{
 		ContributesPolicies: map[schema.GroupKind]sdk.PolicyPlugin{
 			wellknown.BackendConfigPolicyGVK.GroupKind(): {
@@ -157,6 +159,13 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, v
 				ProcessBackend:    processBackend,
 				GetPolicyStatus:   getPolicyStatusFn(commoncol.CrudClient),
 				PatchPolicyStatus: patchPolicyStatusFn(commoncol.CrudClient),
+				ValidatePolicy: func(obj metav1.Object) []error {
+					b, ok := obj.(*v1alpha1.BackendConfigPolicy)
+					if !ok {
+						return nil
+					}
+					return validatePolicy(ctx, commoncol, b)
+				},
 			},
 		},
 	}
@@ -235,7 +244,7 @@ func translate(
 	if pol.Spec.Http1ProtocolOptions != nil {
 		http1ProtocolOptions, err := translateHttp1ProtocolOptions(pol.Spec.Http1ProtocolOptions)
 		if err != nil {
-			errs = append(errs, err)
+			errs = append(errs, pluginsdkutils.WithFieldPath(field.NewPath("spec", "http1ProtocolOptions"), err))
 		}
 		ir.http1ProtocolOptions = http1ProtocolOptions
 	}
@@ -244,10 +253,11 @@ func translate(
 		ir.http2ProtocolOptions = translateHttp2ProtocolOptions(pol.Spec.Http2ProtocolOptions)
 	}
 
-	if pol.Spec.TLS != nil {
+	// the TLS secret is not resolved when validating the policy before it is persisted, see validatePolicy
+	if pol.Spec.TLS != nil && (krtctx != nil || pol.Spec.TLS.SecretRef == nil) {
 		tlsConfig, err := translateTLSConfig(NewDefaultSecretGetter(commoncol.Secrets, krtctx), pol.Spec.TLS, pol.Namespace)
 		if err != nil {
-			errs = append(errs, err)
+			errs = append(errs, pluginsdkutils.WithFieldPath(field.NewPath("spec", "tls"), err))
 		}
 		ir.tlsConfig = tlsConfig
 	}
@@ -255,7 +265,7 @@ func translate(
 	if pol.Spec.LoadBalancer != nil {
 		loadBalancerConfig, err := translateLoadBalancerConfig(pol.Spec.LoadBalancer, pol.Name, pol.Namespace)
 		if err != nil {
-			errs = append(errs, err)
+			errs = append(errs, pluginsdkutils.WithFieldPath(field.NewPath("spec", "loadBalancer"), err))
 		}
 		ir.loadBalancerConfig = loadBalancerConfig
 	}
@@ -7,13 +7,28 @@ import (
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
 	"github.com/kgateway-dev/kgateway/v2/pkg/xds/bootstrap"
 )
 
+// validatePolicy validates a BackendConfigPolicy before it is persisted. The TLS secret it may reference is
+// not resolved, as it may be created after the policy. The policy is applied to a placeholder cluster the
+// way backends are translated, and the resulting cluster is validated in-process, without envoy.
+func validatePolicy(ctx context.Context, commoncol *collections.CommonCollections, pol *v1alpha1.BackendConfigPolicy) []error {
+	policyIR, errs := translate(commoncol, nil, pol)
+	if err := validateXDS(ctx, policyIR, validator.NewProto(), apisettings.ValidationStrict); err != nil {
+		errs = append(errs, pluginsdkutils.WithFieldPath(field.NewPath("spec"), err))
+	}
+	return errs
+}
+
 // validateXDS performs xDS validation checks on the BCP IR definition. This acts as a
 // safety net to catch bugs in the IR construction logic and prevents invalid configuration
 // from being applied when STRICT mode is enabled.
@@ -13,8 +13,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
 )
 
@@ -181,3 +186,45 @@ func TestBackendConfigPolicyXDSValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    v1alpha1.BackendConfigPolicySpec
+		wantErr bool
+	}{
+		{
+			name: "unresolved TLS secret is not rejected",
+			spec: v1alpha1.BackendConfigPolicySpec{
+				ConnectTimeout: &metav1.Duration{Duration: 5 * time.Second},
+				TLS: &v1alpha1.TLS{
+					SecretRef: &corev1.LocalObjectReference{Name: "missing"},
+				},
+			},
+		},
+		{
+			name: "invalid TLS config is rejected",
+			spec: v1alpha1.BackendConfigPolicySpec{
+				TLS: &v1alpha1.TLS{
+					Files: &v1alpha1.TLSFiles{TLSCertificate: ptr.To("/etc/certs/tls.crt")},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pol := &v1alpha1.BackendConfigPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			errs := validatePolicy(t.Context(), &collections.CommonCollections{}, pol)
+			if tt.wantErr {
+				assert.NotEmpty(t, errs)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
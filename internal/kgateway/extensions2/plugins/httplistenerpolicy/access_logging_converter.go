@@ -57,7 +57,7 @@ func convertAccessLogConfig(
 	grpcBackends := make(map[string]*ir.BackendObjectIR, len(policy.Spec.AccessLog))
 	for idx, log := range configs {
 		if log.GrpcService != nil {
-			backend, err := commoncol.BackendIndex.GetBackendFromRef(krtctx, parentSrc, log.GrpcService.BackendRef.BackendObjectReference)
+			backend, err := getBackendFromRef(krtctx, commoncol, parentSrc, log.GrpcService.BackendRef.BackendObjectReference)
 			// TODO: what is the correct behavior? maybe route to static blackhole?
 			if err != nil {
 				return nil, fmt.Errorf("%w: %v", ErrUnresolvedBackendRef, err)
@@ -66,7 +66,7 @@ func convertAccessLogConfig(
 			continue
 		}
 		if log.OpenTelemetry != nil {
-			backend, err := commoncol.BackendIndex.GetBackendFromRef(krtctx, parentSrc, log.OpenTelemetry.GrpcService.BackendRef.BackendObjectReference)
+			backend, err := getBackendFromRef(krtctx, commoncol, parentSrc, log.OpenTelemetry.GrpcService.BackendRef.BackendObjectReference)
 			// TODO: what is the correct behavior? maybe route to static blackhole?
 			if err != nil {
 				return nil, fmt.Errorf("%w: %v", ErrUnresolvedBackendRef, err)
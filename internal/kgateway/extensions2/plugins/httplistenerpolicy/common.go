@@ -3,11 +3,15 @@ package httplistenerpolicy
 import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	pluginsdkir "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 )
 
 func ToEnvoyGrpc(in v1alpha1.CommonGrpcService, backend *ir.BackendObjectIR) (*envoycorev3.GrpcService, error) {
@@ -66,3 +70,24 @@ func ToEnvoyGrpc(in v1alpha1.CommonGrpcService, backend *ir.BackendObjectIR) (*e
 	}
 	return grpcService, nil
 }
+
+// getBackendFromRef resolves a backendRef of the policy. krtctx is nil when the policy is validated before
+// it is persisted: the reference is then not resolved, and a backend named after it stands in for it so
+// that the rest of the configuration is still validated.
+func getBackendFromRef(
+	krtctx krt.HandlerContext,
+	commoncol *collections.CommonCollections,
+	parentSrc ir.ObjectSource,
+	ref gwv1.BackendObjectReference,
+) (*ir.BackendObjectIR, error) {
+	if krtctx == nil {
+		backend := pluginsdkir.NewBackendObjectIR(ir.ObjectSource{
+			Group:     string(ptr.Deref(ref.Group, "")),
+			Kind:      string(ptr.Deref(ref.Kind, "Service")),
+			Namespace: string(ptr.Deref(ref.Namespace, gwv1.Namespace(parentSrc.Namespace))),
+			Name:      string(ref.Name),
+		}, int32(ptr.Deref(ref.Port, 0)), "")
+		return &backend, nil
+	}
+	return commoncol.BackendIndex.GetBackendFromRef(krtctx, parentSrc, ref)
+}
@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/utils/pointer"
 
@@ -194,7 +195,7 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 		kclient.Filter{ObjectFilter: commoncol.Client.ObjectFilter()},
 	), commoncol.KrtOpts.ToOptions("HTTPListenerPolicy")...)
 	gk := wellknown.HTTPListenerPolicyGVK.GroupKind()
-	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, i *v1alpha1.HTTPListenerPolicy) *ir.PolicyWrapper {
+	buildPolicy := func(krtctx krt.HandlerContext, i *v1alpha1.HTTPListenerPolicy) *ir.PolicyWrapper {
 		objSrc := ir.ObjectSource{
 			Group:     gk.Group,
 			Kind:      gk.Kind,
@@ -206,13 +207,13 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 		accessLog, err := convertAccessLogConfig(ctx, i, commoncol, krtctx, objSrc)
 		if err != nil {
 			logger.Error("error translating access log", "error", err)
-			errs = append(errs, err)
+			errs = append(errs, pluginsdkutils.WithFieldPath(field.NewPath("spec", "accessLog"), err))
 		}
 
 		tracingProvider, tracingConfig, err := convertTracingConfig(ctx, i, commoncol, krtctx, objSrc)
 		if err != nil {
 			logger.Error("error translating tracing", "error", err)
-			errs = append(errs, err)
+			errs = append(errs, pluginsdkutils.WithFieldPath(field.NewPath("spec", "tracing"), err))
 		}
 
 		upgradeConfigs := convertUpgradeConfig(i)
@@ -262,7 +263,8 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 		}
 
 		return pol
-	})
+	}
+	policyCol := krt.NewCollection(col, buildPolicy)
 
 	return sdk.Plugin{
 		ContributesPolicies: map[schema.GroupKind]sdk.PolicyPlugin{
@@ -274,6 +276,14 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 				MergePolicies: func(pols []ir.PolicyAtt) ir.PolicyAtt {
 					return policy.MergePolicies(pols, mergePolicies, "" /*no merge settings*/)
 				},
+				ValidatePolicy: func(obj metav1.Object) []error {
+					i, ok := obj.(*v1alpha1.HTTPListenerPolicy)
+					if !ok {
+						return nil
+					}
+					// backendRefs are not resolved when validating the policy before it is persisted, see getBackendFromRef
+					return buildPolicy(nil, i).Errors
+				},
 			},
 		},
 	}
@@ -37,7 +37,7 @@ func convertTracingConfig(
 		return nil, nil, fmt.Errorf("Tracing.OpenTelemetryConfig.GrpcService.BackendRef must be specified")
 	}
 
-	backend, err := commoncol.BackendIndex.GetBackendFromRef(krtctx, parentSrc, config.Provider.OpenTelemetry.GrpcService.BackendRef.BackendObjectReference)
+	backend, err := getBackendFromRef(krtctx, commoncol, parentSrc, config.Provider.OpenTelemetry.GrpcService.BackendRef.BackendObjectReference)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w: %v", ErrUnresolvedBackendRef, err)
 	}
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -416,3 +417,28 @@ func TestTracingConverter(t *testing.T) {
 		}
 	})
 }
+
+func TestConvertTracingConfigWithoutResolvingReferences(t *testing.T) {
+	policy := &v1alpha1.HTTPListenerPolicy{
+		Spec: v1alpha1.HTTPListenerPolicySpec{
+			Tracing: &v1alpha1.Tracing{
+				Provider: v1alpha1.TracingProvider{
+					OpenTelemetry: &v1alpha1.OpenTelemetryTracingConfig{
+						GrpcService: v1alpha1.CommonGrpcService{
+							BackendRef: &gwv1.BackendRef{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name: "missing-service",
+									Port: ptr.To(gwv1.PortNumber(4317)),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	// the policy is validated before it is persisted, the backend is not resolved
+	provider, _, err := convertTracingConfig(context.Background(), policy, nil, nil, ir.ObjectSource{Namespace: "default"})
+	require.NoError(t, err)
+	assert.Equal(t, "service_default_missing-service_4317", provider.GetGrpcService().GetEnvoyGrpc().GetClusterName())
+}
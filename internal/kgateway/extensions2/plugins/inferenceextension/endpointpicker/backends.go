@@ -3,6 +3,7 @@ package endpointpicker
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -50,12 +51,7 @@ func processPoolBackendObjIR(
 	out.Name = in.ClusterName()
 	out.ClusterDiscoveryType = &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STATIC}
 	out.LbPolicy = envoyclusterv3.Cluster_ROUND_ROBIN
-	out.LbSubsetConfig = &envoyclusterv3.Cluster_LbSubsetConfig{
-		SubsetSelectors: []*envoyclusterv3.Cluster_LbSubsetConfig_LbSubsetSelector{{
-			Keys: []string{dstEndpointKey},
-		}},
-		FallbackPolicy: envoyclusterv3.Cluster_LbSubsetConfig_ANY_ENDPOINT,
-	}
+	out.LbSubsetConfig = buildLbSubsetConfig(irPool)
 
 	// TODO [danehans]: Set H1/H2 app protocol programmatically:
 	// https://github.com/kubernetes-sigs/gateway-api-inference-extension/issues/1273
@@ -124,6 +120,25 @@ func processPoolBackendObjIR(
 	return nil
 }
 
+// buildLbSubsetConfig returns the subset lb config used to route to the endpoint selected by the EPP.
+// In shadow mode no subset config is returned, so the selection of the EPP is ignored. When no endpoint
+// is selected, requests fall back to any endpoint unless the pool fails closed with a 503.
+func buildLbSubsetConfig(pool *inferencePool) *envoyclusterv3.Cluster_LbSubsetConfig {
+	if pool.picker.shadow {
+		return nil
+	}
+	fallback := envoyclusterv3.Cluster_LbSubsetConfig_ANY_ENDPOINT
+	if !pool.failOpen && pool.picker.failureStatus == http.StatusServiceUnavailable {
+		fallback = envoyclusterv3.Cluster_LbSubsetConfig_NO_FALLBACK
+	}
+	return &envoyclusterv3.Cluster_LbSubsetConfig{
+		SubsetSelectors: []*envoyclusterv3.Cluster_LbSubsetConfig_LbSubsetSelector{{
+			Keys: []string{dstEndpointKey},
+		}},
+		FallbackPolicy: fallback,
+	}
+}
+
 func addHTTP1(c *envoyclusterv3.Cluster) {
 	http1Opts := &upstreamsv3.HttpProtocolOptions{
 		UpstreamProtocolOptions: &upstreamsv3.HttpProtocolOptions_ExplicitHttpConfig_{
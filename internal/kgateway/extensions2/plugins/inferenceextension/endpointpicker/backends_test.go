@@ -13,8 +13,10 @@ import (
 	"istio.io/istio/pkg/kube/krt/krttest"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
@@ -134,3 +136,60 @@ func TestProcessPoolBackendObjIR_SkipsOnErrors(t *testing.T) {
 	require.Len(t, cla.Endpoints, 1)
 	assert.Empty(t, cla.Endpoints[0].LbEndpoints)
 }
+
+func TestBuildLbSubsetConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		failureMode  inf.EndpointPickerFailureMode
+		annotations  map[string]string
+		wantFallback *envoyclusterv3.Cluster_LbSubsetConfig_LbSubsetFallbackPolicy
+	}{
+		{
+			name:         "fail closed falls back to any endpoint",
+			failureMode:  inf.EndpointPickerFailClose,
+			wantFallback: ptr.To(envoyclusterv3.Cluster_LbSubsetConfig_ANY_ENDPOINT),
+		},
+		{
+			name:         "fail open falls back to any endpoint",
+			failureMode:  inf.EndpointPickerFailOpen,
+			wantFallback: ptr.To(envoyclusterv3.Cluster_LbSubsetConfig_ANY_ENDPOINT),
+		},
+		{
+			name:         "fail closed with 503 has no fallback",
+			failureMode:  inf.EndpointPickerFailClose,
+			annotations:  map[string]string{apiannotations.EndpointPickerFailureStatus: "503"},
+			wantFallback: ptr.To(envoyclusterv3.Cluster_LbSubsetConfig_NO_FALLBACK),
+		},
+		{
+			name:        "shadow mode ignores the picker selection",
+			failureMode: inf.EndpointPickerFailClose,
+			annotations: map[string]string{apiannotations.EndpointPickerMode: string(apiannotations.EndpointPickerModeShadow)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pool := newInferencePool(&inf.InferencePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns", Annotations: tc.annotations},
+				Spec: inf.InferencePoolSpec{
+					TargetPorts: []inf.Port{{Number: 9000}},
+					EndpointPickerRef: inf.EndpointPickerRef{
+						Name:        "svc",
+						Port:        &inf.Port{Number: inf.PortNumber(9002)},
+						FailureMode: tc.failureMode,
+					},
+				},
+			})
+
+			cfg := buildLbSubsetConfig(pool)
+			if tc.wantFallback == nil {
+				assert.Nil(t, cfg)
+				return
+			}
+			require.NotNil(t, cfg)
+			assert.Equal(t, *tc.wantFallback, cfg.FallbackPolicy)
+			require.Len(t, cfg.SubsetSelectors, 1)
+			assert.Equal(t, []string{dstEndpointKey}, cfg.SubsetSelectors[0].Keys)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
@@ -27,7 +30,7 @@ type inferencePool struct {
 	// configRef is a reference to the extension configuration. A configRef is typically implemented
 	// as a Kubernetes Service resource.
 	configRef *service
-	// mu is a mutex to protect access to the errors list.
+	// mu is a mutex to protect access to the errors list and the reported picker condition.
 	mu sync.Mutex
 	// errors is a list of errors that occurred while processing the InferencePool.
 	errors []error
@@ -42,6 +45,22 @@ type inferencePool struct {
 	// Defaults to `false`.
 	//
 	failOpen bool
+	// picker defines the endpoint picker settings configured by InferencePool annotations.
+	picker pickerConfig
+	// pickerCond is the last EndpointPickerReady condition reported in the InferencePool status.
+	pickerCond *metav1.Condition
+}
+
+// pickerConfig defines how the proxy consults the endpoint picker of an InferencePool.
+type pickerConfig struct {
+	// shadow configures the proxy to consult the endpoint picker but ignore its selection,
+	// routing requests using round robin across all pool endpoints.
+	shadow bool
+	// timeout is the timeout of the gRPC stream to the endpoint picker.
+	timeout time.Duration
+	// failureStatus is the HTTP status returned when the endpoint picker is unavailable
+	// and the pool fails closed.
+	failureStatus int
 }
 
 type targetPort struct {
@@ -67,6 +86,9 @@ func newInferencePool(pool *inf.InferencePool) *inferencePool {
 		ports: []servicePort{port},
 	}
 
+	// Invalid annotations are reported by validatePool, so the defaults are used here.
+	picker, _ := parsePickerConfig(pool)
+
 	return &inferencePool{
 		obj:         pool,
 		podSelector: convertSelector(pool.Spec.Selector.MatchLabels),
@@ -75,6 +97,7 @@ func newInferencePool(pool *inf.InferencePool) *inferencePool {
 		configRef:   svcIR,
 		endpoints:   []endpoint{},
 		failOpen:    isFailOpen(pool),
+		picker:      picker,
 	}
 }
 
@@ -174,6 +197,10 @@ func (ir *inferencePool) Equals(other any) bool {
 	if !ir.failOpenEqual(otherPool) {
 		return false
 	}
+	// Compare endpoint picker settings
+	if ir.picker != otherPool.picker {
+		return false
+	}
 	return true
 }
 
@@ -204,6 +231,24 @@ func (ir *inferencePool) snapshotErrors() []error {
 	return out
 }
 
+// setPickerCondition records the EndpointPickerReady condition reported in the pool status.
+func (ir *inferencePool) setPickerCondition(cond *metav1.Condition) {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+	ir.pickerCond = cond
+}
+
+// pickerConditionChanged returns true if the given EndpointPickerReady condition differs
+// from the one last reported in the pool status.
+func (ir *inferencePool) pickerConditionChanged(cond metav1.Condition) bool {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+	return ir.pickerCond == nil ||
+		ir.pickerCond.Status != cond.Status ||
+		ir.pickerCond.Reason != cond.Reason ||
+		ir.pickerCond.Message != cond.Message
+}
+
 // hasErrors checks if the inferencePool has any errors.
 func (ir *inferencePool) hasErrors() bool {
 	ir.mu.Lock()
@@ -215,6 +260,13 @@ func (ir *inferencePool) failOpenEqual(other *inferencePool) bool {
 	return ir.failOpen == other.failOpen
 }
 
+// extProcFailureModeAllow returns whether requests are forwarded when the endpoint picker is unavailable.
+// A pool failing closed with a 503 lets the request through and relies on the subset load balancer
+// to reject it, since no endpoint is selected.
+func (ir *inferencePool) extProcFailureModeAllow() bool {
+	return ir.failOpen || ir.picker.shadow || ir.picker.failureStatus == http.StatusServiceUnavailable
+}
+
 func convertSelector(selector map[inf.LabelKey]inf.LabelValue) map[string]string {
 	result := make(map[string]string, len(selector))
 	for k, v := range selector {
@@ -294,6 +346,57 @@ func versionEquals(a, b metav1.Object) bool {
 	return versionEquals && a.GetUID() == b.GetUID()
 }
 
+// parsePickerConfig returns the endpoint picker settings of the given pool along with any errors
+// caused by invalid annotations. Defaults are used for invalid values.
+func parsePickerConfig(pool *inf.InferencePool) (pickerConfig, []error) {
+	cfg := pickerConfig{
+		timeout:       defaultPickerTimeout,
+		failureStatus: http.StatusInternalServerError,
+	}
+	if pool == nil {
+		return cfg, nil
+	}
+
+	var errs []error
+	annos := pool.GetAnnotations()
+	if val, ok := annos[apiannotations.EndpointPickerMode]; ok {
+		switch apiannotations.EndpointPickerModeValue(val) {
+		case apiannotations.EndpointPickerModeNormal:
+		case apiannotations.EndpointPickerModeShadow:
+			cfg.shadow = true
+		default:
+			errs = append(errs, fmt.Errorf("invalid annotation %s value %q: must be one of %s or %s",
+				apiannotations.EndpointPickerMode, val,
+				apiannotations.EndpointPickerModeNormal, apiannotations.EndpointPickerModeShadow))
+		}
+	}
+	if val, ok := annos[apiannotations.EndpointPickerTimeout]; ok {
+		d, err := time.ParseDuration(val)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("invalid annotation %s value %q: %w", apiannotations.EndpointPickerTimeout, val, err))
+		case d <= 0:
+			errs = append(errs, fmt.Errorf("invalid annotation %s value %q: must be positive", apiannotations.EndpointPickerTimeout, val))
+		default:
+			cfg.timeout = d
+		}
+	}
+	if val, ok := annos[apiannotations.EndpointPickerFailureStatus]; ok {
+		status, err := strconv.Atoi(val)
+		switch {
+		case err != nil || (status != http.StatusInternalServerError && status != http.StatusServiceUnavailable):
+			errs = append(errs, fmt.Errorf("invalid annotation %s value %q: must be %d or %d",
+				apiannotations.EndpointPickerFailureStatus, val, http.StatusInternalServerError, http.StatusServiceUnavailable))
+		case isFailOpen(pool):
+			errs = append(errs, fmt.Errorf("invalid annotation %s: only supported when failureMode is %s",
+				apiannotations.EndpointPickerFailureStatus, inf.EndpointPickerFailClose))
+		default:
+			cfg.failureStatus = status
+		}
+	}
+	return cfg, errs
+}
+
 func isFailOpen(pool *inf.InferencePool) bool {
 	if pool == nil {
 		return false
@@ -2,6 +2,7 @@ package endpointpicker
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	krtpkg "github.com/kgateway-dev/kgateway/v2/pkg/utils/krtutil"
 )
@@ -60,6 +62,43 @@ func TestIsFailOpen(t *testing.T) {
 	assert.True(t, r.failOpen)
 }
 
+func TestParsePickerConfig(t *testing.T) {
+	// Defaults
+	p := makePool()
+	assert.Equal(t, pickerConfig{timeout: defaultPickerTimeout, failureStatus: http.StatusInternalServerError}, p.picker)
+	assert.False(t, p.extProcFailureModeAllow())
+
+	// Shadow mode, custom timeout and failure status
+	annotate := func(annos map[string]string) func(*inf.InferencePool) {
+		return func(pool *inf.InferencePool) { pool.Annotations = annos }
+	}
+	p = makePool(annotate(map[string]string{
+		apiannotations.EndpointPickerMode:          string(apiannotations.EndpointPickerModeShadow),
+		apiannotations.EndpointPickerTimeout:       "500ms",
+		apiannotations.EndpointPickerFailureStatus: "503",
+	}))
+	assert.Equal(t, pickerConfig{shadow: true, timeout: 500 * time.Millisecond, failureStatus: http.StatusServiceUnavailable}, p.picker)
+	assert.True(t, p.extProcFailureModeAllow())
+
+	// Invalid values are reported and replaced by the defaults
+	p = makePool(annotate(map[string]string{
+		apiannotations.EndpointPickerMode:          "Mirror",
+		apiannotations.EndpointPickerTimeout:       "-1s",
+		apiannotations.EndpointPickerFailureStatus: "404",
+	}))
+	cfg, errs := parsePickerConfig(p.obj.(*inf.InferencePool))
+	assert.Len(t, errs, 3)
+	assert.Equal(t, pickerConfig{timeout: defaultPickerTimeout, failureStatus: http.StatusInternalServerError}, cfg)
+
+	// The failure status only applies to pools failing closed
+	p = makePool(
+		annotate(map[string]string{apiannotations.EndpointPickerFailureStatus: "503"}),
+		func(pool *inf.InferencePool) { pool.Spec.EndpointPickerRef.FailureMode = inf.EndpointPickerFailOpen },
+	)
+	_, errs = parsePickerConfig(p.obj.(*inf.InferencePool))
+	assert.Len(t, errs, 1)
+}
+
 func TestResolvePoolEndpoints_Indexing(t *testing.T) {
 	// Create the pool IR
 	poolIR := makePool()
@@ -39,6 +39,8 @@ const (
 	poolGroupKindName = "endpoint-picker"
 	// Derived from upstream Gateway API Inference Extension defaults (testdata/envoy.yaml).
 	defaultExtProcMaxRequests = 40000
+	// defaultPickerTimeout is the default timeout of the gRPC stream to the endpoint picker.
+	defaultPickerTimeout = 10 * time.Second
 	// envoyLbNamespace is the Envoy predefined namespace for load balancing metadata.
 	envoyLbNamespace = "envoy.lb"
 	// envoySubsetHint defines the outer key of the subset list metadata entry for Envoy
@@ -247,9 +249,9 @@ func (p *endpointPickerPass) ApplyForBackend(
 	override := &extprocv3.ExtProcPerRoute{
 		Override: &extprocv3.ExtProcPerRoute_Overrides{
 			Overrides: &extprocv3.ExtProcOverrides{
-				FailureModeAllow: wrapperspb.Bool(irPool.failOpen),
+				FailureModeAllow: wrapperspb.Bool(irPool.extProcFailureModeAllow()),
 				GrpcService: &envoycorev3.GrpcService{
-					Timeout: durationpb.New(10 * time.Second),
+					Timeout: durationpb.New(irPool.picker.timeout),
 					TargetSpecifier: &envoycorev3.GrpcService_EnvoyGrpc_{
 						EnvoyGrpc: &envoycorev3.GrpcService_EnvoyGrpc{
 							ClusterName: clusterNameExtProc(
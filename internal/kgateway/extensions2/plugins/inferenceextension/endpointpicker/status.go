@@ -8,10 +8,12 @@ import (
 
 	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/ptr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	meta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
@@ -31,6 +33,18 @@ const (
 	// defaultInfPoolStatusName is the Name defined by the default InferencePool
 	// parent status condition.
 	defaultInfPoolStatusName = "default"
+
+	// endpointPickerReadyCondition is the type of the InferencePool parent status condition
+	// reporting the health of the endpoint picker.
+	endpointPickerReadyCondition = "EndpointPickerReady"
+	// endpointPickerReasonReady is used when at least one endpoint picker Pod is ready.
+	endpointPickerReasonReady = "Ready"
+	// endpointPickerReasonNoReadyPods is used when no endpoint picker Pod is ready.
+	endpointPickerReasonNoReadyPods = "NoReadyPods"
+	// endpointPickerReasonServiceNotFound is used when the endpoint picker Service does not exist.
+	endpointPickerReasonServiceNotFound = "ServiceNotFound"
+	// endpointPickerReasonUnknown is used when the endpoint picker Pods cannot be determined.
+	endpointPickerReasonUnknown = "Unknown"
 )
 
 // buildRegisterCallback returns a function that registers all handlers for the
@@ -46,6 +60,7 @@ func buildRegisterCallback(
 		registerRouteHandlers(ctx, commonCol, bcol, poolIdx)
 		registerPoolHandlers(ctx, commonCol, bcol)
 		registerServiceHandlers(ctx, commonCol, bcol)
+		registerPickerPodHandlers(ctx, commonCol, bcol)
 	}
 }
 
@@ -198,6 +213,48 @@ func reconcilePoolsForService(
 	}
 }
 
+// registerPickerPodHandlers sets up handlers for Pod events that affect the health of endpoint pickers.
+func registerPickerPodHandlers(
+	ctx context.Context,
+	commonCol *collections.CommonCollections,
+	bcol krt.Collection[ir.BackendObjectIR],
+) {
+	commonCol.WrappedPods.Register(func(ev krt.Event[krtcollections.WrappedPod]) {
+		reconcilePoolsForPickerPod(ctx, commonCol, bcol, ev)
+	})
+}
+
+// reconcilePoolsForPickerPod updates the status of all InferencePools whose endpoint picker
+// Service selects the given Pod. Pools are only updated when the EndpointPickerReady condition
+// changes, so Pod churn that does not affect picker readiness does not write status.
+func reconcilePoolsForPickerPod(
+	ctx context.Context,
+	commonCol *collections.CommonCollections,
+	bcol krt.Collection[ir.BackendObjectIR],
+	ev krt.Event[krtcollections.WrappedPod],
+) {
+	pod := ev.Latest()
+	for _, beIR := range bcol.List() {
+		irPool, ok := beIR.ObjIr.(*inferencePool)
+		if !ok || irPool.configRef == nil || irPool.configRef.Namespace != pod.Namespace {
+			continue
+		}
+		svc := ptr.Flatten(commonCol.Services.GetKey(types.NamespacedName{
+			Namespace: irPool.configRef.Namespace,
+			Name:      irPool.configRef.Name,
+		}.String()))
+		if svc == nil || len(svc.Spec.Selector) == 0 {
+			continue
+		}
+		if !labels.SelectorFromSet(svc.Spec.Selector).Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		if irPool.pickerConditionChanged(buildEndpointPickerReadyCondition(0, commonCol, irPool)) {
+			updatePoolStatus(ctx, commonCol, beIR, "", nil)
+		}
+	}
+}
+
 // isPoolBackend returns true if the given backendRef references the given InferencePool.
 func isPoolBackend(be gwv1.HTTPBackendRef, poolNN types.NamespacedName) bool {
 	// Group defaulting
@@ -358,6 +415,14 @@ func updatePoolStatus(
 		return &pool.Status.Parents[len(pool.Status.Parents)-1]
 	}
 
+	// Report the health of the endpoint picker on each active Gateway
+	var pickerCond *metav1.Condition
+	if len(activeGws) > 0 && irPool.configRef != nil {
+		cond := buildEndpointPickerReadyCondition(pool.Generation, commonCol, irPool)
+		pickerCond = &cond
+	}
+	irPool.setPickerCondition(pickerCond)
+
 	// Add back each active Gateway
 	for g := range activeGws {
 		p := updateParent(inf.ParentReference{
@@ -367,6 +432,9 @@ func updatePoolStatus(
 		})
 		upsert(&p.Conditions, buildAcceptedCondition(pool.Generation, commonCol.ControllerName))
 		upsert(&p.Conditions, buildResolvedRefsCondition(pool.Generation, errs))
+		if pickerCond != nil {
+			upsert(&p.Conditions, *pickerCond)
+		}
 	}
 
 	if irPool.hasErrors() {
@@ -523,3 +591,60 @@ func buildResolvedRefsCondition(gen int64, errs []error) metav1.Condition {
 	cond.Message = fmt.Sprintf("%s %s", prefix, joined)
 	return cond
 }
+
+// buildEndpointPickerReadyCondition reports whether the Pods selected by the endpoint picker
+// Service of the given pool are ready to serve requests.
+func buildEndpointPickerReadyCondition(
+	gen int64,
+	commonCol *collections.CommonCollections,
+	irPool *inferencePool,
+) metav1.Condition {
+	cond := metav1.Condition{
+		Type:               endpointPickerReadyCondition,
+		ObservedGeneration: gen,
+		LastTransitionTime: metav1.Now(),
+	}
+
+	svcNN := types.NamespacedName{Namespace: irPool.configRef.Namespace, Name: irPool.configRef.Name}
+	svc := ptr.Flatten(commonCol.Services.GetKey(svcNN.String()))
+	if svc == nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = endpointPickerReasonServiceNotFound
+		cond.Message = fmt.Sprintf("Endpoint picker Service %s not found", svcNN)
+		return cond
+	}
+	if len(svc.Spec.Selector) == 0 {
+		cond.Status = metav1.ConditionUnknown
+		cond.Reason = endpointPickerReasonUnknown
+		cond.Message = fmt.Sprintf("Endpoint picker Service %s has no selector", svcNN)
+		return cond
+	}
+
+	sel := labels.SelectorFromSet(svc.Spec.Selector)
+	var ready bool
+	for _, pod := range commonCol.WrappedPods.List() {
+		if pod.Namespace != svcNN.Namespace || pod.Terminal || pod.DeletionTimestamp != nil ||
+			!sel.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		if pod.Ready {
+			ready = true
+			break
+		}
+	}
+
+	var mode string
+	if irPool.picker.shadow {
+		mode = " (shadow mode)"
+	}
+	if !ready {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = endpointPickerReasonNoReadyPods
+		cond.Message = fmt.Sprintf("No ready Pods for endpoint picker Service %s%s", svcNN, mode)
+		return cond
+	}
+	cond.Status = metav1.ConditionTrue
+	cond.Reason = endpointPickerReasonReady
+	cond.Message = fmt.Sprintf("Endpoint picker Service %s has ready Pods%s", svcNN, mode)
+	return cond
+}
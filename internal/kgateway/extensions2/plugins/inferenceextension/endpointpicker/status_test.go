@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/kube/krt/krttest"
 	corev1 "k8s.io/api/core/v1"
@@ -18,6 +19,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -748,3 +750,178 @@ func TestBuildResolvedRefsCondition(t *testing.T) {
 	c = buildResolvedRefsCondition(gen, errs)
 	assert.Equal(t, "InferencePool has 2 errors: test error; another error", c.Message)
 }
+
+func TestBuildEndpointPickerReadyCondition(t *testing.T) {
+	gen := int64(1)
+	wrappedPod := func(name string, ready bool) krtcollections.WrappedPod {
+		return krtcollections.WrappedPod{
+			Named:  krt.Named{Namespace: "default", Name: name},
+			Labels: map[string]string{"app": "epp"},
+			Ready:  ready,
+		}
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "epp"},
+		Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "epp"}},
+	}
+	irPool := &inferencePool{
+		configRef: &service{ObjectSource: ir.ObjectSource{Namespace: "default", Name: "epp"}},
+	}
+	newCommonCol := func(objs ...any) *collections.CommonCollections {
+		mock := krttest.NewMock(t, objs)
+		return &collections.CommonCollections{
+			Services:    krttest.GetMockCollection[*corev1.Service](mock),
+			WrappedPods: krttest.GetMockCollection[krtcollections.WrappedPod](mock),
+		}
+	}
+
+	// Some picker Pods are ready
+	c := buildEndpointPickerReadyCondition(gen, newCommonCol(svc, wrappedPod("epp-1", true), wrappedPod("epp-2", false)), irPool)
+	assert.Equal(t, endpointPickerReadyCondition, c.Type)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+	assert.Equal(t, endpointPickerReasonReady, c.Reason)
+	assert.Equal(t, "Endpoint picker Service default/epp has ready Pods", c.Message)
+	assert.Equal(t, gen, c.ObservedGeneration)
+
+	// No picker Pod is ready
+	c = buildEndpointPickerReadyCondition(gen, newCommonCol(svc, wrappedPod("epp-1", false)), irPool)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, endpointPickerReasonNoReadyPods, c.Reason)
+
+	// The picker Service does not exist
+	c = buildEndpointPickerReadyCondition(gen, newCommonCol(wrappedPod("epp-1", true)), irPool)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, endpointPickerReasonServiceNotFound, c.Reason)
+
+	// Shadow mode is surfaced in the message
+	irPool.picker.shadow = true
+	c = buildEndpointPickerReadyCondition(gen, newCommonCol(svc, wrappedPod("epp-1", true)), irPool)
+	assert.Equal(t, "Endpoint picker Service default/epp has ready Pods (shadow mode)", c.Message)
+}
+
+func TestReconcilePoolsForPickerPod_WritesOnlyOnConditionChange(t *testing.T) {
+	ctx := context.Background()
+	ns := "default"
+	poolNN := types.NamespacedName{Namespace: ns, Name: "my-pool"}
+
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "my-route", UID: "uid1"},
+		Spec: gwv1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1.CommonRouteSpec{
+				ParentRefs: []gwv1.ParentReference{{Name: "my-gateway"}},
+			},
+			Rules: []gwv1.HTTPRouteRule{{
+				BackendRefs: []gwv1.HTTPBackendRef{{
+					BackendRef: gwv1.BackendRef{
+						BackendObjectReference: gwv1.BackendObjectReference{
+							Group: ptr.To(gwv1.Group(inf.GroupVersion.Group)),
+							Kind:  ptr.To(gwv1.Kind(wellknown.InferencePoolKind)),
+							Name:  gwv1.ObjectName(poolNN.Name),
+						},
+					},
+				}},
+			}},
+		},
+	}
+	pool := &inf.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: poolNN.Name, Generation: 1},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "epp"},
+		Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "epp"}},
+	}
+	wrappedPod := func(name string, ready bool) krtcollections.WrappedPod {
+		return krtcollections.WrappedPod{
+			Named:  krt.Named{Namespace: ns, Name: name},
+			Labels: map[string]string{"app": "epp"},
+			Ready:  ready,
+		}
+	}
+
+	// Count the status writes issued for the pool
+	var writes int
+	sch := schemes.DefaultScheme()
+	require.NoError(t, inf.Install(sch))
+	fakeClient := fakeclient.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(pool).
+		WithStatusSubresource(&inf.InferencePool{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(
+				ctx context.Context,
+				c client.Client,
+				subResourceName string,
+				obj client.Object,
+				opts ...client.SubResourceUpdateOption,
+			) error {
+				writes++
+				return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	beIR := ir.BackendObjectIR{
+		ObjectSource: ir.ObjectSource{
+			Group:     inf.GroupVersion.Group,
+			Kind:      wellknown.InferencePoolKind,
+			Namespace: poolNN.Namespace,
+			Name:      poolNN.Name,
+		},
+		ObjIr: &inferencePool{
+			configRef: &service{ObjectSource: ir.ObjectSource{Namespace: ns, Name: "epp"}},
+		},
+	}
+	reconcile := func(ev krt.Event[krtcollections.WrappedPod], pods ...krtcollections.WrappedPod) {
+		objs := []any{
+			svc,
+			beIR,
+			ir.HttpRouteIR{
+				ObjectSource: ir.ObjectSource{
+					Group:     gwv1.SchemeGroupVersion.Group,
+					Kind:      "HTTPRoute",
+					Namespace: ns,
+					Name:      route.Name,
+				},
+				SourceObject: route,
+			},
+		}
+		for _, p := range pods {
+			objs = append(objs, p)
+		}
+		mock := krttest.NewMock(t, objs)
+		commonCol := &collections.CommonCollections{
+			CrudClient:  fakeClient,
+			Routes:      fakeRoutesIndex(krttest.GetMockCollection[ir.HttpRouteIR](mock)),
+			Services:    krttest.GetMockCollection[*corev1.Service](mock),
+			WrappedPods: krttest.GetMockCollection[krtcollections.WrappedPod](mock),
+		}
+		reconcilePoolsForPickerPod(ctx, commonCol, krttest.GetMockCollection[ir.BackendObjectIR](mock), ev)
+	}
+
+	// The first ready picker Pod reports the condition
+	pod1 := wrappedPod("epp-1", true)
+	reconcile(krt.Event[krtcollections.WrappedPod]{Event: controllers.EventAdd, New: &pod1}, pod1)
+	assert.Equal(t, 1, writes)
+
+	// Another picker Pod becoming ready does not change the condition
+	pod2 := wrappedPod("epp-2", true)
+	reconcile(krt.Event[krtcollections.WrappedPod]{Event: controllers.EventAdd, New: &pod2}, pod1, pod2)
+	assert.Equal(t, 1, writes)
+
+	// Losing one of the ready picker Pods does not change the condition either
+	reconcile(krt.Event[krtcollections.WrappedPod]{Event: controllers.EventDelete, Old: &pod2}, pod1)
+	assert.Equal(t, 1, writes)
+
+	// The last ready picker Pod going unready is reported
+	unready := wrappedPod("epp-1", false)
+	reconcile(krt.Event[krtcollections.WrappedPod]{Event: controllers.EventUpdate, Old: &pod1, New: &unready}, unready)
+	assert.Equal(t, 2, writes)
+
+	var updated inf.InferencePool
+	require.NoError(t, fakeClient.Get(ctx, poolNN, &updated))
+	require.Len(t, updated.Status.Parents, 1)
+	cond := meta.FindStatusCondition(updated.Status.Parents[0].Conditions, endpointPickerReadyCondition)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, endpointPickerReasonNoReadyPods, cond.Reason)
+}
@@ -34,6 +34,10 @@ func validatePool(pool *inf.InferencePool, svcCol krt.Collection[*corev1.Service
 			fmt.Errorf("invalid InferencePool: must have exactly one target port"))
 	}
 
+	// Endpoint picker annotations must be valid
+	_, annoErrs := parsePickerConfig(pool)
+	errs = append(errs, annoErrs...)
+
 	// Port must be specified when kind is Service
 	if pool.Spec.EndpointPickerRef.Port == nil {
 		errs = append(errs,
@@ -12,6 +12,7 @@ import (
 	"k8s.io/utils/ptr"
 	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
@@ -66,6 +67,14 @@ func TestValidatePool(t *testing.T) {
 			svc:        makeSvc(ns, svcName, 80, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP),
 			wantErrs:   0,
 		},
+		{
+			name: "invalid endpoint picker annotation",
+			modifyPool: func(p *inf.InferencePool) {
+				p.Annotations = map[string]string{apiannotations.EndpointPickerTimeout: "soon"}
+			},
+			svc:      makeSvc(ns, svcName, 80, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP),
+			wantErrs: 1,
+		},
 		{
 			name:       "ExternalName service rejected",
 			modifyPool: func(_ *inf.InferencePool) {},
@@ -0,0 +1,226 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+// TODO: envoy-based AI gateway is deprecated in v2.1 and will be removed in v2.2. This file (and any associated tests) can be removed in v2.2.
+
+const (
+	defaultAICacheTTL                 = time.Hour
+	defaultAICacheSimilarityThreshold = int32(95)
+
+	openAIEmbeddingsPath = "/v1/embeddings"
+)
+
+// aiCacheConfig is the cache config passed to the AI extension.
+// It needs to be defined in the python ai extension in the same format.
+type aiCacheConfig struct {
+	// Policy is the namespace/name of the TrafficPolicy, which scopes the cached responses together
+	// with the route and the backend of the request.
+	Policy     string                 `json:"policy"`
+	TTLSeconds int64                  `json:"ttlSeconds"`
+	Semantic   *aiSemanticCacheConfig `json:"semantic,omitempty"`
+}
+
+type aiSemanticCacheConfig struct {
+	Embeddings          aiEmbeddingsConfig `json:"embeddings"`
+	Redis               *v1alpha1.Host     `json:"redis,omitempty"`
+	SimilarityThreshold int32              `json:"similarityThreshold"`
+}
+
+// aiEmbeddingsConfig is the embeddings endpoint resolved from the referenced AI Backend.
+// The auth token is resolved from the Backend, like the auth token of the OpenAI moderation prompt guard.
+type aiEmbeddingsConfig struct {
+	Host            string `json:"host"`
+	Port            int32  `json:"port"`
+	Path            string `json:"path"`
+	Model           string `json:"model,omitempty"`
+	AuthHeader      string `json:"authHeader"`
+	AuthTokenPrefix string `json:"authTokenPrefix,omitempty"`
+	AuthToken       string `json:"authToken"`
+}
+
+// resolveAICache resolves the cache config of the AI policy, including the embeddings Backend of
+// the semantic cache and its auth token. Returns nil if caching is not configured or disabled.
+func resolveAICache(
+	krtctx krt.HandlerContext,
+	policyCR *v1alpha1.TrafficPolicy,
+	backends *krtcollections.BackendIndex,
+	secrets *krtcollections.SecretIndex,
+) (*aiCacheConfig, error) {
+	cache := policyCR.Spec.AI.Cache
+	if cache == nil || cache.Disable != nil {
+		return nil, nil
+	}
+
+	ttl := defaultAICacheTTL
+	if cache.TTL != nil {
+		ttl = cache.TTL.Duration
+	}
+	out := &aiCacheConfig{
+		Policy:     policyCR.GetNamespace() + "/" + policyCR.GetName(),
+		TTLSeconds: int64(ttl.Seconds()),
+	}
+	if cache.Semantic == nil {
+		return out, nil
+	}
+
+	if cache.Semantic.VectorStore.Redis == nil {
+		return nil, errors.New("cache: a vector store must be set for semantic caching")
+	}
+	if !resolvesReferences(krtctx) {
+		return out, nil
+	}
+	if backends == nil {
+		return nil, errors.New("cache: backends are not available to resolve the embeddings backend")
+	}
+	backendIR, err := backends.GetBackendFromRef(krtctx, ir.ObjectSource{
+		Group:     wellknown.TrafficPolicyGVK.Group,
+		Kind:      wellknown.TrafficPolicyGVK.Kind,
+		Namespace: policyCR.GetNamespace(),
+		Name:      policyCR.GetName(),
+	}, gwv1.BackendObjectReference{
+		Group: ptr.To(gwv1.Group(wellknown.BackendGVK.Group)),
+		Kind:  ptr.To(gwv1.Kind(wellknown.BackendGVK.Kind)),
+		Name:  gwv1.ObjectName(cache.Semantic.Embeddings.Name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cache: failed to resolve embeddings backend %s: %w", cache.Semantic.Embeddings.Name, err)
+	}
+	backend, ok := backendIR.Obj.(*v1alpha1.Backend)
+	if !ok {
+		return nil, fmt.Errorf("cache: embeddings backend %s is not a Backend", cache.Semantic.Embeddings.Name)
+	}
+	embeddings, err := embeddingsConfigForBackend(krtctx, backend, secrets)
+	if err != nil {
+		return nil, fmt.Errorf("cache: embeddings backend %s: %w", backend.GetName(), err)
+	}
+
+	out.Semantic = &aiSemanticCacheConfig{
+		Embeddings:          *embeddings,
+		Redis:               cache.Semantic.VectorStore.Redis,
+		SimilarityThreshold: ptr.Deref(cache.Semantic.SimilarityThreshold, defaultAICacheSimilarityThreshold),
+	}
+	return out, nil
+}
+
+// embeddingsConfigForBackend derives the embeddings endpoint from an AI Backend using the OpenAI or
+// Azure OpenAI LLM provider.
+func embeddingsConfigForBackend(
+	krtctx krt.HandlerContext,
+	backend *v1alpha1.Backend,
+	secrets *krtcollections.SecretIndex,
+) (*aiEmbeddingsConfig, error) {
+	if backend.Spec.Type != v1alpha1.BackendTypeAI || backend.Spec.AI == nil || backend.Spec.AI.LLM == nil {
+		return nil, errors.New("must be an AI backend with a single LLM provider")
+	}
+	llm := backend.Spec.AI.LLM
+
+	var (
+		out       aiEmbeddingsConfig
+		authToken v1alpha1.SingleAuthToken
+	)
+	switch {
+	case llm.OpenAI != nil:
+		out = aiEmbeddingsConfig{
+			Host:            "api.openai.com",
+			Port:            443,
+			Path:            openAIEmbeddingsPath,
+			Model:           ptr.Deref(llm.OpenAI.Model, ""),
+			AuthHeader:      "Authorization",
+			AuthTokenPrefix: "Bearer ",
+		}
+		if out.Model == "" {
+			return nil, errors.New("the model of the openai provider must be set to an embeddings model")
+		}
+		authToken = llm.OpenAI.AuthToken
+	case llm.AzureOpenAI != nil:
+		out = aiEmbeddingsConfig{
+			Host: llm.AzureOpenAI.Endpoint,
+			Port: 443,
+			Path: fmt.Sprintf("/openai/deployments/%s/embeddings?api-version=%s",
+				llm.AzureOpenAI.DeploymentName, llm.AzureOpenAI.ApiVersion),
+			AuthHeader: "api-key",
+		}
+		authToken = llm.AzureOpenAI.AuthToken
+	default:
+		return nil, errors.New("only the openai and azureopenai providers are supported for embeddings")
+	}
+
+	if llm.Host != nil {
+		out.Host = *llm.Host
+	}
+	if llm.Port != nil {
+		out.Port = int32(*llm.Port)
+	}
+	if llm.Path != nil && llm.Path.Full != nil {
+		out.Path = *llm.Path.Full
+	}
+	if llm.AuthHeader != nil {
+		if llm.AuthHeader.HeaderName != nil {
+			out.AuthHeader = *llm.AuthHeader.HeaderName
+		}
+		if llm.AuthHeader.Prefix != nil {
+			out.AuthTokenPrefix = *llm.AuthHeader.Prefix
+		}
+	}
+
+	// The embeddings requests are sent by the AI extension itself, so there is no client token to pass through
+	if authToken.Kind == v1alpha1.Passthrough {
+		return nil, errors.New("passthrough auth tokens are not supported for embeddings")
+	}
+	var secret *ir.Secret
+	if authToken.Kind == v1alpha1.SecretRef && authToken.SecretRef != nil {
+		var err error
+		secret, err = pluginutils.GetSecretIr(secrets, krtctx, authToken.SecretRef.Name, backend.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+	}
+	token, err := pluginutils.GetAuthToken(authToken, secret)
+	if err != nil {
+		return nil, err
+	}
+	out.AuthToken = token
+	return &out, nil
+}
+
+// applyCache passes the resolved cache config to the AI extension through the ext_proc initial metadata.
+func applyCache(cache *aiCacheConfig, extProcRouteSettings *envoy_ext_proc_v3.ExtProcPerRoute) error {
+	if cache == nil {
+		return nil
+	}
+	bin, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+	// Use this in the server to key per-route-config
+	cacheHash, _ := hashUnique(cache, nil)
+	extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(),
+		&envoycorev3.HeaderValue{
+			Key:   "x-cache-config",
+			Value: string(bin),
+		},
+		&envoycorev3.HeaderValue{
+			Key:   "x-cache-config-hash",
+			Value: fmt.Sprint(cacheHash),
+		},
+	)
+	return nil
+}
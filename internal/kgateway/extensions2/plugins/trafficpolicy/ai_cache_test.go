@@ -0,0 +1,244 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+func TestResolveAICacheWithoutSemantic(t *testing.T) {
+	tests := []struct {
+		name     string
+		cache    *v1alpha1.AICache
+		expected *aiCacheConfig
+	}{
+		{
+			name:     "no cache",
+			cache:    nil,
+			expected: nil,
+		},
+		{
+			name:     "default ttl",
+			cache:    &v1alpha1.AICache{},
+			expected: &aiCacheConfig{Policy: "default/policy", TTLSeconds: 3600},
+		},
+		{
+			name:     "custom ttl",
+			cache:    &v1alpha1.AICache{TTL: &metav1.Duration{Duration: 10 * time.Minute}},
+			expected: &aiCacheConfig{Policy: "default/policy", TTLSeconds: 600},
+		},
+		{
+			name:     "disabled",
+			cache:    &v1alpha1.AICache{Disable: &v1alpha1.PolicyDisable{}},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1alpha1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+				Spec: v1alpha1.TrafficPolicySpec{
+					AI: &v1alpha1.AIPolicy{Cache: tt.cache},
+				},
+			}
+			cache, err := resolveAICache(nil, policy, nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cache)
+		})
+	}
+}
+
+func TestEmbeddingsConfigForBackend(t *testing.T) {
+	tests := []struct {
+		name     string
+		llm      *v1alpha1.LLMProvider
+		expected *aiEmbeddingsConfig
+		errMsg   string
+	}{
+		{
+			name: "openai",
+			llm: &v1alpha1.LLMProvider{
+				OpenAI: &v1alpha1.OpenAIConfig{
+					AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.SecretRef, SecretRef: &corev1.LocalObjectReference{Name: "openai-secret"}},
+					Model:     ptr.To("text-embedding-3-small"),
+				},
+			},
+			expected: &aiEmbeddingsConfig{
+				Host:            "api.openai.com",
+				Port:            443,
+				Path:            "/v1/embeddings",
+				Model:           "text-embedding-3-small",
+				AuthHeader:      "Authorization",
+				AuthTokenPrefix: "Bearer ",
+				AuthToken:       "token",
+			},
+		},
+		{
+			name: "openai with overrides",
+			llm: &v1alpha1.LLMProvider{
+				OpenAI: &v1alpha1.OpenAIConfig{
+					AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.SecretRef, SecretRef: &corev1.LocalObjectReference{Name: "openai-secret"}},
+					Model:     ptr.To("nomic-embed-text"),
+				},
+				Host:       ptr.To("ollama.ai.svc.cluster.local"),
+				Port:       ptr.To(gwv1.PortNumber(11434)),
+				Path:       &v1alpha1.PathOverride{Full: ptr.To("/api/embeddings")},
+				AuthHeader: &v1alpha1.AuthHeader{HeaderName: ptr.To("x-api-key"), Prefix: ptr.To("")},
+			},
+			expected: &aiEmbeddingsConfig{
+				Host:       "ollama.ai.svc.cluster.local",
+				Port:       11434,
+				Path:       "/api/embeddings",
+				Model:      "nomic-embed-text",
+				AuthHeader: "x-api-key",
+				AuthToken:  "token",
+			},
+		},
+		{
+			name: "azure openai",
+			llm: &v1alpha1.LLMProvider{
+				AzureOpenAI: &v1alpha1.AzureOpenAIConfig{
+					AuthToken:      v1alpha1.SingleAuthToken{Kind: v1alpha1.SecretRef, SecretRef: &corev1.LocalObjectReference{Name: "openai-secret"}},
+					Endpoint:       "myendpoint.openai.azure.com",
+					DeploymentName: "embeddings",
+					ApiVersion:     "2024-02-01",
+				},
+			},
+			expected: &aiEmbeddingsConfig{
+				Host:       "myendpoint.openai.azure.com",
+				Port:       443,
+				Path:       "/openai/deployments/embeddings/embeddings?api-version=2024-02-01",
+				AuthHeader: "api-key",
+				AuthToken:  "token",
+			},
+		},
+		{
+			name: "openai without model",
+			llm: &v1alpha1.LLMProvider{
+				OpenAI: &v1alpha1.OpenAIConfig{
+					AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.SecretRef, SecretRef: &corev1.LocalObjectReference{Name: "openai-secret"}},
+				},
+			},
+			errMsg: "the model of the openai provider must be set to an embeddings model",
+		},
+		{
+			name: "passthrough token",
+			llm: &v1alpha1.LLMProvider{
+				OpenAI: &v1alpha1.OpenAIConfig{
+					AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.Passthrough},
+					Model:     ptr.To("text-embedding-3-small"),
+				},
+			},
+			errMsg: "passthrough auth tokens are not supported for embeddings",
+		},
+		{
+			name: "inline token",
+			llm: &v1alpha1.LLMProvider{
+				OpenAI: &v1alpha1.OpenAIConfig{
+					AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("inline-token")},
+					Model:     ptr.To("text-embedding-3-small"),
+				},
+			},
+			expected: &aiEmbeddingsConfig{
+				Host:            "api.openai.com",
+				Port:            443,
+				Path:            "/v1/embeddings",
+				Model:           "text-embedding-3-small",
+				AuthHeader:      "Authorization",
+				AuthTokenPrefix: "Bearer ",
+				AuthToken:       "inline-token",
+			},
+		},
+		{
+			name: "missing secret",
+			llm: &v1alpha1.LLMProvider{
+				OpenAI: &v1alpha1.OpenAIConfig{
+					AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.SecretRef, SecretRef: &corev1.LocalObjectReference{Name: "missing"}},
+					Model:     ptr.To("text-embedding-3-small"),
+				},
+			},
+			errMsg: `failed to find secret missing: Secret "missing" not found`,
+		},
+		{
+			name: "unsupported provider",
+			llm: &v1alpha1.LLMProvider{
+				Anthropic: &v1alpha1.AnthropicConfig{
+					AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.SecretRef, SecretRef: &corev1.LocalObjectReference{Name: "openai-secret"}},
+				},
+			},
+			errMsg: "only the openai and azureopenai providers are supported for embeddings",
+		},
+	}
+
+	secrets := krtcollections.NewSecretIndex(map[schema.GroupKind]krt.Collection[ir.Secret]{
+		wellknown.SecretGVK.GroupKind(): krt.NewStaticCollection(nil, []ir.Secret{{
+			ObjectSource: ir.ObjectSource{Kind: "Secret", Namespace: "default", Name: "openai-secret"},
+			Data:         map[string][]byte{"Authorization": []byte("token")},
+		}}),
+	}, nil)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{Name: "embeddings", Namespace: "default"},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeAI,
+					AI:   &v1alpha1.AIBackend{LLM: tt.llm},
+				},
+			}
+			cfg, err := embeddingsConfigForBackend(krt.TestingDummyContext{}, backend, secrets)
+			if tt.errMsg != "" {
+				require.EqualError(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestApplyCache(t *testing.T) {
+	extproc := &envoy_ext_proc_v3.ExtProcPerRoute{
+		Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+			Overrides: &envoy_ext_proc_v3.ExtProcOverrides{},
+		},
+	}
+	require.NoError(t, applyCache(nil, extproc))
+	assert.Empty(t, extproc.GetOverrides().GetGrpcInitialMetadata())
+
+	cache := &aiCacheConfig{
+		Policy:     "default/policy",
+		TTLSeconds: 60,
+		Semantic: &aiSemanticCacheConfig{
+			Embeddings:          aiEmbeddingsConfig{Host: "api.openai.com", Port: 443, AuthToken: "token"},
+			Redis:               &v1alpha1.Host{Host: "redis", Port: 6379},
+			SimilarityThreshold: 90,
+		},
+	}
+	require.NoError(t, applyCache(cache, extproc))
+	md := extproc.GetOverrides().GetGrpcInitialMetadata()
+	require.Len(t, md, 2)
+	assert.Equal(t, "x-cache-config", md[0].GetKey())
+	assert.Equal(t, "x-cache-config-hash", md[1].GetKey())
+	assert.NotEmpty(t, md[1].GetValue())
+
+	var got aiCacheConfig
+	require.NoError(t, json.Unmarshal([]byte(md[0].GetValue()), &got))
+	assert.Equal(t, *cache, got)
+}
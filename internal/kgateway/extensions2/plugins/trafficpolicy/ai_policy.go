@@ -3,6 +3,7 @@ package trafficpolicy
 import (
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"hash"
 	"hash/fnv"
@@ -17,6 +18,8 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -24,6 +27,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/regexutils"
 )
 
 // TODO: envoy-based AI gateway is deprecated in v2.1 and will be removed in v2.2. This file (and any associated tests) can be removed in v2.2.
@@ -109,6 +113,8 @@ func constructAI(
 	krtctx krt.HandlerContext,
 	policyCR *v1alpha1.TrafficPolicy,
 	secrets *krtcollections.SecretIndex,
+	backends *krtcollections.BackendIndex,
+	configMaps krt.Collection[*corev1.ConfigMap],
 	out *trafficPolicySpecIr,
 ) error {
 	if policyCR.Spec.AI == nil {
@@ -123,14 +129,69 @@ func constructAI(
 		return fmt.Errorf("ai: %w", err)
 	}
 	ir.AISecret = secret
+	// The regex pattern sets reference ConfigMaps, so they are expanded here rather than in preProcessAITrafficPolicy
+	aiSpec, err := expandAIPatternSets(krtctx, configMaps, policyCR)
+	if err != nil {
+		return fmt.Errorf("ai: %w", err)
+	}
 	// Preprocess the AI backend
-	if err := preProcessAITrafficPolicy(policyCR.Spec.AI, ir); err != nil {
+	if err := preProcessAITrafficPolicy(aiSpec, ir); err != nil {
+		return fmt.Errorf("ai: %w", err)
+	}
+	// The cache config references other resources, so it is resolved here rather than in preProcessAITrafficPolicy
+	cache, err := resolveAICache(krtctx, policyCR, backends, secrets)
+	if err != nil {
+		return fmt.Errorf("ai: %w", err)
+	}
+	if err := applyCache(cache, ir.Extproc); err != nil {
 		return fmt.Errorf("ai: %w", err)
 	}
 	out.ai = ir
 	return nil
 }
 
+// expandAIPatternSets returns the AI policy of the TrafficPolicy with the regex pattern sets of its
+// prompt guards replaced by the matches of the referenced ConfigMaps.
+func expandAIPatternSets(
+	krtctx krt.HandlerContext,
+	configMaps krt.Collection[*corev1.ConfigMap],
+	policyCR *v1alpha1.TrafficPolicy,
+) (*v1alpha1.AIPolicy, error) {
+	pg := policyCR.Spec.AI.PromptGuard
+	if pg == nil || !resolvesReferences(krtctx) {
+		return policyCR.Spec.AI, nil
+	}
+	getConfigMap := func(name string) *corev1.ConfigMap {
+		cm := krt.FetchOne(krtctx, configMaps, krt.FilterObjectName(types.NamespacedName{
+			Namespace: policyCR.Namespace,
+			Name:      name,
+		}))
+		if cm == nil {
+			return nil
+		}
+		return *cm
+	}
+
+	// Work on a deep copy to avoid mutating the CRD object in memory since agentgateway will need it as well
+	aiSpec := policyCR.Spec.AI.DeepCopy()
+	var errs []error
+	if req := aiSpec.PromptGuard.Request; req != nil {
+		regex, err := regexutils.ExpandPatternSets(req.Regex, getConfigMap)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		req.Regex = regex
+	}
+	if resp := aiSpec.PromptGuard.Response; resp != nil {
+		regex, err := regexutils.ExpandPatternSets(resp.Regex, getConfigMap)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		resp.Regex = regex
+	}
+	return aiSpec, errors.Join(errs...)
+}
+
 func (p *trafficPolicyPluginGwPass) processAITrafficPolicy(
 	configMap *ir.TypedFilterConfigMap,
 	inIr *aiPolicyIR,
@@ -380,6 +441,7 @@ func applyPromptGuard(pg *v1alpha1.AIPromptGuard, extProcRouteSettings *envoy_ex
 	if req := pg.Request; req != nil {
 		// Work on a deep copy to avoid mutating the CRD object in memory since agentgateway will need it as well
 		reqCopy := req.DeepCopy()
+		reqCopy.Regex = expandBuiltinRegexes(reqCopy.Regex)
 		if mod := reqCopy.Moderation; mod != nil {
 			if mod.OpenAIModeration != nil {
 				token, err := pluginutils.GetAuthToken(mod.OpenAIModeration.AuthToken, secret)
@@ -417,6 +479,8 @@ func applyPromptGuard(pg *v1alpha1.AIPromptGuard, extProcRouteSettings *envoy_ex
 	}
 
 	if resp := pg.Response; resp != nil {
+		resp = resp.DeepCopy()
+		resp.Regex = expandBuiltinRegexes(resp.Regex)
 		// Resp needs to be defined in python ai extensions in the same format
 		bin, err := json.Marshal(resp)
 		if err != nil {
@@ -441,6 +505,44 @@ func applyPromptGuard(pg *v1alpha1.AIPromptGuard, extProcRouteSettings *envoy_ex
 	return nil
 }
 
+// expandBuiltinRegexes replaces the built-ins of the regex that are not natively implemented by the
+// AI extension with the equivalent pattern matches, named after the built-in.
+// The regex is modified in place.
+func expandBuiltinRegexes(regex *v1alpha1.Regex) *v1alpha1.Regex {
+	if regex == nil {
+		return nil
+	}
+
+	var builtins []v1alpha1.BuiltIn
+	var matches []v1alpha1.RegexMatch
+	for _, builtin := range regex.Builtins {
+		pattern, ok := regexutils.BuiltinPIIPattern(builtin)
+		if !ok {
+			builtins = append(builtins, builtin)
+			continue
+		}
+		matches = append(matches, v1alpha1.RegexMatch{
+			Name:    ptr.To(string(builtin)),
+			Pattern: ptr.To(pattern),
+		})
+	}
+	for _, match := range regex.Matches {
+		if match.Builtin != nil {
+			if pattern, ok := regexutils.BuiltinPIIPattern(*match.Builtin); ok {
+				if match.Name == nil {
+					match.Name = ptr.To(string(*match.Builtin))
+				}
+				match.Pattern = ptr.To(pattern)
+				match.Builtin = nil
+			}
+		}
+		matches = append(matches, match)
+	}
+	regex.Builtins = builtins
+	regex.Matches = matches
+	return regex
+}
+
 // hashUnique generates a hash of the struct that is unique to the object by
 // hashing the entire structure using hashstructure.
 func hashUnique(obj interface{}, hasher hash.Hash64) (uint64, error) {
@@ -502,8 +604,8 @@ func aiSecretForSpec(
 	}
 
 	secretRef := policyCR.Spec.AI.PromptGuard.Request.Moderation.OpenAIModeration.AuthToken.SecretRef
-	if secretRef == nil {
-		// no secret ref is set
+	if secretRef == nil || !resolvesReferences(krtctx) {
+		// no secret ref is set, or it is not resolved
 		return nil, nil
 	}
 
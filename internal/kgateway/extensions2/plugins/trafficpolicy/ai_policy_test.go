@@ -17,6 +17,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/regexutils"
 )
 
 func TestAIPolicyIREquals(t *testing.T) {
@@ -542,6 +543,62 @@ func TestDefault(t *testing.T) {
 	}
 }
 
+func TestExpandBuiltinRegexes(t *testing.T) {
+	ibanPattern, ok := regexutils.BuiltinPIIPattern(v1alpha1.IBAN)
+	require.True(t, ok)
+	jwtPattern, ok := regexutils.BuiltinPIIPattern(v1alpha1.JWT)
+	require.True(t, ok)
+
+	regex := &v1alpha1.Regex{
+		Builtins: []v1alpha1.BuiltIn{v1alpha1.SSN, v1alpha1.IBAN},
+		Matches: []v1alpha1.RegexMatch{
+			{Name: ptr.To("CUSTOM"), Pattern: ptr.To("custom")},
+			{Builtin: ptr.To(v1alpha1.JWT), Action: ptr.To(v1alpha1.LOG)},
+			{Name: ptr.To("email"), Builtin: ptr.To(v1alpha1.EMAIL), Action: ptr.To(v1alpha1.REJECT)},
+		},
+		Action: ptr.To(v1alpha1.MASK),
+	}
+
+	expanded := expandBuiltinRegexes(regex)
+	// built-ins natively implemented by the AI extension are kept as is
+	assert.Equal(t, []v1alpha1.BuiltIn{v1alpha1.SSN}, expanded.Builtins)
+	assert.Equal(t, []v1alpha1.RegexMatch{
+		{Name: ptr.To("IBAN"), Pattern: ptr.To(ibanPattern)},
+		{Name: ptr.To("CUSTOM"), Pattern: ptr.To("custom")},
+		{Name: ptr.To("JWT"), Pattern: ptr.To(jwtPattern), Action: ptr.To(v1alpha1.LOG)},
+		{Name: ptr.To("email"), Builtin: ptr.To(v1alpha1.EMAIL), Action: ptr.To(v1alpha1.REJECT)},
+	}, expanded.Matches)
+	assert.Equal(t, ptr.To(v1alpha1.MASK), expanded.Action)
+}
+
+func TestApplyPromptGuardExpandsBuiltins(t *testing.T) {
+	pg := &v1alpha1.AIPromptGuard{
+		Request: &v1alpha1.PromptguardRequest{
+			Regex: &v1alpha1.Regex{Builtins: []v1alpha1.BuiltIn{v1alpha1.API_KEY}},
+		},
+		Response: &v1alpha1.PromptguardResponse{
+			Regex: &v1alpha1.Regex{Builtins: []v1alpha1.BuiltIn{v1alpha1.PRIVATE_KEY}},
+		},
+	}
+	extProcRouteSettings := &envoy_ext_proc_v3.ExtProcPerRoute{
+		Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+			Overrides: &envoy_ext_proc_v3.ExtProcOverrides{},
+		},
+	}
+
+	require.NoError(t, applyPromptGuard(pg, extProcRouteSettings, nil))
+	md := map[string]string{}
+	for _, h := range extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata() {
+		md[h.GetKey()] = h.GetValue()
+	}
+	assert.Contains(t, md["x-req-guardrails-config"], `"name":"API_KEY"`)
+	assert.NotContains(t, md["x-req-guardrails-config"], `"builtins"`)
+	assert.Contains(t, md["x-resp-guardrails-config"], `"name":"PRIVATE_KEY"`)
+	// the policy is not modified
+	assert.Equal(t, []v1alpha1.BuiltIn{v1alpha1.API_KEY}, pg.Request.Regex.Builtins)
+	assert.Equal(t, []v1alpha1.BuiltIn{v1alpha1.PRIVATE_KEY}, pg.Response.Regex.Builtins)
+}
+
 // Mock implementation of RouteBackendContext for testing
 func (ir *RouteBackendContext) NewRouteBackendContext() *RouteBackendContext {
 	return &RouteBackendContext{
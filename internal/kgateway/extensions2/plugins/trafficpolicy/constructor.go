@@ -6,12 +6,14 @@ import (
 
 	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
 )
 
 // FetchGatewayExtensionFunc defines the signature for fetching gateway extensions
@@ -39,6 +41,9 @@ func NewTrafficPolicyConstructor(
 	}
 }
 
+// ConstructIR constructs the IR of the TrafficPolicy. krtctx is nil when the policy is validated before
+// it is persisted: the resources it references are then not resolved, and the parts of the IR that
+// depend on them are left unset.
 func (c *TrafficPolicyConstructor) ConstructIR(
 	krtctx krt.HandlerContext,
 	policyCR *v1alpha1.TrafficPolicy,
@@ -48,52 +53,54 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	}
 	outSpec := trafficPolicySpecIr{}
 
+	// errors are annotated with the path of the field that caused them, for the validating webhook
+	specPath := field.NewPath("spec")
 	var errors []error
 	// Construct AI specific IR
-	if err := constructAI(krtctx, policyCR, c.commoncol.Secrets, &outSpec); err != nil {
-		errors = append(errors, err)
+	if err := constructAI(krtctx, policyCR, c.commoncol.Secrets, c.commoncol.BackendIndex, c.commoncol.ConfigMaps, &outSpec); err != nil {
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("ai"), err))
 	}
 	// Construct transformation specific IR
 	if err := constructTransformation(policyCR, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("transformation"), err))
 	}
 	// Construct rustformation specific IR
 	if err := constructRustformation(policyCR, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("transformation"), err))
 	}
 	// Construct extproc specific IR
 	if err := constructExtProc(krtctx, policyCR, c.FetchGatewayExtension, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("extProc"), err))
 	}
 	// Construct extauth specific IR
 	if err := constructExtAuth(krtctx, policyCR, c.FetchGatewayExtension, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("extAuth"), err))
 	}
 	// Construct local rate limit specific IR
 	if err := constructLocalRateLimit(policyCR, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("rateLimit", "local"), err))
 	}
 	// Construct global rate limit specific IR
 	if err := constructGlobalRateLimit(krtctx, policyCR, c.FetchGatewayExtension, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("rateLimit", "global"), err))
 	}
 	// Construct cors specific IR
 	if err := constructCORS(policyCR, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("cors"), err))
 	}
 	// Construct csrf specific IR
 	if err := constructCSRF(policyCR.Spec, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("csrf"), err))
 	}
 
 	// Construct header modifiers specific IR
 	if err := constructHeaderModifiers(policyCR.Spec, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("headerModifiers"), err))
 	}
 
 	// Construct header modifiers specific IR
 	if err := constructHeaderModifiers(policyCR.Spec, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("headerModifiers"), err))
 	}
 
 	// Construct auto host rewrite specific IR
@@ -105,12 +112,12 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 
 	// Construct rbac specific IR
 	if err := constructRBAC(policyCR, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("rbac"), err))
 	}
 
 	// Construct rbac specific IR
 	if err := constructRBAC(policyCR, &outSpec); err != nil {
-		errors = append(errors, err)
+		errors = append(errors, pluginsdkutils.WithFieldPath(specPath.Child("rbac"), err))
 	}
 
 	for _, err := range errors {
@@ -121,6 +128,12 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	return &policyIr, errors
 }
 
+// resolvesReferences reports whether the resources referenced by a policy are resolved while
+// constructing its IR, see ConstructIR.
+func resolvesReferences(krtctx krt.HandlerContext) bool {
+	return krtctx != nil
+}
+
 func (c *TrafficPolicyConstructor) FetchGatewayExtension(krtctx krt.HandlerContext, extensionRef v1alpha1.NamespacedObjectReference, ns string) (*TrafficPolicyGatewayExtensionIR, error) {
 	namespace := ptr.Deref(extensionRef.Namespace, "")
 	if namespace == "" {
@@ -0,0 +1,147 @@
+package trafficpolicy
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+)
+
+func TestConstructIRFields(t *testing.T) {
+	tests := []struct {
+		// field is the json name of the TrafficPolicySpec field under test
+		field string
+		spec  v1alpha1.TrafficPolicySpec
+		check func(t *testing.T, spec trafficPolicySpecIr)
+	}{
+		{
+			field: "ai",
+			spec:  v1alpha1.TrafficPolicySpec{AI: &v1alpha1.AIPolicy{RouteType: ptr.To(v1alpha1.CHAT_STREAMING)}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.ai) },
+		},
+		{
+			field: "transformation",
+			spec: v1alpha1.TrafficPolicySpec{Transformation: &v1alpha1.TransformationPolicy{
+				Request: &v1alpha1.Transform{Set: []v1alpha1.HeaderTransformation{{Name: "x-test", Value: "value"}}},
+			}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.transformation) },
+		},
+		{
+			field: "extProc",
+			spec:  v1alpha1.TrafficPolicySpec{ExtProc: &v1alpha1.ExtProcPolicy{Disable: &v1alpha1.PolicyDisable{}}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.extProc) },
+		},
+		{
+			field: "extAuth",
+			spec:  v1alpha1.TrafficPolicySpec{ExtAuth: &v1alpha1.ExtAuthPolicy{Disable: &v1alpha1.PolicyDisable{}}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.extAuth) },
+		},
+		{
+			field: "rateLimit",
+			spec: v1alpha1.TrafficPolicySpec{RateLimit: &v1alpha1.RateLimit{
+				Local: &v1alpha1.LocalRateLimitPolicy{TokenBucket: &v1alpha1.TokenBucket{
+					MaxTokens:    10,
+					FillInterval: metav1.Duration{Duration: time.Second},
+				}},
+			}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.localRateLimit) },
+		},
+		{
+			field: "cors",
+			spec: v1alpha1.TrafficPolicySpec{Cors: &v1alpha1.CorsPolicy{HTTPCORSFilter: &gwv1.HTTPCORSFilter{
+				AllowOrigins: []gwv1.AbsoluteURI{"https://example.com"},
+			}}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.cors) },
+		},
+		{
+			field: "csrf",
+			spec:  v1alpha1.TrafficPolicySpec{Csrf: &v1alpha1.CSRFPolicy{PercentageEnabled: ptr.To(int32(100))}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.csrf) },
+		},
+		{
+			field: "headerModifiers",
+			spec: v1alpha1.TrafficPolicySpec{HeaderModifiers: &v1alpha1.HeaderModifiers{
+				Request: &gwv1.HTTPHeaderFilter{Set: []gwv1.HTTPHeader{{Name: "x-req", Value: "a"}}},
+			}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.headerModifiers) },
+		},
+		{
+			field: "autoHostRewrite",
+			spec:  v1alpha1.TrafficPolicySpec{AutoHostRewrite: ptr.To(true)},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.autoHostRewrite) },
+		},
+		{
+			field: "buffer",
+			spec:  v1alpha1.TrafficPolicySpec{Buffer: &v1alpha1.Buffer{MaxRequestSize: ptr.To(resource.MustParse("1Mi"))}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.buffer) },
+		},
+		{
+			field: "timeouts",
+			spec:  v1alpha1.TrafficPolicySpec{Timeouts: &v1alpha1.Timeouts{Request: &metav1.Duration{Duration: 10 * time.Second}}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.timeouts) },
+		},
+		{
+			field: "retry",
+			spec: v1alpha1.TrafficPolicySpec{Retry: &v1alpha1.Retry{
+				Attempts:    3,
+				StatusCodes: []gwv1.HTTPRouteRetryStatusCode{503},
+			}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.retry) },
+		},
+		{
+			field: "rbac",
+			spec: v1alpha1.TrafficPolicySpec{RBAC: &v1alpha1.RBAC{
+				Policy: v1alpha1.RBACPolicy{MatchExpressions: []string{"request.headers['x-user'] == 'admin'"}},
+			}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.NotNil(t, spec.rbac) },
+		},
+		{
+			// MCP policies only apply to agentgateway and are ignored by Envoy
+			field: "mcp",
+			spec: v1alpha1.TrafficPolicySpec{MCP: &v1alpha1.MCPPolicy{Authorization: []v1alpha1.MCPAuthorizationRule{{
+				Names:            []string{"delete_repo"},
+				MatchExpressions: []string{"jwt.role == 'admin'"},
+			}}}},
+			check: func(t *testing.T, spec trafficPolicySpecIr) { assert.Equal(t, trafficPolicySpecIr{}, spec) },
+		},
+	}
+
+	// every TrafficPolicySpec field must be covered, so that new fields are not silently dropped
+	covered := sets.New[string]()
+	for _, tt := range tests {
+		covered.Insert(tt.field)
+	}
+	specType := reflect.TypeOf(v1alpha1.TrafficPolicySpec{})
+	for i := range specType.NumField() {
+		field := strings.Split(specType.Field(i).Tag.Get("json"), ",")[0]
+		if field == "targetRefs" || field == "targetSelectors" {
+			continue
+		}
+		assert.True(t, covered.Has(field), "TrafficPolicySpec field %s is not covered", field)
+	}
+
+	constructor := &TrafficPolicyConstructor{commoncol: &collections.CommonCollections{}}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			policy := &v1alpha1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			policyIR, errs := constructor.ConstructIR(krt.TestingDummyContext{}, policy)
+			require.Empty(t, errs)
+			tt.check(t, policyIR.spec)
+		})
+	}
+}
@@ -121,6 +121,10 @@ func constructExtAuth(
 		return nil
 	}
 
+	if !resolvesReferences(krtctx) {
+		return nil
+	}
+
 	// kubebuilder validation ensures the extensionRef is not nil, since disable is nil
 	provider, err := fetchGatewayExtension(krtctx, *spec.ExtensionRef, in.GetNamespace())
 	if err != nil {
@@ -106,6 +106,10 @@ func constructExtProc(
 		return nil
 	}
 
+	if !resolvesReferences(krtctx) {
+		return nil
+	}
+
 	// kubebuilder validation ensures the extensionRef is not nil, since disable is nil
 	gatewayExtension, err := fetchGatewayExtension(krtctx, *spec.ExtensionRef, in.GetNamespace())
 	if err != nil {
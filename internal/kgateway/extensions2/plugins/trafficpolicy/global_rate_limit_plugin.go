@@ -4,21 +4,35 @@ import (
 	"errors"
 	"fmt"
 
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
 	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
 )
 
+// tokenHitsAddendFormat extracts the total number of tokens of a request from the dynamic
+// metadata emitted by the AI extension once the LLM response has been processed.
+const tokenHitsAddendFormat = "%DYNAMIC_METADATA(ai.kgateway.io:total_tokens)%"
+
+// tokenRateLimitHeader tells the AI extension that the route is rate limited on the total tokens, so that
+// it does not also add the prompt tokens to the rate limit on the request path
+const tokenRateLimitHeader = "x-token-rate-limit"
+
 // globalRateLimitIR represents the intermediate representation for a global rate limit policy.
 type globalRateLimitIR struct {
 	provider         *TrafficPolicyGatewayExtensionIR
 	rateLimitActions []*envoyroutev3.RateLimit
+	// tokens is true if a descriptor counts the tokens reported by the AI extension
+	tokens bool
 }
 
 var _ PolicySubIR = &globalRateLimitIR{}
@@ -36,6 +50,9 @@ func (r *globalRateLimitIR) Equals(other PolicySubIR) bool {
 		return false
 	}
 
+	if r.tokens != otherGlobalRateLimit.tokens {
+		return false
+	}
 	if len(r.rateLimitActions) != len(otherGlobalRateLimit.rateLimitActions) {
 		return false
 	}
@@ -84,11 +101,42 @@ func constructGlobalRateLimit(
 	}
 
 	globalPolicy := in.Spec.RateLimit.Global
-	// Create rate limit actions for the route or vhost
-	actions, err := createRateLimitActions(globalPolicy.Descriptors)
-	if err != nil {
-		return fmt.Errorf("failed to create rate limit actions: %w", err)
+	if len(globalPolicy.Descriptors) == 0 {
+		return errors.New("failed to create rate limit actions: at least one descriptor is required for global rate limiting")
 	}
+
+	var requestDescriptors, tokenDescriptors []v1alpha1.RateLimitDescriptor
+	for _, descriptor := range globalPolicy.Descriptors {
+		if descriptor.Unit == v1alpha1.RateLimitUnitTokens {
+			tokenDescriptors = append(tokenDescriptors, descriptor)
+		} else {
+			requestDescriptors = append(requestDescriptors, descriptor)
+		}
+	}
+
+	var rateLimits []*envoyroutev3.RateLimit
+	if len(requestDescriptors) > 0 {
+		// Create rate limit actions for the route or vhost
+		actions, err := createRateLimitActions(requestDescriptors)
+		if err != nil {
+			return fmt.Errorf("failed to create rate limit actions: %w", err)
+		}
+		rateLimits = append(rateLimits, &envoyroutev3.RateLimit{
+			Actions: actions,
+		})
+	}
+	if len(tokenDescriptors) > 0 {
+		tokenRateLimits, err := createTokenRateLimits(tokenDescriptors)
+		if err != nil {
+			return fmt.Errorf("failed to create token rate limit actions: %w", err)
+		}
+		rateLimits = append(rateLimits, tokenRateLimits...)
+	}
+
+	if !resolvesReferences(krtctx) {
+		return nil
+	}
+
 	gwExtIR, err := fetchGatewayExtension(krtctx, globalPolicy.ExtensionRef, in.GetNamespace())
 	if err != nil {
 		return fmt.Errorf("ratelimit: %w", err)
@@ -98,14 +146,47 @@ func constructGlobalRateLimit(
 	}
 	// Create route rate limits and store in the RateLimitIR struct
 	out.globalRateLimit = &globalRateLimitIR{
-		provider: gwExtIR,
-		rateLimitActions: []*envoyroutev3.RateLimit{
-			{
+		provider:         gwExtIR,
+		rateLimitActions: rateLimits,
+		tokens:           len(tokenDescriptors) > 0,
+	}
+	return nil
+}
+
+// createTokenRateLimits translates descriptors counting LLM tokens to Envoy route config rate limits.
+// Each descriptor produces two rate limits sharing the same actions:
+//   - one applied on the request path, which rejects the request once the budget is exhausted. The
+//     rate limit service counts a request without hits addend, or with a zero one, as one hit, so each
+//     request is debited one hit when it is admitted.
+//   - one applied when the stream completes, which debits the total tokens reported by the AI
+//     extension in the response dynamic metadata, for both buffered and streaming responses
+//
+// A request is therefore debited its total tokens plus one. The AI extension does not add the prompt
+// tokens on the request path of these routes, see tokenRateLimitHeader.
+func createTokenRateLimits(descriptors []v1alpha1.RateLimitDescriptor) ([]*envoyroutev3.RateLimit, error) {
+	var result []*envoyroutev3.RateLimit
+	for _, descriptor := range descriptors {
+		actions, err := createRateLimitActions([]v1alpha1.RateLimitDescriptor{descriptor})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result,
+			&envoyroutev3.RateLimit{
+				Actions: actions,
+				HitsAddend: &envoyroutev3.RateLimit_HitsAddend{
+					Number: wrapperspb.UInt64(1),
+				},
+			},
+			&envoyroutev3.RateLimit{
 				Actions: actions,
+				HitsAddend: &envoyroutev3.RateLimit_HitsAddend{
+					Format: tokenHitsAddendFormat,
+				},
+				ApplyOnStreamDone: true,
 			},
-		},
+		)
 	}
-	return nil
+	return result, nil
 }
 
 // createRateLimitActions translates the API descriptors to Envoy route config rate limit actions
@@ -207,3 +288,20 @@ func (p *trafficPolicyPluginGwPass) handleGlobalRateLimit(fcn string, typedFilte
 	}
 	typedFilterConfig.AddTypedConfig(getRateLimitFilterName(providerName), rateLimitPerRoute)
 }
+
+// handleTokenRateLimit tells the AI extension of the route that the global rate limit counts tokens
+func handleTokenRateLimit(typedFilterConfig *ir.TypedFilterConfigMap, globalRateLimit *globalRateLimitIR) {
+	if globalRateLimit == nil || !globalRateLimit.tokens {
+		return
+	}
+	extProc, ok := typedFilterConfig.GetTypedConfig(wellknown.AIExtProcFilterName).(*envoy_ext_proc_v3.ExtProcPerRoute)
+	if !ok || extProc.GetOverrides() == nil {
+		return
+	}
+	extProc = proto.Clone(extProc).(*envoy_ext_proc_v3.ExtProcPerRoute)
+	extProc.GetOverrides().GrpcInitialMetadata = append(extProc.GetOverrides().GetGrpcInitialMetadata(), &envoycorev3.HeaderValue{
+		Key:   tokenRateLimitHeader,
+		Value: "true",
+	})
+	typedFilterConfig.AddTypedConfig(wellknown.AIExtProcFilterName, extProc)
+}
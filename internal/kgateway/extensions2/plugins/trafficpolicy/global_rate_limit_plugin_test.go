@@ -9,6 +9,7 @@ import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
 	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 func TestGlobalRateLimitIREquals(t *testing.T) {
@@ -370,6 +372,92 @@ func TestCreateRateLimitActions(t *testing.T) {
 	}
 }
 
+func TestCreateTokenRateLimits(t *testing.T) {
+	descriptors := []v1alpha1.RateLimitDescriptor{
+		{
+			Entries: []v1alpha1.RateLimitDescriptorEntry{
+				{
+					Type:   v1alpha1.RateLimitDescriptorEntryTypeHeader,
+					Header: ptr.To("x-api-key"),
+				},
+			},
+			Unit: v1alpha1.RateLimitUnitTokens,
+		},
+	}
+
+	rateLimits, err := createTokenRateLimits(descriptors)
+	require.NoError(t, err)
+	require.Len(t, rateLimits, 2)
+
+	// The request path check debits one hit, as the rate limit service counts a zero addend as one
+	enforce := rateLimits[0]
+	require.Len(t, enforce.GetActions(), 1)
+	assert.Equal(t, "x-api-key", enforce.GetActions()[0].GetRequestHeaders().GetHeaderName())
+	assert.Equal(t, uint64(1), enforce.GetHitsAddend().GetNumber().GetValue())
+	assert.False(t, enforce.GetApplyOnStreamDone())
+
+	// The reported total tokens are debited once the response completes, so a request is debited its
+	// total tokens plus one
+	debit := rateLimits[1]
+	assert.Equal(t, enforce.GetActions(), debit.GetActions())
+	assert.Equal(t, tokenHitsAddendFormat, debit.GetHitsAddend().GetFormat())
+	assert.True(t, debit.GetApplyOnStreamDone())
+
+	for _, rl := range rateLimits {
+		require.NoError(t, rl.ValidateAll())
+	}
+
+	_, err = createTokenRateLimits([]v1alpha1.RateLimitDescriptor{
+		{
+			Entries: []v1alpha1.RateLimitDescriptorEntry{
+				{Type: v1alpha1.RateLimitDescriptorEntryTypeHeader},
+			},
+			Unit: v1alpha1.RateLimitUnitTokens,
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "header entry requires Header field to be set")
+}
+
+func TestHandleTokenRateLimit(t *testing.T) {
+	newExtProc := func() *envoy_ext_proc_v3.ExtProcPerRoute {
+		return &envoy_ext_proc_v3.ExtProcPerRoute{
+			Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+				Overrides: &envoy_ext_proc_v3.ExtProcOverrides{
+					GrpcInitialMetadata: []*envoycorev3.HeaderValue{{Key: "x-chat-streaming", Value: "true"}},
+				},
+			},
+		}
+	}
+
+	t.Run("adds the token rate limit header to the AI extension metadata", func(t *testing.T) {
+		extProc := newExtProc()
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		typedFilterConfig.AddTypedConfig(wellknown.AIExtProcFilterName, extProc)
+
+		handleTokenRateLimit(&typedFilterConfig, &globalRateLimitIR{tokens: true})
+
+		got := typedFilterConfig.GetTypedConfig(wellknown.AIExtProcFilterName).(*envoy_ext_proc_v3.ExtProcPerRoute)
+		md := got.GetOverrides().GetGrpcInitialMetadata()
+		require.Len(t, md, 2)
+		assert.Equal(t, "x-chat-streaming", md[0].GetKey())
+		assert.Equal(t, tokenRateLimitHeader, md[1].GetKey())
+		assert.Equal(t, "true", md[1].GetValue())
+		// the config of the policy is not modified
+		assert.Len(t, extProc.GetOverrides().GetGrpcInitialMetadata(), 1)
+	})
+
+	t.Run("ignores request rate limits", func(t *testing.T) {
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		typedFilterConfig.AddTypedConfig(wellknown.AIExtProcFilterName, newExtProc())
+
+		handleTokenRateLimit(&typedFilterConfig, &globalRateLimitIR{})
+
+		got := typedFilterConfig.GetTypedConfig(wellknown.AIExtProcFilterName).(*envoy_ext_proc_v3.ExtProcPerRoute)
+		assert.Len(t, got.GetOverrides().GetGrpcInitialMetadata(), 1)
+	})
+}
+
 func TestToRateLimitFilterConfig(t *testing.T) {
 	defaultExtensionName := "test-ratelimit"
 	defaultNamespace := "test-namespace"
@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/watch"
 
 	// TODO(nfuden): remove once rustformations are able to be used in a production environment
@@ -167,23 +168,28 @@ func (d *TrafficPolicy) Equals(in any) bool {
 // to each policy sub-IR's Validate() method. This follows the exact same pattern as the Equals() method.
 // PGV validation is always performed regardless of route replacement mode.
 func (p *TrafficPolicy) Validate() error {
-	var validators []func() error
-	validators = append(validators, p.spec.ai.Validate)
-	validators = append(validators, p.spec.transformation.Validate)
-	validators = append(validators, p.spec.rustformation.Validate)
-	validators = append(validators, p.spec.localRateLimit.Validate)
-	validators = append(validators, p.spec.globalRateLimit.Validate)
-	validators = append(validators, p.spec.extProc.Validate)
-	validators = append(validators, p.spec.extAuth.Validate)
-	validators = append(validators, p.spec.csrf.Validate)
-	validators = append(validators, p.spec.cors.Validate)
-	validators = append(validators, p.spec.headerModifiers.Validate)
-	validators = append(validators, p.spec.buffer.Validate)
-	validators = append(validators, p.spec.autoHostRewrite.Validate)
-	validators = append(validators, p.spec.rbac.Validate)
-	for _, validator := range validators {
-		if err := validator(); err != nil {
-			return err
+	specPath := field.NewPath("spec")
+	validators := []struct {
+		path     *field.Path
+		validate func() error
+	}{
+		{specPath.Child("ai"), p.spec.ai.Validate},
+		{specPath.Child("transformation"), p.spec.transformation.Validate},
+		{specPath.Child("transformation"), p.spec.rustformation.Validate},
+		{specPath.Child("rateLimit", "local"), p.spec.localRateLimit.Validate},
+		{specPath.Child("rateLimit", "global"), p.spec.globalRateLimit.Validate},
+		{specPath.Child("extProc"), p.spec.extProc.Validate},
+		{specPath.Child("extAuth"), p.spec.extAuth.Validate},
+		{specPath.Child("csrf"), p.spec.csrf.Validate},
+		{specPath.Child("cors"), p.spec.cors.Validate},
+		{specPath.Child("headerModifiers"), p.spec.headerModifiers.Validate},
+		{specPath.Child("buffer"), p.spec.buffer.Validate},
+		{specPath.Child("autoHostRewrite"), p.spec.autoHostRewrite.Validate},
+		{specPath.Child("rbac"), p.spec.rbac.Validate},
+	}
+	for _, v := range validators {
+		if err := v.validate(); err != nil {
+			return pluginsdkutils.WithFieldPath(v.path, err)
 		}
 	}
 	return nil
@@ -239,7 +245,7 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, me
 	constructor := NewTrafficPolicyConstructor(ctx, commoncol)
 
 	// TrafficPolicy IR will have TypedConfig -> implement backendroute method to add prompt guard, etc.
-	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, policyCR *v1alpha1.TrafficPolicy) *ir.PolicyWrapper {
+	buildPolicy := func(krtctx krt.HandlerContext, policyCR *v1alpha1.TrafficPolicy) *ir.PolicyWrapper {
 		objSrc := ir.ObjectSource{
 			Group:     gk.Group,
 			Kind:      gk.Kind,
@@ -254,7 +260,7 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, me
 		}
 		precedenceWeight, err := pluginsdkutils.ParsePrecedenceWeightAnnotation(policyCR.Annotations, apiannotations.PolicyPrecedenceWeight)
 		if err != nil {
-			errors = append(errors, err)
+			errors = append(errors, pluginsdkutils.WithFieldPath(field.NewPath("metadata", "annotations").Key(apiannotations.PolicyPrecedenceWeight), err))
 		}
 
 		pol := &ir.PolicyWrapper{
@@ -266,7 +272,8 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, me
 			PrecedenceWeight: precedenceWeight,
 		}
 		return pol
-	})
+	}
+	policyCol := krt.NewCollection(col, buildPolicy)
 
 	return sdk.Plugin{
 		ContributesPolicies: map[schema.GroupKind]sdk.PolicyPlugin{
@@ -278,6 +285,13 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, me
 				},
 				GetPolicyStatus:   getPolicyStatusFn(commoncol.CrudClient),
 				PatchPolicyStatus: patchPolicyStatusFn(commoncol.CrudClient),
+				ValidatePolicy: func(obj metav1.Object) []error {
+					policyCR, ok := obj.(*v1alpha1.TrafficPolicy)
+					if !ok {
+						return nil
+					}
+					return validatePolicy(ctx, constructor, policyCR)
+				},
 			},
 		},
 		ExtraHasSynced: constructor.HasSynced,
@@ -403,6 +417,7 @@ func (p *trafficPolicyPluginGwPass) ApplyForRoute(pCtx *ir.RouteContext, outputR
 		if len(aiBackends) > 0 {
 			// Apply the AI policy to the all AI backends
 			p.processAITrafficPolicy(&pCtx.TypedFilterConfig, policy.spec.ai)
+			handleTokenRateLimit(&pCtx.TypedFilterConfig, policy.spec.globalRateLimit)
 		}
 	}
 
@@ -425,6 +440,7 @@ func (p *trafficPolicyPluginGwPass) ApplyForRouteBackend(
 
 	if rtPolicy.spec.ai != nil && (rtPolicy.spec.ai.Transformation != nil || rtPolicy.spec.ai.Extproc != nil) {
 		p.processAITrafficPolicy(&pCtx.TypedFilterConfig, rtPolicy.spec.ai)
+		handleTokenRateLimit(&pCtx.TypedFilterConfig, rtPolicy.spec.globalRateLimit)
 	}
 
 	return nil
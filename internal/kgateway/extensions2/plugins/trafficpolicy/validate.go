@@ -5,10 +5,14 @@ import (
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	pluginsdkir "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
 	"github.com/kgateway-dev/kgateway/v2/pkg/xds/bootstrap"
 )
@@ -29,6 +33,22 @@ func validateWithValidationLevel(ctx context.Context, p *TrafficPolicy, v valida
 	return nil
 }
 
+// validatePolicy validates a TrafficPolicy before it is persisted. The resources it references are not
+// resolved, see TrafficPolicyConstructor.ConstructIR. The policy is applied to a placeholder route the
+// way the translator applies it, and the resulting config is validated in-process, without envoy.
+func validatePolicy(ctx context.Context, c *TrafficPolicyConstructor, policyCR *v1alpha1.TrafficPolicy) []error {
+	p, errs := c.ConstructIR(nil, policyCR)
+	if err := p.Validate(); err != nil {
+		errs = append(errs, err)
+	} else if err := validateXDS(ctx, p, validator.NewProto()); err != nil {
+		errs = append(errs, pluginsdkutils.WithFieldPath(field.NewPath("spec"), err))
+	}
+	if _, err := pluginsdkutils.ParsePrecedenceWeightAnnotation(policyCR.Annotations, apiannotations.PolicyPrecedenceWeight); err != nil {
+		errs = append(errs, pluginsdkutils.WithFieldPath(field.NewPath("metadata", "annotations").Key(apiannotations.PolicyPrecedenceWeight), err))
+	}
+	return errs
+}
+
 // validateXDS performs only xDS validation by building a partial bootstrap config and validating
 // it via envoy validate mode. It re-uses the ApplyForRoute method to ensure that the translation
 // and validation logic go through the same code path as normal.
@@ -0,0 +1,86 @@
+package trafficpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	missingExtension := v1alpha1.NamespacedObjectReference{Name: "missing"}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		spec        v1alpha1.TrafficPolicySpec
+		wantFields  []string
+	}{
+		{
+			name: "unresolved references are not rejected",
+			spec: v1alpha1.TrafficPolicySpec{
+				ExtAuth: &v1alpha1.ExtAuthPolicy{ExtensionRef: &missingExtension},
+				ExtProc: &v1alpha1.ExtProcPolicy{ExtensionRef: &missingExtension},
+				RateLimit: &v1alpha1.RateLimit{Global: &v1alpha1.RateLimitPolicy{
+					ExtensionRef: missingExtension,
+					Descriptors: []v1alpha1.RateLimitDescriptor{{
+						Entries: []v1alpha1.RateLimitDescriptorEntry{{
+							Type:    v1alpha1.RateLimitDescriptorEntryTypeGeneric,
+							Generic: &v1alpha1.RateLimitDescriptorEntryGeneric{Key: "service", Value: "api"},
+						}},
+					}},
+				}},
+			},
+		},
+		{
+			name: "valid spec is accepted",
+			spec: v1alpha1.TrafficPolicySpec{
+				Transformation: &v1alpha1.TransformationPolicy{
+					Request: &v1alpha1.Transform{Set: []v1alpha1.HeaderTransformation{{Name: "x-test", Value: "value"}}},
+				},
+				RateLimit: &v1alpha1.RateLimit{Local: &v1alpha1.LocalRateLimitPolicy{TokenBucket: &v1alpha1.TokenBucket{
+					MaxTokens:    10,
+					FillInterval: metav1.Duration{Duration: time.Second},
+				}}},
+				RBAC: &v1alpha1.RBAC{
+					Policy: v1alpha1.RBACPolicy{MatchExpressions: []string{"request.headers['x-user'] == 'admin'"}},
+				},
+			},
+		},
+		{
+			name: "invalid spec is rejected",
+			spec: v1alpha1.TrafficPolicySpec{
+				RateLimit: &v1alpha1.RateLimit{Global: &v1alpha1.RateLimitPolicy{ExtensionRef: missingExtension}},
+			},
+			wantFields: []string{"spec.rateLimit.global"},
+		},
+		{
+			name:        "invalid precedence weight is rejected",
+			annotations: map[string]string{apiannotations.PolicyPrecedenceWeight: "high"},
+			wantFields:  []string{"metadata.annotations[" + apiannotations.PolicyPrecedenceWeight + "]"},
+		},
+	}
+
+	constructor := &TrafficPolicyConstructor{commoncol: &collections.CommonCollections{}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1alpha1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default", Annotations: tt.annotations},
+				Spec:       tt.spec,
+			}
+			errs := validatePolicy(context.Background(), constructor, policy)
+			var fields []string
+			for _, fe := range pluginsdkutils.ToFieldErrors(errs, field.NewPath("spec")) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
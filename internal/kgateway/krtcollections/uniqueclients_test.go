@@ -188,7 +188,7 @@ func TestUniqueClients(t *testing.T) {
 				pods.WaitUntilSynced(context.Background().Done())
 			}
 
-			cb, uccBuilder := NewUniquelyConnectedClients(nil, false)
+			cb, uccBuilder := NewUniquelyConnectedClients(nil, false, nil)
 			ucc := uccBuilder(context.Background(), krtutil.KrtOptions{}, pods)
 			ucc.WaitUntilSynced(context.Background().Done())
 
@@ -242,3 +242,37 @@ func TestUniqueClients(t *testing.T) {
 		})
 	}
 }
+
+func TestUniqueClientsDelta(t *testing.T) {
+	g := NewWithT(t)
+
+	cb, uccBuilder := NewUniquelyConnectedClients(nil, false, nil)
+	ucc := uccBuilder(context.Background(), krtutil.KrtOptions{}, nil)
+	ucc.WaitUntilSynced(context.Background().Done())
+
+	role := wellknown.GatewayApiProxyValue + "~best-proxy-role"
+	node := &envoycorev3.Node{
+		Id: "podname.ns",
+		Metadata: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				xds.RoleKey: structpb.NewStringValue(role),
+			},
+		},
+	}
+	g.Expect(cb.OnDeltaStreamOpen(context.Background(), 1, "")).To(Succeed())
+	g.Expect(cb.OnStreamDeltaRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: node})).To(Succeed())
+	// the node is only set on the first request of an incremental stream
+	g.Expect(cb.OnStreamDeltaRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{ResponseNonce: "1"})).To(Succeed())
+
+	g.Eventually(func() []string {
+		var names []string
+		for _, c := range ucc.List() {
+			names = append(names, c.ResourceName())
+		}
+		return names
+	}, "1s").Should(ConsistOf(role))
+	g.Expect(node.GetMetadata().GetFields()[xds.RoleKey].GetStringValue()).To(Equal(role))
+
+	cb.OnDeltaStreamClosed(1, node)
+	g.Eventually(ucc.List, "5s").Should(BeEmpty())
+}
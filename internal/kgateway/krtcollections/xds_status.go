@@ -0,0 +1,277 @@
+package krtcollections
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+)
+
+var xdsNacksTotal = metrics.NewCounter(
+	metrics.CounterOpts{
+		Subsystem: "xds",
+		Name:      "nacks_total",
+		Help:      "Total number of xDS responses rejected by proxies",
+	},
+	[]string{"gateway", "namespace", "type"},
+)
+
+// XdsNack is a response rejected by an xDS client.
+type XdsNack struct {
+	// Version is the version of the rejected response, if known.
+	Version string    `json:"version,omitempty"`
+	Nonce   string    `json:"nonce"`
+	Code    int32     `json:"code,omitempty"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// XdsTypeStatus is the last config ACKed and NACKed by an xDS client for a type URL.
+type XdsTypeStatus struct {
+	TypeUrl          string    `json:"typeUrl"`
+	LastAckedVersion string    `json:"lastAckedVersion,omitempty"`
+	LastAckTime      time.Time `json:"lastAckTime,omitzero"`
+	LastNack         *XdsNack  `json:"lastNack,omitempty"`
+	// Rejected is true if the last response of this type was NACKed, i.e. the client is not running
+	// the latest config.
+	Rejected bool `json:"rejected"`
+
+	// sentVersions maps the nonce of the responses awaiting an ACK or NACK to their version
+	sentVersions map[string]string
+}
+
+// XdsClientStatus is the xDS status of a connected client (stream).
+type XdsClientStatus struct {
+	StreamID int64 `json:"streamId"`
+	// Role is the role of the client, i.e. the owner of the config it gets
+	Role    string                    `json:"role"`
+	Gateway *types.NamespacedName     `json:"gateway,omitempty"`
+	Types   map[string]*XdsTypeStatus `json:"types"`
+}
+
+// GatewayRejectedConfig holds the responses rejected by the proxies of a Gateway, with one entry per
+// type URL and proxy for which the last response was NACKed.
+type GatewayRejectedConfig struct {
+	types.NamespacedName
+	Nacks []GatewayXdsNack
+}
+
+// GatewayXdsNack is the last response of a type rejected by a proxy of a Gateway.
+type GatewayXdsNack struct {
+	TypeUrl string
+	XdsNack
+}
+
+func (g GatewayRejectedConfig) ResourceName() string {
+	return g.NamespacedName.String()
+}
+
+func (g GatewayRejectedConfig) Equals(in GatewayRejectedConfig) bool {
+	return g.NamespacedName == in.NamespacedName && slices.EqualFunc(g.Nacks, in.Nacks, func(a, b GatewayXdsNack) bool {
+		return a.TypeUrl == b.TypeUrl && a.Version == b.Version && a.Code == b.Code && a.Message == b.Message
+	})
+}
+
+// XdsStatus tracks the config ACKed and NACKed by every connected xDS client.
+type XdsStatus struct {
+	lock    sync.RWMutex
+	clients map[int64]*XdsClientStatus
+	trigger *krt.RecomputeTrigger
+}
+
+func NewXdsStatus() *XdsStatus {
+	return &XdsStatus{
+		clients: map[int64]*XdsClientStatus{},
+		trigger: krt.NewRecomputeTrigger(true),
+	}
+}
+
+// Clients returns a copy of the status of all connected clients, sorted by stream ID.
+func (s *XdsStatus) Clients() []XdsClientStatus {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	out := make([]XdsClientStatus, 0, len(s.clients))
+	for _, c := range s.clients {
+		cc := *c
+		cc.Types = make(map[string]*XdsTypeStatus, len(c.Types))
+		for typeUrl, ts := range c.Types {
+			tsc := *ts
+			tsc.sentVersions = nil
+			cc.Types[typeUrl] = &tsc
+		}
+		out = append(out, cc)
+	}
+	slices.SortFunc(out, func(a, b XdsClientStatus) int {
+		return cmp.Compare(a.StreamID, b.StreamID)
+	})
+	return out
+}
+
+// GatewayRejections returns a collection of the config currently rejected by the proxies of each Gateway.
+func (s *XdsStatus) GatewayRejections(krtOpts krtutil.KrtOptions) krt.Collection[GatewayRejectedConfig] {
+	return krt.NewManyFromNothing(func(ctx krt.HandlerContext) []GatewayRejectedConfig {
+		s.trigger.MarkDependant(ctx)
+		return s.gatewayRejections()
+	}, krtOpts.ToOptions("GatewayRejectedConfig")...)
+}
+
+func (s *XdsStatus) gatewayRejections() []GatewayRejectedConfig {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	byGateway := map[types.NamespacedName][]GatewayXdsNack{}
+	for _, c := range s.clients {
+		if c.Gateway == nil {
+			continue
+		}
+		for typeUrl, ts := range c.Types {
+			if ts.Rejected && ts.LastNack != nil {
+				byGateway[*c.Gateway] = append(byGateway[*c.Gateway], GatewayXdsNack{TypeUrl: typeUrl, XdsNack: *ts.LastNack})
+			}
+		}
+	}
+	out := make([]GatewayRejectedConfig, 0, len(byGateway))
+	for gw, nacks := range byGateway {
+		slices.SortFunc(nacks, func(a, b GatewayXdsNack) int {
+			return cmp.Or(cmp.Compare(a.TypeUrl, b.TypeUrl), cmp.Compare(a.Version, b.Version), cmp.Compare(a.Message, b.Message))
+		})
+		// proxies of the same gateway usually reject the same config
+		nacks = slices.CompactFunc(nacks, func(a, b GatewayXdsNack) bool {
+			return a.TypeUrl == b.TypeUrl && a.Version == b.Version && a.Message == b.Message
+		})
+		out = append(out, GatewayRejectedConfig{NamespacedName: gw, Nacks: nacks})
+	}
+	return out
+}
+
+// onStreamRequest records the ACK or NACK carried by a request. role is the role of the client, it is
+// only needed on the first request of a stream.
+func (s *XdsStatus) onStreamRequest(sid int64, role string, r *envoy_service_discovery_v3.DiscoveryRequest) {
+	s.onRequest(sid, role, r.GetTypeUrl(), r.GetResponseNonce(), r.GetVersionInfo(), r.GetErrorDetail())
+}
+
+// onStreamDeltaRequest records the ACK or NACK carried by an incremental request. Incremental requests
+// carry no version, so the version ACKed is the system version of the response.
+func (s *XdsStatus) onStreamDeltaRequest(sid int64, role string, r *envoy_service_discovery_v3.DeltaDiscoveryRequest) {
+	s.onRequest(sid, role, r.GetTypeUrl(), r.GetResponseNonce(), "", r.GetErrorDetail())
+}
+
+func (s *XdsStatus) onRequest(sid int64, role, typeUrl, nonce, ackedVersion string, errorDetail *rpcstatus.Status) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	c, ok := s.clients[sid]
+	if !ok {
+		if !xds.IsKubeGatewayCacheKey(role) {
+			return
+		}
+		c = &XdsClientStatus{
+			StreamID: sid,
+			Role:     role,
+			Gateway:  gatewayFromRole(role),
+			Types:    map[string]*XdsTypeStatus{},
+		}
+		s.clients[sid] = c
+	}
+	// the first request of a type is neither an ACK nor a NACK
+	if nonce == "" {
+		return
+	}
+	ts := c.typeStatus(typeUrl)
+	version := ts.sentVersions[nonce]
+	delete(ts.sentVersions, nonce)
+
+	wasRejected := ts.Rejected
+	if errorDetail == nil {
+		ts.LastAckedVersion = cmp.Or(ackedVersion, version)
+		ts.LastAckTime = time.Now()
+		ts.Rejected = false
+	} else {
+		ts.LastNack = &XdsNack{
+			Version: version,
+			Nonce:   nonce,
+			Code:    errorDetail.GetCode(),
+			Message: errorDetail.GetMessage(),
+			Time:    time.Now(),
+		}
+		ts.Rejected = true
+		logger.Warn("xDS client rejected config", "role", c.Role, "type", typeUrl, "version", version, "error", errorDetail.GetMessage())
+		if c.Gateway != nil {
+			xdsNacksTotal.Inc(
+				metrics.Label{Name: "gateway", Value: c.Gateway.Name},
+				metrics.Label{Name: "namespace", Value: c.Gateway.Namespace},
+				metrics.Label{Name: "type", Value: typeUrl},
+			)
+		}
+	}
+	if wasRejected || ts.Rejected {
+		s.trigger.TriggerRecomputation()
+	}
+}
+
+// onStreamResponse records the version of a response, so that it is known if the response is NACKed.
+func (s *XdsStatus) onStreamResponse(sid int64, resp *envoy_service_discovery_v3.DiscoveryResponse) {
+	s.onResponse(sid, resp.GetTypeUrl(), resp.GetNonce(), resp.GetVersionInfo())
+}
+
+// onStreamDeltaResponse records the system version of an incremental response, so that it is known if the
+// response is NACKed.
+func (s *XdsStatus) onStreamDeltaResponse(sid int64, resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) {
+	s.onResponse(sid, resp.GetTypeUrl(), resp.GetNonce(), resp.GetSystemVersionInfo())
+}
+
+func (s *XdsStatus) onResponse(sid int64, typeUrl, nonce, version string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	c, ok := s.clients[sid]
+	if !ok {
+		return
+	}
+	ts := c.typeStatus(typeUrl)
+	// a client only ACKs or NACKs the latest response of a type, older ones will never be answered
+	clear(ts.sentVersions)
+	ts.sentVersions[nonce] = version
+}
+
+func (s *XdsStatus) onStreamClosed(sid int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	c, ok := s.clients[sid]
+	if !ok {
+		return
+	}
+	delete(s.clients, sid)
+	for _, ts := range c.Types {
+		if ts.Rejected {
+			s.trigger.TriggerRecomputation()
+			return
+		}
+	}
+}
+
+func (c *XdsClientStatus) typeStatus(typeUrl string) *XdsTypeStatus {
+	ts, ok := c.Types[typeUrl]
+	if !ok {
+		ts = &XdsTypeStatus{TypeUrl: typeUrl, sentVersions: map[string]string{}}
+		c.Types[typeUrl] = ts
+	}
+	return ts
+}
+
+// gatewayFromRole returns the Gateway of a role built with xds.OwnerNamespaceNameID, or nil if the role
+// does not identify a Gateway.
+func gatewayFromRole(role string) *types.NamespacedName {
+	parts := strings.Split(role, xds.KeyDelimiter)
+	if len(parts) < 3 {
+		return nil
+	}
+	return &types.NamespacedName{Namespace: parts[1], Name: parts[2]}
+}
@@ -0,0 +1,97 @@
+package krtcollections
+
+import (
+	"testing"
+
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
+)
+
+func TestXdsStatus(t *testing.T) {
+	const listenerType = "type.googleapis.com/envoy.config.listener.v3.Listener"
+	gw := types.NamespacedName{Namespace: "ns", Name: "gw"}
+	role := xds.OwnerNamespaceNameID(wellknown.GatewayApiProxyValue, gw.Namespace, gw.Name)
+
+	s := NewXdsStatus()
+	// the first request of a stream carries the role
+	s.onStreamRequest(1, role, &envoy_service_discovery_v3.DiscoveryRequest{TypeUrl: listenerType})
+	// streams of other clients are ignored
+	s.onStreamRequest(2, "other", &envoy_service_discovery_v3.DiscoveryRequest{TypeUrl: listenerType})
+
+	s.onStreamResponse(1, &envoy_service_discovery_v3.DiscoveryResponse{TypeUrl: listenerType, VersionInfo: "v1", Nonce: "n1"})
+	s.onStreamRequest(1, "", &envoy_service_discovery_v3.DiscoveryRequest{TypeUrl: listenerType, VersionInfo: "v1", ResponseNonce: "n1"})
+	assert.Empty(t, s.gatewayRejections())
+
+	s.onStreamResponse(1, &envoy_service_discovery_v3.DiscoveryResponse{TypeUrl: listenerType, VersionInfo: "v2", Nonce: "n2"})
+	s.onStreamRequest(1, "", &envoy_service_discovery_v3.DiscoveryRequest{
+		TypeUrl:       listenerType,
+		VersionInfo:   "v1",
+		ResponseNonce: "n2",
+		ErrorDetail:   &status.Status{Code: 3, Message: "invalid listener"},
+	})
+
+	clients := s.Clients()
+	require.Len(t, clients, 1)
+	assert.Equal(t, &gw, clients[0].Gateway)
+	ts := clients[0].Types[listenerType]
+	assert.True(t, ts.Rejected)
+	assert.Equal(t, "v1", ts.LastAckedVersion)
+	assert.Equal(t, "v2", ts.LastNack.Version)
+	assert.Equal(t, "invalid listener", ts.LastNack.Message)
+
+	rejections := s.gatewayRejections()
+	require.Len(t, rejections, 1)
+	assert.Equal(t, gw, rejections[0].NamespacedName)
+	require.Len(t, rejections[0].Nacks, 1)
+	assert.Equal(t, listenerType, rejections[0].Nacks[0].TypeUrl)
+	assert.Equal(t, "v2", rejections[0].Nacks[0].Version)
+
+	// a later ACK clears the rejection but keeps the last NACK
+	s.onStreamResponse(1, &envoy_service_discovery_v3.DiscoveryResponse{TypeUrl: listenerType, VersionInfo: "v3", Nonce: "n3"})
+	s.onStreamRequest(1, "", &envoy_service_discovery_v3.DiscoveryRequest{TypeUrl: listenerType, VersionInfo: "v3", ResponseNonce: "n3"})
+	assert.Empty(t, s.gatewayRejections())
+	ts = s.Clients()[0].Types[listenerType]
+	assert.False(t, ts.Rejected)
+	assert.Equal(t, "v3", ts.LastAckedVersion)
+	assert.NotNil(t, ts.LastNack)
+
+	s.onStreamClosed(1)
+	assert.Empty(t, s.Clients())
+}
+
+func TestXdsStatusDelta(t *testing.T) {
+	const clusterType = "type.googleapis.com/envoy.config.cluster.v3.Cluster"
+	gw := types.NamespacedName{Namespace: "ns", Name: "gw"}
+	role := xds.OwnerNamespaceNameID(wellknown.GatewayApiProxyValue, gw.Namespace, gw.Name)
+
+	s := NewXdsStatus()
+	s.onStreamDeltaRequest(1, role, &envoy_service_discovery_v3.DeltaDiscoveryRequest{TypeUrl: clusterType})
+
+	// incremental requests carry no version, the ACKed version is the system version of the response
+	s.onStreamDeltaResponse(1, &envoy_service_discovery_v3.DeltaDiscoveryResponse{TypeUrl: clusterType, SystemVersionInfo: "v1", Nonce: "n1"})
+	s.onStreamDeltaRequest(1, "", &envoy_service_discovery_v3.DeltaDiscoveryRequest{TypeUrl: clusterType, ResponseNonce: "n1"})
+	ts := s.Clients()[0].Types[clusterType]
+	assert.False(t, ts.Rejected)
+	assert.Equal(t, "v1", ts.LastAckedVersion)
+
+	s.onStreamDeltaResponse(1, &envoy_service_discovery_v3.DeltaDiscoveryResponse{TypeUrl: clusterType, SystemVersionInfo: "v2", Nonce: "n2"})
+	s.onStreamDeltaRequest(1, "", &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+		TypeUrl:       clusterType,
+		ResponseNonce: "n2",
+		ErrorDetail:   &status.Status{Code: 3, Message: "invalid cluster"},
+	})
+	ts = s.Clients()[0].Types[clusterType]
+	assert.True(t, ts.Rejected)
+	assert.Equal(t, "v1", ts.LastAckedVersion)
+	assert.Equal(t, "v2", ts.LastNack.Version)
+
+	rejections := s.gatewayRejections()
+	require.Len(t, rejections, 1)
+	assert.Equal(t, "invalid cluster", rejections[0].Nacks[0].Message)
+}
@@ -0,0 +1,268 @@
+package offline
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiserverschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	structuralpruning "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	kgatewaycrds "github.com/kgateway-dev/kgateway/v2/install/helm/kgateway-crds"
+)
+
+// StdinPath is the input path that reads manifests from stdin
+const StdinPath = "-"
+
+// Loader decodes Kubernetes manifests into typed objects. Objects of kgateway kinds are
+// defaulted using the schemas of the kgateway CRDs, as the API server would.
+type Loader struct {
+	scheme           *runtime.Scheme
+	schemas          map[schema.GroupVersionKind]*apiserverschema.Structural
+	defaultNamespace string
+	stdin            io.Reader
+}
+
+// NewLoader returns a Loader decoding the kinds registered in the given scheme. Namespaced
+// objects without a namespace are placed in defaultNamespace.
+func NewLoader(scheme *runtime.Scheme, defaultNamespace string, stdin io.Reader) (*Loader, error) {
+	schemas, err := crdSchemas(kgatewaycrds.Templates)
+	if err != nil {
+		return nil, fmt.Errorf("loading CRD schemas: %w", err)
+	}
+	return &Loader{
+		scheme:           scheme,
+		schemas:          schemas,
+		defaultNamespace: defaultNamespace,
+		stdin:            stdin,
+	}, nil
+}
+
+// LoadPaths loads the manifests of the given files, directories (walked recursively for
+// .yaml and .yml files) and StdinPath. Files ending in .tar.gz or .tgz, such as debug bundles,
+// are loaded as archives.
+func (l *Loader) LoadPaths(paths []string) ([]client.Object, error) {
+	var objs []client.Object
+	for _, path := range paths {
+		if path == StdinPath {
+			loaded, err := l.Load(StdinPath, l.stdin)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, loaded...)
+			continue
+		}
+
+		var files []string
+		err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			// explicitly listed files are always loaded
+			if file == path || strings.HasSuffix(file, ".yaml") || strings.HasSuffix(file, ".yml") {
+				files = append(files, file)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			f, err := os.Open(file) //nolint:gosec // G304: reading user supplied manifests is the purpose of the loader
+			if err != nil {
+				return nil, err
+			}
+			var loaded []client.Object
+			if isArchive(file) {
+				loaded, err = l.LoadArchive(file, f)
+			} else {
+				loaded, err = l.Load(file, f)
+			}
+			f.Close()
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, loaded...)
+		}
+	}
+	return objs, nil
+}
+
+// Load decodes all the documents of a YAML or JSON stream. The source is only used in errors.
+func (l *Loader) Load(source string, r io.Reader) ([]client.Object, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bufio.NewReader(r), 4096)
+
+	var objs []client.Object
+	for i := 0; ; i++ {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: document %d: %w", source, i, err)
+		}
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+
+		decoded, _, err := unstructured.UnstructuredJSONScheme.Decode(raw, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%s: document %d: %w", source, i, err)
+		}
+		var items []*unstructured.Unstructured
+		switch u := decoded.(type) {
+		case *unstructured.UnstructuredList:
+			for j := range u.Items {
+				items = append(items, &u.Items[j])
+			}
+		case *unstructured.Unstructured:
+			items = append(items, u)
+		}
+		for _, u := range items {
+			obj, err := l.convert(u)
+			if err != nil {
+				return nil, fmt.Errorf("%s: document %d: %w", source, i, err)
+			}
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+// LoadArchive decodes the .yaml and .yml files of a gzipped tar archive. The source is only used in errors.
+func (l *Loader) LoadArchive(source string, r io.Reader) ([]client.Object, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	defer gz.Close()
+
+	var objs []client.Object
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", source, err)
+		}
+		if hdr.Typeflag != tar.TypeReg || !(strings.HasSuffix(hdr.Name, ".yaml") || strings.HasSuffix(hdr.Name, ".yml")) {
+			continue
+		}
+		loaded, err := l.Load(source+":"+hdr.Name, tr)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, loaded...)
+	}
+	return objs, nil
+}
+
+func isArchive(file string) bool {
+	return strings.HasSuffix(file, ".tar.gz") || strings.HasSuffix(file, ".tgz")
+}
+
+// convert defaults the given object and converts it to its typed representation
+func (l *Loader) convert(u *unstructured.Unstructured) (client.Object, error) {
+	gvk := u.GroupVersionKind()
+	typed, err := l.scheme.New(gvk)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported kind %s: %w", gvk, err)
+	}
+
+	if structural, ok := l.schemas[gvk]; ok {
+		unknownFields := structuralpruning.PruneWithOptions(u.Object, structural, true, apiserverschema.UnknownFieldPathOptions{
+			TrackUnknownFieldPaths: true,
+		})
+		if len(unknownFields) > 0 {
+			return nil, fmt.Errorf("%s %s: unknown fields: %v", gvk.Kind, u.GetName(), unknownFields)
+		}
+		structuraldefaulting.PruneNonNullableNullsWithoutDefaults(u.Object, structural)
+		structuraldefaulting.Default(u.Object, structural)
+	}
+
+	data, err := u.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, typed); err != nil {
+		return nil, fmt.Errorf("%s %s: %w", gvk.Kind, u.GetName(), err)
+	}
+	obj, ok := typed.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("unsupported kind %s", gvk)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	// GatewayClasses are the only cluster-scoped kind routed by the translator
+	if _, isGwc := obj.(*gwv1.GatewayClass); !isGwc && obj.GetNamespace() == "" {
+		obj.SetNamespace(l.defaultNamespace)
+	}
+	return obj, nil
+}
+
+// crdSchemas returns the structural schemas of every version of the CRDs in the given FS.
+// Templated files that are not valid CRDs are skipped.
+func crdSchemas(fsys fs.FS) (map[schema.GroupVersionKind]*apiserverschema.Structural, error) {
+	schemas := map[schema.GroupVersionKind]*apiserverschema.Structural{}
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".yaml") {
+			return err
+		}
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+
+		crdv1 := &apiextensionsv1.CustomResourceDefinition{}
+		if err := utilyaml.Unmarshal(data, crdv1); err != nil || crdv1.Kind != "CustomResourceDefinition" {
+			return nil
+		}
+		crd := &apiextensions.CustomResourceDefinition{}
+		if err := apiextensionsv1.Convert_v1_CustomResourceDefinition_To_apiextensions_CustomResourceDefinition(crdv1, crd, nil); err != nil {
+			return err
+		}
+		for _, ver := range crd.Spec.Versions {
+			validation, err := apiextensions.GetSchemaForVersion(crd, ver.Name)
+			if err != nil {
+				return err
+			}
+			if validation == nil || validation.OpenAPIV3Schema == nil {
+				continue
+			}
+			structural, err := apiserverschema.NewStructural(validation.OpenAPIV3Schema)
+			if err != nil {
+				return err
+			}
+			schemas[schema.GroupVersionKind{
+				Group:   crd.Spec.Group,
+				Version: ver.Name,
+				Kind:    crd.Spec.Names.Kind,
+			}] = structural
+		}
+		return nil
+	})
+	return schemas, err
+}
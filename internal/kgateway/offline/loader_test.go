@@ -0,0 +1,134 @@
+package offline
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/debugbundle"
+	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
+)
+
+func TestLoad(t *testing.T) {
+	manifests := `
+apiVersion: gateway.networking.k8s.io/v1
+kind: GatewayClass
+metadata:
+  name: kgateway
+spec:
+  controllerName: kgateway.dev/kgateway
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gw
+spec:
+  gatewayClassName: kgateway
+  listeners:
+  - name: http
+    protocol: HTTP
+    port: 8080
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: gateway.kgateway.dev/v1alpha1
+  kind: TrafficPolicy
+  metadata:
+    name: policy
+    namespace: apps
+  spec:
+    timeouts:
+      request: 5s
+`
+	loader, err := NewLoader(schemes.InferExtScheme(), "default", nil)
+	require.NoError(t, err)
+
+	objs, err := loader.Load("test", strings.NewReader(manifests))
+	require.NoError(t, err)
+	require.Len(t, objs, 3)
+
+	gwc, ok := objs[0].(*gwv1.GatewayClass)
+	require.True(t, ok)
+	assert.Empty(t, gwc.Namespace)
+
+	gw, ok := objs[1].(*gwv1.Gateway)
+	require.True(t, ok)
+	assert.Equal(t, "default", gw.Namespace)
+	assert.Equal(t, gwv1.PortNumber(8080), gw.Spec.Listeners[0].Port)
+
+	policy, ok := objs[2].(*v1alpha1.TrafficPolicy)
+	require.True(t, ok)
+	assert.Equal(t, "apps", policy.Namespace)
+	assert.Equal(t, "TrafficPolicy", policy.GetObjectKind().GroupVersionKind().Kind)
+}
+
+func TestLoadErrors(t *testing.T) {
+	loader, err := NewLoader(schemes.InferExtScheme(), "default", nil)
+	require.NoError(t, err)
+
+	_, err = loader.Load("unknown-kind", strings.NewReader("apiVersion: example.com/v1\nkind: Widget\nmetadata:\n  name: w\n"))
+	assert.ErrorContains(t, err, "unsupported kind")
+
+	_, err = loader.Load("unknown-field", strings.NewReader(`
+apiVersion: gateway.kgateway.dev/v1alpha1
+kind: TrafficPolicy
+metadata:
+  name: policy
+spec:
+  notAField: true
+`))
+	assert.ErrorContains(t, err, "unknown fields")
+}
+
+func TestLoadArchive(t *testing.T) {
+	cli := fake.NewClientBuilder().WithScheme(schemes.InferExtScheme()).WithObjects(
+		&gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "kgateway"},
+		},
+		&v1alpha1.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "apps"},
+			Spec: v1alpha1.TrafficPolicySpec{
+				Timeouts: &v1alpha1.Timeouts{Request: &metav1.Duration{Duration: 5 * time.Second}},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cert", Namespace: "default"},
+			Data:       map[string][]byte{"tls.key": []byte("secret")},
+		},
+	).Build()
+
+	// a debug bundle of the cluster loads back the objects of the cluster, with redacted secrets
+	var bundle bytes.Buffer
+	require.NoError(t, debugbundle.Write(context.Background(), &bundle, debugbundle.Sources{Reader: cli}))
+
+	loader, err := NewLoader(schemes.InferExtScheme(), "default", nil)
+	require.NoError(t, err)
+	objs, err := loader.LoadArchive("bundle.tar.gz", &bundle)
+	require.NoError(t, err)
+	require.Len(t, objs, 3)
+
+	secret, ok := objs[0].(*corev1.Secret)
+	require.True(t, ok)
+	assert.Equal(t, debugbundle.RedactedValue, string(secret.Data["tls.key"]))
+
+	policy, ok := objs[1].(*v1alpha1.TrafficPolicy)
+	require.True(t, ok)
+	assert.Equal(t, "apps", policy.Namespace)
+	assert.Equal(t, 5*time.Second, policy.Spec.Timeouts.Request.Duration)
+
+	gw, ok := objs[2].(*gwv1.Gateway)
+	require.True(t, ok)
+	assert.Equal(t, "gw", gw.Name)
+}
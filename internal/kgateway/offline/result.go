@@ -0,0 +1,84 @@
+package offline
+
+import (
+	"encoding/json"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Result is the outcome of an offline translation
+type Result struct {
+	// Gateways holds the configuration translated for each Gateway, keyed by namespace/name
+	Gateways map[string]*GatewayResult
+	// Clusters are the Envoy clusters of the backends, which are shared by all Envoy Gateways
+	Clusters []*envoyclusterv3.Cluster
+	// Statuses are the statuses the translated objects would be written with
+	Statuses *Statuses
+}
+
+// GatewayResult is the configuration translated for a single Gateway. The Envoy fields are set
+// for the envoy dataplane, and Resources and Addresses for agentgateway.
+type GatewayResult struct {
+	Listeners     []*envoylistenerv3.Listener
+	Routes        []*envoyroutev3.RouteConfiguration
+	ExtraClusters []*envoyclusterv3.Cluster
+
+	Resources []proto.Message
+	Addresses []proto.Message
+}
+
+func (r *Result) MarshalJSON() ([]byte, error) {
+	result := map[string]any{}
+	if len(r.Gateways) > 0 {
+		result["gateways"] = r.Gateways
+	}
+	if err := addProtoMessages(result, "clusters", r.Clusters); err != nil {
+		return nil, err
+	}
+	if r.Statuses != nil {
+		result["statuses"] = r.Statuses
+	}
+	return json.Marshal(result)
+}
+
+func (r *GatewayResult) MarshalJSON() ([]byte, error) {
+	result := map[string]any{}
+	if err := addProtoMessages(result, "listeners", r.Listeners); err != nil {
+		return nil, err
+	}
+	if err := addProtoMessages(result, "routes", r.Routes); err != nil {
+		return nil, err
+	}
+	if err := addProtoMessages(result, "extraClusters", r.ExtraClusters); err != nil {
+		return nil, err
+	}
+	if err := addProtoMessages(result, "resources", r.Resources); err != nil {
+		return nil, err
+	}
+	if err := addProtoMessages(result, "addresses", r.Addresses); err != nil {
+		return nil, err
+	}
+	return json.Marshal(result)
+}
+
+// addProtoMessages sets the given messages in the result, marshaled with protojson so that
+// well-known types such as Any and Duration are rendered as in Envoy config dumps
+func addProtoMessages[T proto.Message](result map[string]any, key string, messages []T) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	out := make([]json.RawMessage, 0, len(messages))
+	for _, msg := range messages {
+		data, err := protojson.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		out = append(out, data)
+	}
+	result[key] = out
+	return nil
+}
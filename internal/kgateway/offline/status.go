@@ -0,0 +1,228 @@
+package offline
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+// Statuses holds the status every translated object would be written with, keyed by namespace/name.
+// Policies are keyed by kind/namespace/name.
+type Statuses struct {
+	Gateways     map[string]*gwv1.GatewayStatus        `json:"gateways,omitempty"`
+	ListenerSets map[string]*gwxv1a1.ListenerSetStatus `json:"listenerSets,omitempty"`
+	HTTPRoutes   map[string]*gwv1.RouteStatus          `json:"httpRoutes,omitempty"`
+	TCPRoutes    map[string]*gwv1.RouteStatus          `json:"tcpRoutes,omitempty"`
+	TLSRoutes    map[string]*gwv1.RouteStatus          `json:"tlsRoutes,omitempty"`
+	GRPCRoutes   map[string]*gwv1.RouteStatus          `json:"grpcRoutes,omitempty"`
+	Policies     map[string]*gwv1a2.PolicyStatus       `json:"policies,omitempty"`
+}
+
+// ConditionError is a status condition reporting that an object was not translated as written
+type ConditionError struct {
+	// Object is the kind and key of the object with the condition, e.g. HTTPRoute default/route
+	Object string
+	// Parent is the listener, parent or ancestor the condition was reported for, if any
+	Parent    string
+	Condition metav1.Condition
+}
+
+func (e ConditionError) String() string {
+	parent := ""
+	if e.Parent != "" {
+		parent = " (" + e.Parent + ")"
+	}
+	return fmt.Sprintf("%s%s: %s=%s %s: %s", e.Object, parent, e.Condition.Type, e.Condition.Status, e.Condition.Reason, e.Condition.Message)
+}
+
+// buildStatuses builds the statuses of all the objects in the given reports
+func buildStatuses(
+	ctx context.Context,
+	reportsMap reports.ReportMap,
+	gateways map[types.NamespacedName]*gwv1.Gateway,
+	listenerSets map[types.NamespacedName]*gwxv1a1.XListenerSet,
+	controllerName string,
+	className string,
+) *Statuses {
+	statuses := &Statuses{
+		Gateways:     make(map[string]*gwv1.GatewayStatus),
+		ListenerSets: make(map[string]*gwxv1a1.ListenerSetStatus),
+		HTTPRoutes:   make(map[string]*gwv1.RouteStatus),
+		TCPRoutes:    make(map[string]*gwv1.RouteStatus),
+		TLSRoutes:    make(map[string]*gwv1.RouteStatus),
+		GRPCRoutes:   make(map[string]*gwv1.RouteStatus),
+		Policies:     make(map[string]*gwv1a2.PolicyStatus),
+	}
+
+	// the actual objects are needed for status.listeners to be populated
+	for nn := range reportsMap.Gateways {
+		gw := gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace}}
+		if actual := gateways[nn]; actual != nil {
+			gw = *actual
+		}
+		if status := reportsMap.BuildGWStatus(ctx, gw, nil); status != nil {
+			statuses.Gateways[nn.String()] = status
+		}
+	}
+	for nn := range reportsMap.ListenerSets {
+		ls := gwxv1a1.XListenerSet{ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace}}
+		if actual := listenerSets[nn]; actual != nil {
+			ls = *actual
+		}
+		if status := reportsMap.BuildListenerSetStatus(ctx, ls); status != nil {
+			statuses.ListenerSets[nn.String()] = status
+		}
+	}
+
+	for nn := range reportsMap.HTTPRoutes {
+		route := &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace}}
+		if status := reportsMap.BuildRouteStatus(ctx, route, className); status != nil {
+			statuses.HTTPRoutes[nn.String()] = status
+		}
+	}
+	for nn := range reportsMap.TCPRoutes {
+		route := &gwv1a2.TCPRoute{ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace}}
+		if status := reportsMap.BuildRouteStatus(ctx, route, className); status != nil {
+			statuses.TCPRoutes[nn.String()] = status
+		}
+	}
+	for nn := range reportsMap.TLSRoutes {
+		route := &gwv1a2.TLSRoute{ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace}}
+		if status := reportsMap.BuildRouteStatus(ctx, route, className); status != nil {
+			statuses.TLSRoutes[nn.String()] = status
+		}
+	}
+	for nn := range reportsMap.GRPCRoutes {
+		route := &gwv1.GRPCRoute{ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace}}
+		if status := reportsMap.BuildRouteStatus(ctx, route, className); status != nil {
+			statuses.GRPCRoutes[nn.String()] = status
+		}
+	}
+
+	for key := range reportsMap.Policies {
+		if status := reportsMap.BuildPolicyStatus(ctx, key, controllerName, gwv1a2.PolicyStatus{}); status != nil {
+			statuses.Policies[fmt.Sprintf("%s/%s/%s", key.Kind, key.Namespace, key.Name)] = status
+		}
+	}
+
+	return statuses
+}
+
+// Errors returns the conditions reporting that an object was rejected, could not be resolved
+// or is in conflict, sorted by object.
+func (s *Statuses) Errors() []ConditionError {
+	var errs []ConditionError
+	add := func(object, parent string, conditions []metav1.Condition) {
+		for _, c := range conditions {
+			if isErrorCondition(c) {
+				errs = append(errs, ConditionError{Object: object, Parent: parent, Condition: c})
+			}
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(s.Gateways)) {
+		status := s.Gateways[key]
+		add("Gateway "+key, "", status.Conditions)
+		for _, l := range status.Listeners {
+			add("Gateway "+key, "listener "+string(l.Name), l.Conditions)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(s.ListenerSets)) {
+		status := s.ListenerSets[key]
+		add("XListenerSet "+key, "", status.Conditions)
+		for _, l := range status.Listeners {
+			add("XListenerSet "+key, "listener "+string(l.Name), l.Conditions)
+		}
+	}
+
+	for _, routes := range []struct {
+		kind     string
+		statuses map[string]*gwv1.RouteStatus
+	}{
+		{"HTTPRoute", s.HTTPRoutes},
+		{"TCPRoute", s.TCPRoutes},
+		{"TLSRoute", s.TLSRoutes},
+		{"GRPCRoute", s.GRPCRoutes},
+	} {
+		for _, key := range slices.Sorted(maps.Keys(routes.statuses)) {
+			for _, parent := range routes.statuses[key].Parents {
+				add(routes.kind+" "+key, "parent "+parentRefString(parent.ParentRef), parent.Conditions)
+			}
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(s.Policies)) {
+		kind, nn, _ := strings.Cut(key, "/")
+		for _, ancestor := range s.Policies[key].Ancestors {
+			add(kind+" "+nn, "ancestor "+parentRefString(ancestor.AncestorRef), ancestor.Conditions)
+		}
+	}
+
+	return errs
+}
+
+// isErrorCondition returns true for the conditions that mean the object, or part of it, is not
+// programmed as written. Attached=False is not an error, as policies may be overridden by design.
+func isErrorCondition(c metav1.Condition) bool {
+	switch c.Type {
+	case string(gwv1.GatewayConditionAccepted), string(gwv1.GatewayConditionProgrammed), string(gwv1.ListenerConditionResolvedRefs):
+		return c.Status == metav1.ConditionFalse
+	case string(gwv1.ListenerConditionConflicted), string(gwv1.RouteConditionPartiallyInvalid):
+		return c.Status == metav1.ConditionTrue
+	}
+	return false
+}
+
+func parentRefString(ref gwv1.ParentReference) string {
+	kind := "Gateway"
+	if ref.Kind != nil {
+		kind = string(*ref.Kind)
+	}
+	s := kind + " "
+	if ref.Namespace != nil {
+		s += string(*ref.Namespace) + "/"
+	}
+	s += string(ref.Name)
+	if ref.SectionName != nil {
+		s += "/" + string(*ref.SectionName)
+	}
+	return s
+}
+
+// mergeReports merges the reports of the translation of several gateways. Routes and policies
+// attached to several gateways get the parents and ancestors of all of them.
+func mergeReports(merged reports.ReportMap, r reports.ReportMap) {
+	maps.Copy(merged.Gateways, r.Gateways)
+	maps.Copy(merged.ListenerSets, r.ListenerSets)
+	mergeRouteReports(merged.HTTPRoutes, r.HTTPRoutes)
+	mergeRouteReports(merged.TCPRoutes, r.TCPRoutes)
+	mergeRouteReports(merged.TLSRoutes, r.TLSRoutes)
+	mergeRouteReports(merged.GRPCRoutes, r.GRPCRoutes)
+	for key, report := range r.Policies {
+		if old := merged.Policies[key]; old != nil {
+			maps.Copy(old.Ancestors, report.Ancestors)
+			continue
+		}
+		merged.Policies[key] = report
+	}
+}
+
+func mergeRouteReports(merged, source map[types.NamespacedName]*reports.RouteReport) {
+	for nn, report := range source {
+		if old := merged[nn]; old != nil {
+			maps.Copy(old.Parents, report.Parents)
+			continue
+		}
+		merged[nn] = report
+	}
+}
@@ -0,0 +1,59 @@
+package offline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func TestStatusesErrors(t *testing.T) {
+	accepted := metav1.Condition{Type: "Accepted", Status: metav1.ConditionTrue, Reason: "Accepted"}
+	notAccepted := metav1.Condition{Type: "Accepted", Status: metav1.ConditionFalse, Reason: "Invalid", Message: "bad"}
+	unresolved := metav1.Condition{Type: "ResolvedRefs", Status: metav1.ConditionFalse, Reason: "BackendNotFound"}
+	conflicted := metav1.Condition{Type: "Conflicted", Status: metav1.ConditionTrue, Reason: "HostnameConflict"}
+	notAttached := metav1.Condition{Type: "Attached", Status: metav1.ConditionFalse, Reason: "Overridden"}
+
+	statuses := &Statuses{
+		Gateways: map[string]*gwv1.GatewayStatus{
+			"default/gw": {
+				Conditions: []metav1.Condition{accepted},
+				Listeners: []gwv1.ListenerStatus{{
+					Name:       "http",
+					Conditions: []metav1.Condition{accepted, conflicted},
+				}},
+			},
+		},
+		HTTPRoutes: map[string]*gwv1.RouteStatus{
+			"default/b": {Parents: []gwv1.RouteParentStatus{{
+				ParentRef:  gwv1.ParentReference{Name: "gw"},
+				Conditions: []metav1.Condition{accepted, unresolved},
+			}}},
+			"default/a": {Parents: []gwv1.RouteParentStatus{{
+				ParentRef:  gwv1.ParentReference{Name: "gw", SectionName: ptr.To(gwv1.SectionName("http"))},
+				Conditions: []metav1.Condition{accepted},
+			}}},
+		},
+		Policies: map[string]*gwv1a2.PolicyStatus{
+			"TrafficPolicy/default/tp": {Ancestors: []gwv1a2.PolicyAncestorStatus{{
+				AncestorRef: gwv1.ParentReference{Kind: ptr.To(gwv1.Kind("HTTPRoute")), Namespace: ptr.To(gwv1.Namespace("default")), Name: "a"},
+				Conditions:  []metav1.Condition{notAccepted, notAttached},
+			}}},
+		},
+	}
+
+	var got []string
+	for _, e := range statuses.Errors() {
+		got = append(got, e.String())
+	}
+	assert.Equal(t, []string{
+		"Gateway default/gw (listener http): Conflicted=True HostnameConflict: ",
+		"HTTPRoute default/b (parent Gateway gw): ResolvedRefs=False BackendNotFound: ",
+		"TrafficPolicy default/tp (ancestor HTTPRoute default/a): Accepted=False Invalid: bad",
+	}, got)
+
+	assert.Empty(t, (&Statuses{}).Errors())
+}
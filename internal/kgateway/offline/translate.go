@@ -0,0 +1,268 @@
+package offline
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	kubeclient "istio.io/istio/pkg/kube"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/agentgatewaysyncer"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/registry"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/standalone"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	agwplugins "github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/plugins"
+	agwtranslator "github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/translator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/client/clientset/versioned/fake"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/namespaces"
+	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
+)
+
+// Dataplane is the proxy the manifests are translated for
+type Dataplane string
+
+const (
+	DataplaneEnvoy        Dataplane = "envoy"
+	DataplaneAgentgateway Dataplane = "agentgateway"
+)
+
+// Options configure an offline translation
+type Options struct {
+	Dataplane Dataplane
+	// EnvoyBinary is used by the binary validator to validate the translated xDS when the validation
+	// mode is strict. Defaults to the path used by the controller.
+	EnvoyBinary string
+}
+
+// Translate runs the translation of the given objects without a cluster, using the same
+// plugins as the controller, and returns the configuration of every Gateway of the dataplane
+// along with the statuses of the translated objects. The default GatewayClasses are created
+// unless the objects define them.
+func Translate(ctx context.Context, objs []client.Object, opts Options) (*Result, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	settings, err := apisettings.BuildSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		controllerName string
+		classNames     []string
+	)
+	switch opts.Dataplane {
+	case DataplaneEnvoy, "":
+		controllerName = wellknown.DefaultGatewayControllerName
+		classNames = []string{wellknown.DefaultGatewayClassName, wellknown.DefaultWaypointClassName}
+	case DataplaneAgentgateway:
+		controllerName = wellknown.DefaultAgwControllerName
+		classNames = []string{wellknown.DefaultAgwClassName}
+		settings.EnableAgentgateway = true
+		settings.EnableInferExt = true
+	default:
+		return nil, fmt.Errorf("unsupported dataplane %q", opts.Dataplane)
+	}
+
+	cli, ourCli, err := newFakeClients(ctx, objs, controllerName, classNames)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// the informers must be stopped before the client can shut down
+		cancel()
+		cli.Shutdown()
+	}()
+
+	krtOpts := krtutil.KrtOptions{
+		Stop: ctx.Done(),
+	}
+	commoncol, err := collections.NewCommonCollections(ctx, krtOpts, cli, ourCli, nil, controllerName, *settings)
+	if err != nil {
+		return nil, err
+	}
+
+	// gateways and listener sets are needed as written to build their listener statuses
+	gateways := map[types.NamespacedName]*gwv1.Gateway{}
+	listenerSets := map[types.NamespacedName]*gwxv1a1.XListenerSet{}
+	for _, obj := range objs {
+		switch o := obj.(type) {
+		case *gwv1.Gateway:
+			gateways[client.ObjectKeyFromObject(o)] = o
+		case *gwxv1a1.XListenerSet:
+			listenerSets[client.ObjectKeyFromObject(o)] = o
+		}
+	}
+
+	var (
+		result         *Result
+		reportsMap     reports.ReportMap
+		policyStatuses map[string]*gwv1a2.PolicyStatus
+	)
+	if opts.Dataplane == DataplaneAgentgateway {
+		result, reportsMap, policyStatuses, err = translateAgentgateway(ctx, krtOpts, cli, commoncol, *settings)
+	} else {
+		v := validator.New(settings.XdsValidators)
+		if opts.EnvoyBinary != "" {
+			v = validator.New(settings.XdsValidators, opts.EnvoyBinary)
+		}
+		result, reportsMap = translateEnvoy(ctx, krtOpts, cli, commoncol, *settings, v)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Statuses = buildStatuses(ctx, reportsMap, gateways, listenerSets, controllerName, classNames[0])
+	maps.Copy(result.Statuses.Policies, policyStatuses)
+	return result, nil
+}
+
+// newFakeClients returns fake clients serving the given objects, with the GatewayClasses of
+// the controller created unless they are part of the objects
+func newFakeClients(
+	ctx context.Context,
+	objs []client.Object,
+	controllerName string,
+	classNames []string,
+) (kubeclient.CLIClient, *fake.Clientset, error) {
+	var anyObjs, ourObjs []runtime.Object
+	for _, obj := range objs {
+		if obj.GetObjectKind().GroupVersionKind().Group == v1alpha1.GroupName {
+			ourObjs = append(ourObjs, obj)
+		} else {
+			anyObjs = append(anyObjs, obj)
+		}
+	}
+
+	cli, ourCli, err := standalone.NewClients(anyObjs, ourObjs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := standalone.CreateGatewayClasses(ctx, cli, controllerName, classNames...); err != nil {
+		cli.Shutdown()
+		return nil, nil, err
+	}
+	return cli, ourCli, nil
+}
+
+// translateEnvoy translates every Envoy Gateway as the proxy syncer would
+func translateEnvoy(
+	ctx context.Context,
+	krtOpts krtutil.KrtOptions,
+	cli kubeclient.CLIClient,
+	commoncol *collections.CommonCollections,
+	settings apisettings.Settings,
+	v validator.Validator,
+) (*Result, reports.ReportMap) {
+	plugins := registry.Plugins(ctx, commoncol, wellknown.DefaultWaypointClassName, settings, v)
+	plugins = append(plugins, krtcollections.NewBuiltinPlugin(ctx))
+	extensions := registry.MergePlugins(plugins...)
+
+	// clusters do not depend on the Gateway, only on the client's locality
+	ucc := ir.NewUniqlyConnectedClient("offline", "", nil, ir.PodLocality{})
+	translation := standalone.TranslateEnvoy(ctx, krtOpts, cli, commoncol, extensions, settings, v, ucc)
+
+	result := &Result{
+		Gateways: map[string]*GatewayResult{},
+		Clusters: translation.Clusters,
+	}
+	reportsMap := reports.NewReportMap()
+	for _, gw := range translation.Gateways {
+		mergeReports(reportsMap, gw.Reports)
+		if gw.Result == nil {
+			continue
+		}
+		result.Gateways[types.NamespacedName{Namespace: gw.Gateway.Namespace, Name: gw.Gateway.Name}.String()] = &GatewayResult{
+			Listeners:     gw.Result.Listeners,
+			Routes:        gw.Result.Routes,
+			ExtraClusters: gw.Result.ExtraClusters,
+		}
+	}
+	// backend policies are not reported during gateway translation, see GenerateBackendPolicyReport
+	mergeReports(reportsMap, translation.BackendPolicyReports)
+
+	return result, reportsMap
+}
+
+// translateAgentgateway translates every agentgateway Gateway as the agentgateway syncer would.
+// Policy statuses are not part of the reports, as the syncer computes them in dedicated status
+// collections, and are returned keyed by kind/namespace/name.
+func translateAgentgateway(
+	ctx context.Context,
+	krtOpts krtutil.KrtOptions,
+	cli kubeclient.CLIClient,
+	commoncol *collections.CommonCollections,
+	settings apisettings.Settings,
+) (*Result, reports.ReportMap, map[string]*gwv1a2.PolicyStatus, error) {
+	plugins := registry.Plugins(ctx, commoncol, wellknown.DefaultAgwClassName, settings, nil)
+	commoncol.InitPlugins(ctx, registry.MergePlugins(plugins...), settings)
+
+	cli.RunAndWait(ctx.Done())
+
+	agwCollections, err := agwplugins.NewAgwCollections(
+		commoncol,
+		wellknown.DefaultAgwControllerName,
+		namespaces.GetPodNamespace(),
+		cli.ClusterID().String(),
+	)
+	if err != nil {
+		return nil, reports.ReportMap{}, nil, err
+	}
+	agwPlugins := agwplugins.MergePlugins(agwplugins.Plugins(agwCollections)...)
+
+	syncer := agentgatewaysyncer.NewAgwSyncer(
+		wellknown.DefaultAgwControllerName,
+		wellknown.DefaultAgwClassName,
+		cli,
+		nil, // no manager, the syncer is not started
+		agwCollections,
+		agwPlugins,
+		nil, // no xds cache, snapshots are read from the collection
+		settings.EnableInferExt,
+	)
+	syncer.Init(krtOpts)
+	kubeclient.WaitForCacheSync("agentgateway", ctx.Done(), syncer.CacheSyncs()...)
+
+	result := &Result{
+		Gateways: map[string]*GatewayResult{},
+	}
+	reportsMap := reports.NewReportMap()
+	for _, res := range syncer.XDS().List() {
+		mergeReports(reportsMap, res.Reports)
+		gwResult := &GatewayResult{}
+		for _, name := range slices.Sorted(maps.Keys(res.ResourceConfig.Items)) {
+			if r, ok := res.ResourceConfig.Items[name].Resource.(*agwtranslator.AgwResourceWithCustomName); ok {
+				gwResult.Resources = append(gwResult.Resources, r.Message)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(res.AddressConfig.Items)) {
+			if r, ok := res.AddressConfig.Items[name].Resource.(*agwtranslator.AgwResourceWithCustomName); ok {
+				gwResult.Addresses = append(gwResult.Addresses, r.Message)
+			}
+		}
+		result.Gateways[res.NamespacedName.String()] = gwResult
+	}
+
+	policyStatuses := map[string]*gwv1a2.PolicyStatus{}
+	for gk, col := range syncer.PolicyStatuses() {
+		col.WaitUntilSynced(ctx.Done())
+		for _, policy := range col.List() {
+			status := policy.Status
+			policyStatuses[fmt.Sprintf("%s/%s/%s", gk.Kind, policy.Obj.GetNamespace(), policy.Obj.GetName())] = &status
+		}
+	}
+	return result, reportsMap, policyStatuses, nil
+}
@@ -0,0 +1,109 @@
+package offline
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
+)
+
+const translateManifests = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gw
+spec:
+  gatewayClassName: kgateway
+  listeners:
+  - name: http
+    protocol: HTTP
+    port: 8080
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: backend
+spec:
+  selector:
+    app: backend
+  ports:
+  - port: 8080
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: route
+spec:
+  parentRefs:
+  - name: gw
+  rules:
+  - backendRefs:
+    - name: backend
+      port: 8080
+`
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name      string
+		manifests string
+		errors    []string
+	}{
+		{
+			name:      "valid",
+			manifests: translateManifests,
+		},
+		{
+			name: "policy with missing extension",
+			manifests: translateManifests + `
+---
+apiVersion: gateway.kgateway.dev/v1alpha1
+kind: TrafficPolicy
+metadata:
+  name: policy
+spec:
+  targetRefs:
+  - group: gateway.networking.k8s.io
+    kind: HTTPRoute
+    name: route
+  extAuth:
+    extensionRef:
+      name: missing
+`,
+			// the rule the policy targets is replaced with a direct response
+			errors: []string{
+				"HTTPRoute default/route",
+				"TrafficPolicy default/policy (ancestor Gateway default/gw): Accepted=False Invalid",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader, err := NewLoader(schemes.InferExtScheme(), "default", nil)
+			require.NoError(t, err)
+			objs, err := loader.Load("test", strings.NewReader(tt.manifests))
+			require.NoError(t, err)
+
+			result, err := Translate(context.Background(), objs, Options{Dataplane: DataplaneEnvoy})
+			require.NoError(t, err)
+
+			gw := result.Gateways["default/gw"]
+			require.NotNil(t, gw)
+			assert.NotEmpty(t, gw.Listeners)
+			assert.NotEmpty(t, result.Clusters)
+			require.Contains(t, result.Statuses.HTTPRoutes, "default/route")
+
+			var errs []string
+			for _, e := range result.Statuses.Errors() {
+				errs = append(errs, e.String())
+			}
+			require.Len(t, errs, len(tt.errors), errs)
+			for i, e := range tt.errors {
+				assert.Contains(t, errs[i], e)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package proxy_syncer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/server/stream/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSliceToResourcesVersions(t *testing.T) {
+	clas := testEndpoints(3, 2)
+	r, versions := sliceToResources(clas)
+	require.Len(t, versions, 3)
+	assert.Len(t, r.Items, 3)
+
+	// changing a resource only changes its version
+	clas[1].GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress().PortSpecifier = &envoycorev3.SocketAddress_PortValue{PortValue: 9090}
+	r2, versions2 := sliceToResources(clas)
+	assert.NotEqual(t, r.Version, r2.Version)
+	assert.Equal(t, versions["cluster-0"], versions2["cluster-0"])
+	assert.NotEqual(t, versions["cluster-1"], versions2["cluster-1"])
+	assert.Equal(t, versions["cluster-2"], versions2["cluster-2"])
+}
+
+// BenchmarkXdsUpdateBytes compares the bytes sent to a proxy when a single endpoint changes, with
+// state-of-the-world xDS, which sends all the endpoints of the proxy, and incremental (delta) xDS, which
+// only sends the ClusterLoadAssignment that changed.
+func BenchmarkXdsUpdateBytes(b *testing.B) {
+	tests := []struct {
+		clusters            int
+		endpointsPerCluster int
+	}{
+		{clusters: 100, endpointsPerCluster: 10},
+		{clusters: 1000, endpointsPerCluster: 10},
+		{clusters: 1000, endpointsPerCluster: 50},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("clusters=%d,endpoints=%d", tc.clusters, tc.clusters*tc.endpointsPerCluster)
+		b.Run(name+",mode=sotw", func(b *testing.B) {
+			ctx := context.Background()
+			clas := testEndpoints(tc.clusters, tc.endpointsPerCluster)
+			cache := envoycache.NewSnapshotCache(false, envoycache.IDHash{}, nil)
+			snap := endpointsSnapshot(clas)
+			require.NoError(b, cache.SetSnapshot(ctx, "proxy", snap))
+
+			var sent int
+			b.ResetTimer()
+			for i := range b.N {
+				responses := make(chan envoycache.Response, 1)
+				cancel := cache.CreateWatch(&envoycache.Request{
+					Node:        &envoycorev3.Node{Id: "proxy"},
+					TypeUrl:     resource.EndpointType,
+					VersionInfo: snap.GetVersion(resource.EndpointType),
+				}, stream.NewStreamState(false, nil), responses)
+
+				updateEndpoint(clas[i%len(clas)], i)
+				snap = endpointsSnapshot(clas)
+				require.NoError(b, cache.SetSnapshot(ctx, "proxy", snap))
+
+				resp, err := (<-responses).GetDiscoveryResponse()
+				require.NoError(b, err)
+				sent += proto.Size(resp)
+				cancel()
+			}
+			b.ReportMetric(float64(sent)/float64(b.N), "bytes/update")
+		})
+
+		b.Run(name+",mode=delta", func(b *testing.B) {
+			ctx := context.Background()
+			clas := testEndpoints(tc.clusters, tc.endpointsPerCluster)
+			cache := envoycache.NewSnapshotCache(false, envoycache.IDHash{}, nil)
+			require.NoError(b, cache.SetSnapshot(ctx, "proxy", endpointsSnapshot(clas)))
+
+			req := &envoycache.DeltaRequest{
+				Node:    &envoycorev3.Node{Id: "proxy"},
+				TypeUrl: resource.EndpointType,
+			}
+			state := stream.NewStreamState(true, nil)
+			// the first response has all the endpoints
+			responses := make(chan envoycache.DeltaResponse, 1)
+			cache.CreateDeltaWatch(req, state, responses)
+			state.SetResourceVersions((<-responses).GetNextVersionMap())
+
+			var sent int
+			b.ResetTimer()
+			for i := range b.N {
+				responses := make(chan envoycache.DeltaResponse, 1)
+				cancel := cache.CreateDeltaWatch(req, state, responses)
+
+				updateEndpoint(clas[i%len(clas)], i)
+				require.NoError(b, cache.SetSnapshot(ctx, "proxy", endpointsSnapshot(clas)))
+
+				raw := <-responses
+				resp, err := raw.GetDeltaDiscoveryResponse()
+				require.NoError(b, err)
+				if len(resp.GetResources()) != 1 {
+					b.Fatalf("expected 1 resource in the delta response, got %d", len(resp.GetResources()))
+				}
+				sent += proto.Size(resp)
+				state.SetResourceVersions(raw.GetNextVersionMap())
+				cancel()
+			}
+			b.ReportMetric(float64(sent)/float64(b.N), "bytes/update")
+		})
+	}
+}
+
+// endpointsSnapshot builds a snapshot of clas with the version map set from the hashes of the resources,
+// as snapshotPerClient does
+func endpointsSnapshot(clas []*envoyendpointv3.ClusterLoadAssignment) *envoycache.Snapshot {
+	endpoints, versions := sliceToResources(clas)
+	snap := &envoycache.Snapshot{}
+	snap.Resources[envoycachetypes.Endpoint] = endpoints
+	snap.VersionMap = map[string]map[string]string{resource.EndpointType: versions}
+	return snap
+}
+
+func testEndpoints(clusters, endpointsPerCluster int) []*envoyendpointv3.ClusterLoadAssignment {
+	clas := make([]*envoyendpointv3.ClusterLoadAssignment, 0, clusters)
+	for c := range clusters {
+		lbEndpoints := make([]*envoyendpointv3.LbEndpoint, 0, endpointsPerCluster)
+		for e := range endpointsPerCluster {
+			lbEndpoints = append(lbEndpoints, &envoyendpointv3.LbEndpoint{
+				HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{
+					Endpoint: &envoyendpointv3.Endpoint{
+						Address: &envoycorev3.Address{
+							Address: &envoycorev3.Address_SocketAddress{
+								SocketAddress: &envoycorev3.SocketAddress{
+									Address:       fmt.Sprintf("10.%d.%d.%d", c/256, c%256, e),
+									PortSpecifier: &envoycorev3.SocketAddress_PortValue{PortValue: 8080},
+								},
+							},
+						},
+					},
+				},
+			})
+		}
+		clas = append(clas, &envoyendpointv3.ClusterLoadAssignment{
+			ClusterName: fmt.Sprintf("cluster-%d", c),
+			Endpoints:   []*envoyendpointv3.LocalityLbEndpoints{{LbEndpoints: lbEndpoints}},
+		})
+	}
+	return clas
+}
+
+// updateEndpoint changes the port of the first endpoint of cla, as a pod restarting on a new port would
+func updateEndpoint(cla *envoyendpointv3.ClusterLoadAssignment, i int) {
+	cla.GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress().PortSpecifier = &envoycorev3.SocketAddress_PortValue{
+		PortValue: uint32(10000 + i%50000), //nolint:gosec // G115: the port is bounded
+	}
+}
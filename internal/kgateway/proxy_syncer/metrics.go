@@ -4,6 +4,12 @@ import (
 	"strings"
 	"time"
 
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"google.golang.org/protobuf/proto"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	tmetrics "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/metrics"
 	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
 )
 
@@ -70,6 +76,14 @@ var (
 		},
 		[]string{gatewayLabel, namespaceLabel, resourceLabel},
 	)
+	snapshotResourceBytes = metrics.NewGauge(
+		metrics.GaugeOpts{
+			Subsystem: snapshotSubsystem,
+			Name:      "resource_bytes",
+			Help:      "Current serialized size in bytes of the resources in XDS snapshot",
+		},
+		[]string{gatewayLabel, namespaceLabel, resourceLabel},
+	)
 )
 
 // snapshotResourcesMetricLabels defines the labels for XDS snapshot resources metrics.
@@ -87,6 +101,36 @@ func (r snapshotResourcesMetricLabels) toMetricsLabels() []metrics.Label {
 	}
 }
 
+// setSnapshotResourceBytes records the serialized size of the resources of each type of a snapshot, or
+// resets it when snap is nil. Sizes are only computed when metrics are active and the translator metrics
+// are enabled, and only for the types whose version changed since prev, which is nil for a new snapshot.
+func setSnapshotResourceBytes(gateway, namespace string, prev, snap *envoycache.Snapshot) {
+	if !metrics.Active() || tmetrics.Detail() == apisettings.TranslatorMetricsDetailNone {
+		return
+	}
+	for resource, typ := range map[string]envoycachetypes.ResponseType{
+		"Cluster":  envoycachetypes.Cluster,
+		"Endpoint": envoycachetypes.Endpoint,
+		"Route":    envoycachetypes.Route,
+		"Listener": envoycachetypes.Listener,
+	} {
+		if prev != nil && snap != nil && prev.Resources[typ].Version == snap.Resources[typ].Version {
+			continue
+		}
+		size := 0
+		if snap != nil {
+			for _, r := range snap.Resources[typ].Items {
+				size += proto.Size(r.Resource)
+			}
+		}
+		snapshotResourceBytes.Set(float64(size), snapshotResourcesMetricLabels{
+			Gateway:   gateway,
+			Namespace: namespace,
+			Resource:  resource,
+		}.toMetricsLabels()...)
+	}
+}
+
 // statusSyncMetricLabels defines the labels for status sync metrics.
 type statusSyncMetricLabels struct {
 	Name      string
@@ -199,4 +243,5 @@ func ResetMetrics() {
 	snapshotTransformsTotal.Reset()
 	snapshotTransformDuration.Reset()
 	snapshotResources.Reset()
+	snapshotResourceBytes.Reset()
 }
@@ -5,7 +5,12 @@ import (
 	"testing"
 	"time"
 
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 
 	kmetrics "github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections/metrics"
 	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
@@ -153,6 +158,67 @@ func TestResourceSyncMetrics(t *testing.T) {
 	gathered.AssertHistogramPopulated("kgateway_resources_status_sync_duration_seconds")
 }
 
+func TestSetSnapshotResourceBytes(t *testing.T) {
+	setupTest()
+
+	cluster := &envoyclusterv3.Cluster{Name: "backend"}
+	snap := &envoycache.Snapshot{}
+	snap.Resources[envoycachetypes.Cluster] = envoycache.NewResources("1", []envoycachetypes.Resource{cluster})
+
+	setSnapshotResourceBytes(testGatewayName, testNamespace, nil, snap)
+
+	labels := func(resource string) []metrics.Label {
+		return []metrics.Label{
+			{Name: "gateway", Value: testGatewayName},
+			{Name: "namespace", Value: testNamespace},
+			{Name: "resource", Value: resource},
+		}
+	}
+	currentMetrics := metricstest.MustGatherMetrics(t)
+	currentMetrics.AssertMetricsInclude("kgateway_xds_snapshot_resource_bytes", []metricstest.ExpectMetric{
+		&metricstest.ExpectedMetric{Labels: labels("Cluster"), Value: float64(proto.Size(cluster))},
+		&metricstest.ExpectedMetric{Labels: labels("Listener"), Value: 0},
+	})
+
+	// only the types whose version changed are measured again
+	bigger := &envoyclusterv3.Cluster{Name: "backend", AltStatName: "backend-stats"}
+	listener := &envoylistenerv3.Listener{Name: "http"}
+	next := &envoycache.Snapshot{}
+	next.Resources[envoycachetypes.Cluster] = envoycache.NewResources("1", []envoycachetypes.Resource{bigger})
+	next.Resources[envoycachetypes.Listener] = envoycache.NewResources("2", []envoycachetypes.Resource{listener})
+
+	setSnapshotResourceBytes(testGatewayName, testNamespace, snap, next)
+
+	currentMetrics = metricstest.MustGatherMetrics(t)
+	currentMetrics.AssertMetricsInclude("kgateway_xds_snapshot_resource_bytes", []metricstest.ExpectMetric{
+		&metricstest.ExpectedMetric{Labels: labels("Cluster"), Value: float64(proto.Size(cluster))},
+		&metricstest.ExpectedMetric{Labels: labels("Listener"), Value: float64(proto.Size(listener))},
+	})
+
+	setSnapshotResourceBytes(testGatewayName, testNamespace, nil, nil)
+
+	currentMetrics = metricstest.MustGatherMetrics(t)
+	currentMetrics.AssertMetricsInclude("kgateway_xds_snapshot_resource_bytes", []metricstest.ExpectMetric{
+		&metricstest.ExpectedMetric{Labels: labels("Cluster"), Value: 0},
+		&metricstest.ExpectedMetric{Labels: labels("Listener"), Value: 0},
+	})
+}
+
+func TestSetSnapshotResourceBytesNotActive(t *testing.T) {
+	metrics.SetActive(false)
+	defer metrics.SetActive(true)
+
+	setupTest()
+
+	snap := &envoycache.Snapshot{}
+	snap.Resources[envoycachetypes.Cluster] = envoycache.NewResources("1", []envoycachetypes.Resource{&envoyclusterv3.Cluster{Name: "backend"}})
+
+	setSnapshotResourceBytes(testGatewayName, testNamespace, nil, snap)
+
+	currentMetrics := metricstest.MustGatherMetrics(t)
+	currentMetrics.AssertMetricNotExists("kgateway_xds_snapshot_resource_bytes")
+}
+
 func TestGetDetailsFromXDSClientResourceName(t *testing.T) {
 	testCases := []struct {
 		name     string
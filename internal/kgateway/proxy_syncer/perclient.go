@@ -6,6 +6,7 @@ import (
 
 	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/krt"
 
@@ -21,11 +22,15 @@ type clustersWithErrors struct {
 	erroredClustersHash uint64
 	clustersHash        uint64
 	resourceName        string
+	// versions are the versions of the clusters, keyed by name
+	versions map[string]string
 }
 
 type endpointsWithUccName struct {
 	endpoints    envoycache.Resources
 	resourceName string
+	// versions are the versions of the endpoints, keyed by cluster name
+	versions map[string]string
 }
 
 func (c clustersWithErrors) ResourceName() string {
@@ -64,6 +69,7 @@ func snapshotPerClient(
 		logger.Debug("found perclient clusters", "client", ucc.ResourceName(), "clusters", len(clustersForUcc))
 
 		clustersProto := make([]envoycachetypes.ResourceWithTTL, 0, len(clustersForUcc))
+		clusterVersions := make(map[string]string, len(clustersForUcc))
 		var (
 			clustersHash        uint64
 			erroredClustersHash uint64
@@ -80,6 +86,7 @@ func snapshotPerClient(
 				continue
 			}
 			clustersProto = append(clustersProto, envoycachetypes.ResourceWithTTL{Resource: c.Cluster})
+			clusterVersions[c.Cluster.GetName()] = fmt.Sprintf("%d", c.ClusterVersion)
 			clustersHash ^= c.ClusterVersion
 		}
 		clustersVersion := fmt.Sprintf("%d", clustersHash)
@@ -88,6 +95,7 @@ func snapshotPerClient(
 
 		return &clustersWithErrors{
 			clusters:            clusterResources,
+			versions:            clusterVersions,
 			erroredClusters:     erroredClusters,
 			clustersHash:        clustersHash,
 			erroredClustersHash: erroredClustersHash,
@@ -98,15 +106,18 @@ func snapshotPerClient(
 	endpointResources := krt.NewCollection(uccCol, func(kctx krt.HandlerContext, ucc ir.UniqlyConnectedClient) *endpointsWithUccName {
 		endpointsForUcc := endpoints.FetchEndpointsForClient(kctx, ucc)
 		endpointsProto := make([]envoycachetypes.ResourceWithTTL, 0, len(endpointsForUcc))
+		endpointVersions := make(map[string]string, len(endpointsForUcc))
 		var endpointsHash uint64
 		for _, ep := range endpointsForUcc {
 			endpointsProto = append(endpointsProto, envoycachetypes.ResourceWithTTL{Resource: ep.Endpoints})
+			endpointVersions[ep.Endpoints.GetClusterName()] = fmt.Sprintf("%d", ep.EndpointsHash)
 			endpointsHash ^= ep.EndpointsHash
 		}
 
 		endpointResources := envoycache.NewResourcesWithTTL(fmt.Sprintf("%d", endpointsHash), endpointsProto)
 		return &endpointsWithUccName{
 			endpoints:    endpointResources,
+			versions:     endpointVersions,
 			resourceName: ucc.ResourceName(),
 		}
 	}, krtopts.ToOptions("EndpointResources")...)
@@ -141,13 +152,17 @@ func snapshotPerClient(
 
 		logger.Debug("found perclient clusters", "client", ucc.ResourceName(), "clusters", len(clustersForUcc.clusters.Items))
 		clusterResources := clustersForUcc.clusters
+		clusterVersions := clustersForUcc.versions
 
 		snap := XdsSnapWrapper{}
 		if len(listenerRouteSnapshot.Clusters) > 0 {
 			clustersProto := make(map[string]envoycachetypes.ResourceWithTTL, len(listenerRouteSnapshot.Clusters)+len(clustersForUcc.clusters.Items))
 			maps.Copy(clustersProto, clustersForUcc.clusters.Items)
+			clusterVersions = maps.Clone(clustersForUcc.versions)
 			for _, item := range listenerRouteSnapshot.Clusters {
-				clustersProto[envoycache.GetResourceName(item.Resource)] = item
+				name := envoycache.GetResourceName(item.Resource)
+				clustersProto[name] = item
+				clusterVersions[name] = listenerRouteSnapshot.versions[resource.ClusterType][name]
 			}
 			clusterResources.Version = fmt.Sprintf("%d", clustersForUcc.clustersHash^listenerRouteSnapshot.ClustersHash)
 			clusterResources.Items = clustersProto
@@ -161,6 +176,14 @@ func snapshotPerClient(
 		snapshot.Resources[envoycachetypes.Route] = listenerRouteSnapshot.Routes
 		snapshot.Resources[envoycachetypes.Listener] = listenerRouteSnapshot.Listeners
 		// envoycache.NewResources(version, resource)
+		// Setting the version map from the hashes of the collections saves the cache from marshaling and
+		// hashing every resource of the snapshot to answer incremental (delta) xDS requests.
+		snapshot.VersionMap = map[string]map[string]string{
+			resource.ClusterType:  clusterVersions,
+			resource.EndpointType: clientEndpointResources.versions,
+			resource.RouteType:    listenerRouteSnapshot.versions[resource.RouteType],
+			resource.ListenerType: listenerRouteSnapshot.versions[resource.ListenerType],
+		}
 		snap.snap = snapshot
 		logger.Debug("snapshots", "proxy_key", snap.proxyKey,
 			"listeners", resourcesStringer(listenerRouteSnapshot.Listeners).String(),
@@ -200,6 +223,8 @@ func snapshotPerClient(
 				Namespace: cd.Namespace,
 				Resource:  "Listener",
 			}.toMetricsLabels()...)
+
+			setSnapshotResourceBytes(cd.Gateway, cd.Namespace, nil, nil)
 		case controllers.EventAdd, controllers.EventUpdate:
 			snapshotResources.Set(float64(len(o.Latest().snap.Resources[envoycachetypes.Cluster].Items)),
 				snapshotResourcesMetricLabels{
@@ -228,6 +253,12 @@ func snapshotPerClient(
 					Namespace: cd.Namespace,
 					Resource:  "Listener",
 				}.toMetricsLabels()...)
+
+			var prev *envoycache.Snapshot
+			if o.Old != nil {
+				prev = o.Old.snap
+			}
+			setSnapshotResourceBytes(cd.Gateway, cd.Namespace, prev, o.Latest().snap)
 		}
 	})
 
@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"strings"
 	"sync/atomic"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/kube/controllers"
@@ -16,11 +19,13 @@ import (
 
 	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"google.golang.org/protobuf/proto"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	kmetrics "github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/irtranslator"
@@ -31,6 +36,7 @@ import (
 	plug "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	krtutil "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
 )
@@ -56,6 +62,9 @@ type ProxySyncer struct {
 	proxyTranslator ProxyTranslator
 
 	uniqueClients krt.Collection[ir.UniqlyConnectedClient]
+	xdsStatus     *krtcollections.XdsStatus
+	explorer      *irtranslator.PolicyExplorer
+	history       *SnapshotHistory
 
 	statusReport            krt.Singleton[report]
 	backendPolicyReport     krt.Singleton[report]
@@ -82,6 +91,11 @@ type GatewayXdsResources struct {
 
 	// Listeners are items in the LDS response payload.
 	Listeners envoycache.Resources
+
+	// versions are the versions of the Clusters, Routes and Listeners, keyed by type URL and resource name.
+	// They are the hashes of the resources, so that incremental xDS can tell which resources changed
+	// without hashing them again.
+	versions map[string]map[string]string
 }
 
 func (r GatewayXdsResources) ResourceName() string {
@@ -96,26 +110,32 @@ func (r GatewayXdsResources) Equals(in GatewayXdsResources) bool {
 		r.Listeners.Version == in.Listeners.Version
 }
 
-func sliceToResourcesHash[T proto.Message](slice []T) ([]envoycachetypes.ResourceWithTTL, uint64) {
+// sliceToResourcesHash returns the resources of slice, the version of each resource keyed by name, and the
+// hash of all the resources.
+func sliceToResourcesHash[T proto.Message](slice []T) ([]envoycachetypes.ResourceWithTTL, map[string]string, uint64) {
 	var slicePb []envoycachetypes.ResourceWithTTL
+	versions := make(map[string]string, len(slice))
 	var resourcesHash uint64
 	for _, r := range slice {
 		var m proto.Message = r
 		hash := utils.HashProto(r)
 		slicePb = append(slicePb, envoycachetypes.ResourceWithTTL{Resource: m})
+		versions[envoycache.GetResourceName(m)] = fmt.Sprintf("%d", hash)
 		resourcesHash ^= hash
 	}
 
-	return slicePb, resourcesHash
+	return slicePb, versions, resourcesHash
 }
 
-func sliceToResources[T proto.Message](slice []T) envoycache.Resources {
-	r, h := sliceToResourcesHash(slice)
-	return envoycache.NewResourcesWithTTL(fmt.Sprintf("%d", h), r)
+func sliceToResources[T proto.Message](slice []T) (envoycache.Resources, map[string]string) {
+	r, versions, h := sliceToResourcesHash(slice)
+	return envoycache.NewResourcesWithTTL(fmt.Sprintf("%d", h), r), versions
 }
 
 func toResources(gw ir.Gateway, xdsSnap irtranslator.TranslationResult, r reports.ReportMap) *GatewayXdsResources {
-	c, ch := sliceToResourcesHash(xdsSnap.ExtraClusters)
+	c, clusterVersions, ch := sliceToResourcesHash(xdsSnap.ExtraClusters)
+	routes, routeVersions := sliceToResources(xdsSnap.Routes)
+	listeners, listenerVersions := sliceToResources(xdsSnap.Listeners)
 	return &GatewayXdsResources{
 		NamespacedName: types.NamespacedName{
 			Namespace: gw.Obj.GetNamespace(),
@@ -124,8 +144,13 @@ func toResources(gw ir.Gateway, xdsSnap irtranslator.TranslationResult, r report
 		reports:      r,
 		ClustersHash: ch,
 		Clusters:     c,
-		Routes:       sliceToResources(xdsSnap.Routes),
-		Listeners:    sliceToResources(xdsSnap.Listeners),
+		Routes:       routes,
+		Listeners:    listeners,
+		versions: map[string]map[string]string{
+			resource.ClusterType:  clusterVersions,
+			resource.RouteType:    routeVersions,
+			resource.ListenerType: listenerVersions,
+		},
 	}
 }
 
@@ -142,6 +167,9 @@ func NewProxySyncer(
 	xdsCache envoycache.SnapshotCache,
 	agentgatewayClassName string,
 	validator validator.Validator,
+	xdsStatus *krtcollections.XdsStatus,
+	explorer *irtranslator.PolicyExplorer,
+	history *SnapshotHistory,
 ) *ProxySyncer {
 	return &ProxySyncer{
 		controllerName:           controllerName,
@@ -151,7 +179,10 @@ func NewProxySyncer(
 		istioClient:              client,
 		proxyTranslator:          NewProxyTranslator(xdsCache),
 		uniqueClients:            uniqueClients,
-		translator:               translator.NewCombinedTranslator(ctx, mergedPlugins, commonCols, validator),
+		xdsStatus:                xdsStatus,
+		explorer:                 explorer,
+		history:                  history,
+		translator:               translator.NewCombinedTranslator(ctx, mergedPlugins, commonCols, validator, explorer),
 		plugins:                  mergedPlugins,
 		reportQueue:              utils.NewAsyncQueue[reports.ReportMap](),
 		backendPolicyReportQueue: utils.NewAsyncQueue[reports.ReportMap](),
@@ -202,6 +233,9 @@ func (r report) Equals(in report) bool {
 
 var logger = logging.New("proxy_syncer")
 
+// maxConditionMessageLength is the maximum length of a condition message accepted by the API server
+const maxConditionMessageLength = 32768
+
 func (s *ProxySyncer) Init(ctx context.Context, krtopts krtutil.KrtOptions) {
 	// all backends with policies attached in a single collection
 	finalBackends := krt.JoinCollection(s.commonCols.BackendIndex.BackendsWithPolicy(),
@@ -261,11 +295,19 @@ func (s *ProxySyncer) Init(ctx context.Context, krtopts krtutil.KrtOptions) {
 		return &report{merged}
 	}, krtopts.ToOptions("BackendsPolicyReport")...)
 
+	var gatewayRejections krt.Collection[krtcollections.GatewayRejectedConfig]
+	if s.xdsStatus != nil {
+		gatewayRejections = s.xdsStatus.GatewayRejections(krtopts)
+	}
+
 	// as proxies are created, they also contain a reportMap containing status for the Gateway and associated xRoutes (really parentRefs)
 	// here we will merge reports that are per-Proxy to a singleton Report used to persist to k8s on a timer
 	s.statusReport = krt.NewSingleton(func(kctx krt.HandlerContext) *report {
 		proxies := krt.Fetch(kctx, s.mostXdsSnapshots)
 		merged := mergeProxyReports(proxies)
+		if gatewayRejections != nil {
+			applyRejectedConfig(merged, krt.Fetch(kctx, gatewayRejections))
+		}
 		return &report{merged}
 	})
 
@@ -356,6 +398,34 @@ func mergeProxyReports(
 	return merged
 }
 
+// applyRejectedConfig sets Programmed=False on the Gateways whose proxies rejected their config.
+func applyRejectedConfig(rm reports.ReportMap, rejections []krtcollections.GatewayRejectedConfig) {
+	for _, r := range rejections {
+		gwReport, ok := rm.Gateways[r.NamespacedName]
+		if !ok || len(r.Nacks) == 0 {
+			continue
+		}
+		msgs := make([]string, 0, len(r.Nacks))
+		for _, nack := range r.Nacks {
+			msgs = append(msgs, fmt.Sprintf("%s version %q: %s", nack.TypeUrl, nack.Version, nack.Message))
+		}
+		msg := "Proxy rejected config: " + strings.Join(msgs, "; ")
+		if len(msg) > maxConditionMessageLength {
+			msg = msg[:maxConditionMessageLength-3] + "..."
+		}
+
+		// the report is shared with the translation result of the Gateway, it must not be modified
+		gwReport = gwReport.Clone()
+		gwReport.SetCondition(reporter.GatewayCondition{
+			Type:    gwv1.GatewayConditionProgrammed,
+			Status:  metav1.ConditionFalse,
+			Reason:  reports.GatewayReasonProxyRejectedConfig,
+			Message: msg,
+		})
+		rm.Gateways[r.NamespacedName] = gwReport
+	}
+}
+
 func (s *ProxySyncer) Start(ctx context.Context) error {
 	logger.Info("starting Proxy Syncer", "controller", s.controllerName)
 
@@ -392,6 +462,14 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 		s.backendPolicyReportQueue.Enqueue(o.Latest().reportMap)
 	})
 
+	if s.explorer != nil {
+		s.mostXdsSnapshots.Register(func(o krt.Event[GatewayXdsResources]) {
+			if o.Event == controllers.EventDelete {
+				s.explorer.DeleteGateway(o.Latest().NamespacedName)
+			}
+		})
+	}
+
 	s.perclientSnapCollection.RegisterBatch(func(o []krt.Event[XdsSnapWrapper]) {
 		for _, e := range o {
 			cd := getDetailsFromXDSClientResourceName(e.Latest().ResourceName())
@@ -399,7 +477,9 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 			if e.Event != controllers.EventDelete {
 				snapWrap := e.Latest()
 				s.proxyTranslator.syncXds(ctx, snapWrap)
+				s.history.Record(snapWrap.proxyKey, e.Event.String(), snapWrap.snap)
 			} else {
+				s.history.DeleteProxy(e.Latest().proxyKey)
 				// key := e.Latest().proxyKey
 				// if _, err := s.proxyTranslator.xdsCache.GetSnapshot(key); err == nil {
 				// 	s.proxyTranslator.xdsCache.ClearSnapshot(e.Latest().proxyKey)
@@ -4,11 +4,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
 
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
@@ -369,3 +371,33 @@ func TestIsListenerSetStatusEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyRejectedConfig(t *testing.T) {
+	gw := types.NamespacedName{Name: "gw", Namespace: "default"}
+	original := &reports.GatewayReport{}
+	rm := reports.ReportMap{
+		Gateways: map[types.NamespacedName]*reports.GatewayReport{gw: original},
+	}
+
+	applyRejectedConfig(rm, []krtcollections.GatewayRejectedConfig{
+		{
+			NamespacedName: gw,
+			Nacks: []krtcollections.GatewayXdsNack{{
+				TypeUrl: "type.googleapis.com/envoy.config.listener.v3.Listener",
+				XdsNack: krtcollections.XdsNack{Version: "v2", Message: "invalid listener"},
+			}},
+		},
+		// rejections of unknown gateways are ignored
+		{NamespacedName: types.NamespacedName{Name: "other", Namespace: "default"}},
+	})
+
+	conds := rm.Gateways[gw].GetConditions()
+	assert.Len(t, conds, 1)
+	assert.Equal(t, string(gwv1.GatewayConditionProgrammed), conds[0].Type)
+	assert.Equal(t, metav1.ConditionFalse, conds[0].Status)
+	assert.Equal(t, string(reports.GatewayReasonProxyRejectedConfig), conds[0].Reason)
+	assert.Equal(t, `Proxy rejected config: type.googleapis.com/envoy.config.listener.v3.Listener version "v2": invalid listener`, conds[0].Message)
+	// the translation report is left untouched
+	assert.Empty(t, original.GetConditions())
+	assert.Len(t, rm.Gateways, 1)
+}
@@ -0,0 +1,358 @@
+package proxy_syncer
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+)
+
+// SnapshotHistory keeps the last xDS snapshots sent to each proxy, so the changes of a config push can be
+// inspected after the fact. It is safe for concurrent use, and a nil *SnapshotHistory records nothing.
+type SnapshotHistory struct {
+	lock    sync.RWMutex
+	size    int
+	now     func() time.Time
+	proxies map[string]*proxyHistory
+}
+
+type proxyHistory struct {
+	// next is the version of the next snapshot recorded for the proxy
+	next uint64
+	// entries is a ring buffer of the last snapshots, oldest first once start is applied
+	entries []historyEntry
+	start   int
+}
+
+type historyEntry struct {
+	version uint64
+	time    time.Time
+	event   string
+	snap    *envoycache.Snapshot
+}
+
+// SnapshotVersion describes a snapshot sent to a proxy.
+type SnapshotVersion struct {
+	// Version is the sequence number of the snapshot for the proxy, starting at 1
+	Version uint64    `json:"version"`
+	Time    time.Time `json:"time"`
+	// Event is the krt event of the per-client snapshot collection that produced the snapshot
+	Event string `json:"event"`
+	// TypeVersions are the versions of the resources of the snapshot per type URL
+	TypeVersions map[string]string `json:"typeVersions"`
+	// Resources are the hashes of the resources of the snapshot per type URL and name
+	Resources map[string]map[string]string `json:"resources,omitempty"`
+}
+
+// SnapshotDiff is the difference between two snapshots of a proxy.
+type SnapshotDiff struct {
+	Proxy    string         `json:"proxy"`
+	From     uint64         `json:"from"`
+	To       uint64         `json:"to"`
+	Added    []ResourceRef  `json:"added,omitempty"`
+	Removed  []ResourceRef  `json:"removed,omitempty"`
+	Modified []ResourceDiff `json:"modified,omitempty"`
+}
+
+type ResourceRef struct {
+	TypeURL string `json:"typeUrl"`
+	Name    string `json:"name"`
+}
+
+// ResourceDiff lists the fields of a resource that differ between two snapshots. Sensitive fields, such as
+// private keys, are redacted, so Fields is empty when only sensitive fields changed.
+type ResourceDiff struct {
+	ResourceRef `json:",inline"`
+	Fields      []FieldDiff `json:"fields"`
+}
+
+// FieldDiff is a field that differs between two versions of a resource. The path uses the proto field names,
+// with list indexes and map keys in brackets. From is unset when the field was added and To when it was removed.
+type FieldDiff struct {
+	Path string `json:"path"`
+	From any    `json:"from,omitempty"`
+	To   any    `json:"to,omitempty"`
+}
+
+// NewSnapshotHistory returns a history that keeps the last size snapshots of each proxy. It returns nil,
+// which records nothing, when size is not positive.
+func NewSnapshotHistory(size int) *SnapshotHistory {
+	if size <= 0 {
+		return nil
+	}
+	return &SnapshotHistory{
+		size:    size,
+		now:     time.Now,
+		proxies: map[string]*proxyHistory{},
+	}
+}
+
+// Record adds a snapshot sent to a proxy to its history, evicting the oldest snapshot when the history is full.
+// Snapshots are immutable once sent, so the history keeps a reference rather than a copy.
+func (h *SnapshotHistory) Record(proxyKey string, event string, snap *envoycache.Snapshot) {
+	if h == nil || snap == nil {
+		return
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	ph := h.proxies[proxyKey]
+	if ph == nil {
+		ph = &proxyHistory{next: 1}
+		h.proxies[proxyKey] = ph
+	}
+	entry := historyEntry{
+		version: ph.next,
+		time:    h.now(),
+		event:   event,
+		snap:    snap,
+	}
+	ph.next++
+	if len(ph.entries) < h.size {
+		ph.entries = append(ph.entries, entry)
+		return
+	}
+	ph.entries[ph.start] = entry
+	ph.start = (ph.start + 1) % len(ph.entries)
+}
+
+// DeleteProxy drops the history of a proxy that no longer exists.
+func (h *SnapshotHistory) DeleteProxy(proxyKey string) {
+	if h == nil {
+		return
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	delete(h.proxies, proxyKey)
+}
+
+// Proxies returns the keys of the proxies with a history, sorted.
+func (h *SnapshotHistory) Proxies() []string {
+	if h == nil {
+		return nil
+	}
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	keys := make([]string, 0, len(h.proxies))
+	for k := range h.proxies {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Versions returns the snapshots in the history of a proxy, oldest first. The resource hashes are only
+// computed when withResources is set.
+func (h *SnapshotHistory) Versions(proxyKey string, withResources bool) ([]SnapshotVersion, error) {
+	entries, err := h.entries(proxyKey)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]SnapshotVersion, 0, len(entries))
+	for _, e := range entries {
+		v := SnapshotVersion{
+			Version:      e.version,
+			Time:         e.time,
+			Event:        e.event,
+			TypeVersions: map[string]string{},
+		}
+		if withResources {
+			v.Resources = map[string]map[string]string{}
+		}
+		for typeURL, resources := range typedResources(e.snap) {
+			v.TypeVersions[typeURL] = resources.Version
+			if !withResources {
+				continue
+			}
+			hashes := make(map[string]string, len(resources.Items))
+			for name, r := range resources.Items {
+				hashes[name] = strconv.FormatUint(utils.HashProto(r.Resource), 16)
+			}
+			v.Resources[typeURL] = hashes
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// Diff returns the difference between two snapshots in the history of a proxy. A zero to selects the
+// latest snapshot and a zero from the snapshot before to.
+func (h *SnapshotHistory) Diff(proxyKey string, from, to uint64) (*SnapshotDiff, error) {
+	entries, err := h.entries(proxyKey)
+	if err != nil {
+		return nil, err
+	}
+	if to == 0 {
+		to = entries[len(entries)-1].version
+	}
+	if from == 0 {
+		if to <= 1 {
+			return nil, fmt.Errorf("snapshot %d of proxy %s has no previous snapshot", to, proxyKey)
+		}
+		from = to - 1
+	}
+	fromEntry, err := findEntry(entries, proxyKey, from)
+	if err != nil {
+		return nil, err
+	}
+	toEntry, err := findEntry(entries, proxyKey, to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &SnapshotDiff{Proxy: proxyKey, From: from, To: to}
+	fromResources := typedResources(fromEntry.snap)
+	toResources := typedResources(toEntry.snap)
+	for _, typeURL := range sortedKeys(fromResources, toResources) {
+		before, after := fromResources[typeURL].Items, toResources[typeURL].Items
+		for _, name := range sortedKeys(before, after) {
+			ref := ResourceRef{TypeURL: typeURL, Name: name}
+			b, inBefore := before[name]
+			a, inAfter := after[name]
+			switch {
+			case !inBefore:
+				diff.Added = append(diff.Added, ref)
+			case !inAfter:
+				diff.Removed = append(diff.Removed, ref)
+			case !proto.Equal(b.Resource, a.Resource):
+				fields, err := diffResources(b.Resource, a.Resource)
+				if err != nil {
+					return nil, fmt.Errorf("%s %s: %w", typeURL, name, err)
+				}
+				diff.Modified = append(diff.Modified, ResourceDiff{ResourceRef: ref, Fields: fields})
+			}
+		}
+	}
+	return diff, nil
+}
+
+func (h *SnapshotHistory) entries(proxyKey string) ([]historyEntry, error) {
+	if h == nil {
+		return nil, fmt.Errorf("no snapshot history for proxy %s", proxyKey)
+	}
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	ph := h.proxies[proxyKey]
+	if ph == nil || len(ph.entries) == 0 {
+		return nil, fmt.Errorf("no snapshot history for proxy %s", proxyKey)
+	}
+	return append(slices.Clone(ph.entries[ph.start:]), ph.entries[:ph.start]...), nil
+}
+
+func findEntry(entries []historyEntry, proxyKey string, version uint64) (historyEntry, error) {
+	i := slices.IndexFunc(entries, func(e historyEntry) bool { return e.version == version })
+	if i < 0 {
+		return historyEntry{}, fmt.Errorf("snapshot %d of proxy %s is not in the history, which has snapshots %d to %d",
+			version, proxyKey, entries[0].version, entries[len(entries)-1].version)
+	}
+	return entries[i], nil
+}
+
+// typedResources returns the non-empty resources of a snapshot per type URL.
+func typedResources(snap *envoycache.Snapshot) map[string]envoycache.Resources {
+	out := map[string]envoycache.Resources{}
+	for i, resources := range snap.Resources {
+		if len(resources.Items) == 0 {
+			continue
+		}
+		typeURL, err := envoycache.GetResponseTypeURL(envoycachetypes.ResponseType(i))
+		if err != nil {
+			continue
+		}
+		out[typeURL] = resources
+	}
+	return out
+}
+
+func sortedKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// diffResources returns the fields that differ between two resources, after redacting their sensitive fields.
+func diffResources(before, after proto.Message) ([]FieldDiff, error) {
+	b, err := redactedJSON(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := redactedJSON(after)
+	if err != nil {
+		return nil, err
+	}
+	var fields []FieldDiff
+	diffJSON("", b, a, &fields)
+	return fields, nil
+}
+
+func redactedJSON(m proto.Message) (any, error) {
+	m = proto.Clone(m)
+	redactProto(m)
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func diffJSON(path string, before, after any, out *[]FieldDiff) {
+	switch b := before.(type) {
+	case map[string]any:
+		if a, ok := after.(map[string]any); ok {
+			for _, k := range sortedKeys(b, a) {
+				diffJSON(joinPath(path, k), b[k], a[k], out)
+			}
+			return
+		}
+	case []any:
+		if a, ok := after.([]any); ok {
+			for i := range max(len(a), len(b)) {
+				var bi, ai any
+				if i < len(b) {
+					bi = b[i]
+				}
+				if i < len(a) {
+					ai = a[i]
+				}
+				diffJSON(fmt.Sprintf("%s[%d]", path, i), bi, ai, out)
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(before, after) {
+		*out = append(*out, FieldDiff{Path: path, From: before, To: after})
+	}
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	if strings.ContainsAny(field, ".[]") {
+		return fmt.Sprintf("%s[%q]", path, field)
+	}
+	return path + "." + field
+}
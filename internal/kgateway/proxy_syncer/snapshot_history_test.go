@@ -0,0 +1,95 @@
+package proxy_syncer_test
+
+import (
+	"testing"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	. "github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
+)
+
+func TestSnapshotHistory(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	cluster := func(name string, timeout time.Duration, key string) *envoyclusterv3.Cluster {
+		return &envoyclusterv3.Cluster{
+			Name:           name,
+			ConnectTimeout: durationpb.New(timeout),
+			TransportSocket: &envoycorev3.TransportSocket{
+				Name: "tls",
+				ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+					TypedConfig: mustAny(&envoytlsv3.UpstreamTlsContext{
+						CommonTlsContext: &envoytlsv3.CommonTlsContext{
+							TlsCertificates: []*envoytlsv3.TlsCertificate{{
+								PrivateKey: &envoycorev3.DataSource{
+									Specifier: &envoycorev3.DataSource_InlineString{InlineString: key},
+								},
+							}},
+						},
+					}),
+				},
+			},
+		}
+	}
+	snapshot := func(version string, clusters ...*envoyclusterv3.Cluster) *envoycache.Snapshot {
+		items := map[string]envoycachetypes.ResourceWithTTL{}
+		for _, c := range clusters {
+			items[c.GetName()] = envoycachetypes.ResourceWithTTL{Resource: c}
+		}
+		snap := &envoycache.Snapshot{}
+		snap.Resources[envoycachetypes.Cluster] = envoycache.Resources{Version: version, Items: items}
+		return snap
+	}
+
+	h := NewSnapshotHistory(2)
+	h.Record("proxy", "add", snapshot("1", cluster("a", time.Second, "key1")))
+	h.Record("proxy", "update", snapshot("2", cluster("a", time.Second, "key1"), cluster("b", time.Second, "key1")))
+	h.Record("proxy", "update", snapshot("3", cluster("a", 2*time.Second, "key2"), cluster("c", time.Second, "key1")))
+	g.Expect(h.Proxies()).To(gomega.Equal([]string{"proxy"}))
+
+	// the oldest snapshot was evicted
+	versions, err := h.Versions("proxy", true)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(versions).To(gomega.HaveLen(2))
+	g.Expect(versions[0].Version).To(gomega.BeEquivalentTo(2))
+	g.Expect(versions[1].Version).To(gomega.BeEquivalentTo(3))
+	g.Expect(versions[1].Event).To(gomega.Equal("update"))
+	g.Expect(versions[1].TypeVersions).To(gomega.Equal(map[string]string{resource.ClusterType: "3"}))
+	g.Expect(versions[1].Resources[resource.ClusterType]).To(gomega.HaveKey("c"))
+	g.Expect(versions[0].Resources[resource.ClusterType]["a"]).NotTo(gomega.Equal(versions[1].Resources[resource.ClusterType]["a"]))
+
+	_, err = h.Diff("proxy", 1, 3)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("snapshot 1 of proxy proxy is not in the history")))
+	_, err = h.Diff("other", 0, 0)
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	// the latest snapshot is compared with the one before by default
+	diff, err := h.Diff("proxy", 0, 0)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(diff.From).To(gomega.BeEquivalentTo(2))
+	g.Expect(diff.To).To(gomega.BeEquivalentTo(3))
+	g.Expect(diff.Added).To(gomega.Equal([]ResourceRef{{TypeURL: resource.ClusterType, Name: "c"}}))
+	g.Expect(diff.Removed).To(gomega.Equal([]ResourceRef{{TypeURL: resource.ClusterType, Name: "b"}}))
+	g.Expect(diff.Modified).To(gomega.HaveLen(1))
+	g.Expect(diff.Modified[0].Name).To(gomega.Equal("a"))
+	// the private key changed, but is redacted
+	g.Expect(diff.Modified[0].Fields).To(gomega.Equal([]FieldDiff{
+		{Path: "connect_timeout", From: "1s", To: "2s"},
+	}))
+
+	h.DeleteProxy("proxy")
+	g.Expect(h.Proxies()).To(gomega.BeEmpty())
+
+	// a disabled history records nothing
+	var disabled *SnapshotHistory = NewSnapshotHistory(0)
+	disabled.Record("proxy", "add", snapshot("1"))
+	g.Expect(disabled.Proxies()).To(gomega.BeEmpty())
+}
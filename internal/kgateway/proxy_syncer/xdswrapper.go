@@ -3,8 +3,11 @@ package proxy_syncer
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	udpaannontations "github.com/cncf/xds/go/udpa/annotations"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 
@@ -19,6 +22,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 var (
@@ -68,7 +72,24 @@ func (p XdsSnapWrapper) MarshalJSON() (out []byte, err error) {
 		})
 	}
 
-	snap := xds.CloneSnap(p.snap)
+	snapJson, err := RedactedSnapshot(p.snap)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Snap     any
+		ProxyKey string
+	}{
+		Snap:     snapJson,
+		ProxyKey: p.proxyKey,
+	})
+}
+
+// RedactedSnapshot returns the listeners, clusters, routes and endpoints of a snapshot as JSON objects keyed
+// by resource name, with their sensitive fields redacted. The snapshot itself is left untouched.
+func RedactedSnapshot(snap *envoycache.Snapshot) (snapJson map[string]map[string]any, err error) {
+	snap = xds.CloneSnap(snap)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -78,19 +99,12 @@ func (p XdsSnapWrapper) MarshalJSON() (out []byte, err error) {
 
 	// redact things
 	redact(snap)
-	snapJson := map[string]map[string]any{}
+	snapJson = map[string]map[string]any{}
 	addToSnap(snapJson, "Listeners", snap.Resources[envoycachetypes.Listener].Items)
 	addToSnap(snapJson, "Clusters", snap.Resources[envoycachetypes.Cluster].Items)
 	addToSnap(snapJson, "Routes", snap.Resources[envoycachetypes.Route].Items)
 	addToSnap(snapJson, "Endpoints", snap.Resources[envoycachetypes.Endpoint].Items)
-
-	return json.Marshal(struct {
-		Snap     any
-		ProxyKey string
-	}{
-		Snap:     snapJson,
-		ProxyKey: p.proxyKey,
-	})
+	return snapJson, nil
 }
 
 func addToSnap(snapJson map[string]map[string]any, k string, resources map[string]envoycachetypes.ResourceWithTTL) {
@@ -105,13 +119,26 @@ func addToSnap(snapJson map[string]map[string]any, k string, resources map[strin
 	}
 }
 
+// sensitiveMetadataKeys are the keys of the filter metadata that hold credentials, such as the auth token
+// of the AI backend endpoints
+var sensitiveMetadataKeys = []string{"auth_token"}
+
+// sensitiveHeaders are the headers of the config that hold credentials, such as the AI cache config sent
+// to the AI extension in the ext_proc initial metadata
+var sensitiveHeaders = []string{"x-cache-config"}
+
 func redact(snap *envoycache.Snapshot) {
-	// clusters and listener might have secrets
-	for _, l := range snap.Resources[envoycachetypes.Listener].Items {
-		redactProto(l.Resource)
-	}
-	for _, l := range snap.Resources[envoycachetypes.Cluster].Items {
-		redactProto(l.Resource)
+	// all resources might have secrets: clusters and listeners in their TLS config, routes in the ext_proc
+	// metadata and endpoints in their metadata
+	for _, typ := range []envoycachetypes.ResponseType{
+		envoycachetypes.Listener,
+		envoycachetypes.Cluster,
+		envoycachetypes.Route,
+		envoycachetypes.Endpoint,
+	} {
+		for _, l := range snap.Resources[typ].Items {
+			redactProto(l.Resource)
+		}
 	}
 }
 
@@ -131,6 +158,22 @@ func isSensitive(fd protoreflect.FieldDescriptor) bool {
 }
 
 func visitFields(msg protoreflect.Message, ancestor_sensitive bool) {
+	switch m := msg.Interface().(type) {
+	case *envoycorev3.Metadata:
+		for _, fields := range m.GetFilterMetadata() {
+			for _, k := range sensitiveMetadataKeys {
+				if _, ok := fields.GetFields()[k]; ok {
+					fields.Fields[k] = structpb.NewStringValue("[REDACTED]")
+				}
+			}
+		}
+	case *envoycorev3.HeaderValue:
+		if slices.Contains(sensitiveHeaders, strings.ToLower(m.GetKey())) {
+			m.Value = "[REDACTED]"
+			m.RawValue = nil
+		}
+	}
+
 	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
 		sensitive := ancestor_sensitive || isSensitive(fd)
 
@@ -103,6 +103,9 @@ func NewControlPlane(
 
 	snapshotCache := envoycache.NewSnapshotCache(true, xds.NewNodeRoleHasher(), envoyLoggerAdapter)
 
+	// The server serves both state-of-the-world and incremental (delta) xDS from the same cache: the
+	// API type is chosen by the proxies (see the XdsDelta setting). The version map of the snapshots
+	// lets delta streams only send the resources that changed.
 	xdsServer := xdsserver.NewServer(ctx, snapshotCache, callbacks)
 
 	// Register reflection and services on both servers
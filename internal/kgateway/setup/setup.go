@@ -26,6 +26,9 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/admin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/controller"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/irtranslator"
+	tmetrics "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	agwplugins "github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/plugins"
 	"github.com/kgateway-dev/kgateway/v2/pkg/client/clientset/versioned"
@@ -263,7 +266,7 @@ func New(opts ...func(*setup)) (*setup, error) {
 	}
 
 	if s.validator == nil {
-		s.validator = validator.NewBinary()
+		s.validator = validator.New(s.globalSettings.XdsValidators)
 	}
 
 	return s, nil
@@ -276,6 +279,7 @@ func (s *setup) Start(ctx context.Context) error {
 
 	metrics.SetRegistry(s.globalSettings.EnableBuiltinDefaultMetrics, nil)
 	metrics.SetActive(!(mgrOpts.Metrics.BindAddress == "" || mgrOpts.Metrics.BindAddress == "0"))
+	tmetrics.SetDetail(s.globalSettings.TranslatorMetricsDetail)
 
 	mgr, err := ctrl.NewManager(s.restConfig, *mgrOpts)
 	if err != nil {
@@ -287,7 +291,8 @@ func (s *setup) Start(ctx context.Context) error {
 		return err
 	}
 
-	uniqueClientCallbacks, uccBuilder := krtcollections.NewUniquelyConnectedClients(s.extraXDSCallbacks, s.globalSettings.XdsAuth)
+	xdsStatus := krtcollections.NewXdsStatus()
+	uniqueClientCallbacks, uccBuilder := krtcollections.NewUniquelyConnectedClients(s.extraXDSCallbacks, s.globalSettings.XdsAuth, xdsStatus)
 
 	istioClient, err := CreateKubeClient(s.restConfig)
 	if err != nil {
@@ -306,9 +311,14 @@ func (s *setup) Start(ctx context.Context) error {
 	cache := NewControlPlane(ctx, s.xdsListener, s.agwXdsListener, uniqueClientCallbacks, authenticators, s.globalSettings.XdsAuth)
 
 	setupOpts := &controller.SetupOpts{
-		Cache:          cache,
-		KrtDebugger:    s.krtDebugger,
-		GlobalSettings: s.globalSettings,
+		Cache:           cache,
+		KrtDebugger:     s.krtDebugger,
+		XdsStatus:       xdsStatus,
+		PolicyExplorer:  irtranslator.NewPolicyExplorer(),
+		SnapshotHistory: proxy_syncer.NewSnapshotHistory(s.globalSettings.XdsSnapshotHistorySize),
+		APIReader:       mgr.GetAPIReader(),
+		Scheme:          mgr.GetScheme(),
+		GlobalSettings:  s.globalSettings,
 	}
 
 	slog.Info("creating krt collections")
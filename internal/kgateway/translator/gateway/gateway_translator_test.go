@@ -165,6 +165,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("conflicting routes report shadowed and ambiguous rules", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "route-conflicts.yaml",
+			outputFile: "route-conflicts.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "infra",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("httproute with missing backend reports correctly", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "http-routing-missing-backend",
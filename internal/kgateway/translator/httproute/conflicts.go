@@ -0,0 +1,278 @@
+package httproute
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/routeutils"
+	reports "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+// RouteConflict is a route rule match that loses to a higher precedence match of the same virtual host.
+type RouteConflict struct {
+	Host   string
+	Loser  ir.HttpRouteRuleMatchIR
+	Winner ir.HttpRouteRuleMatchIR
+	// Shadowed is true when the winner matches every request the loser matches, so the loser never
+	// receives traffic. Otherwise the matches overlap and the winner only wins because its route was
+	// created first or has a lower namespace/name.
+	Shadowed bool
+}
+
+// RouteConflicts collects the conflicts between the routes of the virtual hosts of a Gateway.
+// A nil *RouteConflicts ignores the routes it is given.
+type RouteConflicts struct {
+	conflicts []RouteConflict
+}
+
+func NewRouteConflicts() *RouteConflicts {
+	return &RouteConflicts{}
+}
+
+// Detect records the conflicts between the routes of the virtual host for host. The routes must be sorted
+// by precedence, highest first, as they are in the virtual host.
+func (c *RouteConflicts) Detect(host string, routes []ir.HttpRouteRuleMatchIR) {
+	if c == nil {
+		return
+	}
+	c.conflicts = append(c.conflicts, DetectRouteConflicts(host, routes)...)
+}
+
+// Conflicts returns the conflicts detected so far.
+func (c *RouteConflicts) Conflicts() []RouteConflict {
+	if c == nil {
+		return nil
+	}
+	return c.conflicts
+}
+
+// Report sets the Conflicted condition on the parent status of each route that loses a conflict, and
+// updates the shadowed rules metric of the Gateway.
+func (c *RouteConflicts) Report(gateway types.NamespacedName, reporter reports.Reporter) {
+	if c == nil {
+		return
+	}
+
+	type loserKey struct {
+		route     types.NamespacedName
+		parentRef string
+	}
+	type loser struct {
+		route     ir.HttpRouteRuleMatchIR
+		shadowed  bool
+		conflicts sets.Set[string]
+	}
+	losers := map[loserKey]*loser{}
+	var keys []loserKey
+	shadowed := sets.New[string]()
+	for _, conflict := range c.conflicts {
+		key := loserKey{
+			route:     types.NamespacedName{Namespace: conflict.Loser.Parent.Namespace, Name: conflict.Loser.Parent.Name},
+			parentRef: parentRefString(conflict.Loser.ParentRef),
+		}
+		l, ok := losers[key]
+		if !ok {
+			l = &loser{route: conflict.Loser, conflicts: sets.New[string]()}
+			losers[key] = l
+			keys = append(keys, key)
+		}
+		if conflict.Shadowed {
+			l.shadowed = true
+			shadowed.Insert(conflict.Loser.Name)
+		}
+		l.conflicts.Insert(conflictMessage(conflict))
+	}
+
+	for _, key := range keys {
+		l := losers[key]
+		reason := reports.RouteReasonAmbiguousOverlap
+		if l.shadowed {
+			reason = reports.RouteReasonRuleShadowed
+		}
+		reporter.Route(l.route.Parent.GetSourceObject()).ParentRef(&l.route.ParentRef).SetCondition(reports.RouteCondition{
+			Type:    reports.RouteConditionConflicted,
+			Status:  metav1.ConditionTrue,
+			Reason:  reason,
+			Message: strings.Join(sets.List(l.conflicts), "; "),
+		})
+	}
+
+	setShadowedRules(shadowedRulesMetricLabels{
+		Namespace:   gateway.Namespace,
+		GatewayName: gateway.Name,
+	}, shadowed.Len())
+}
+
+func conflictMessage(conflict RouteConflict) string {
+	verb := "overlaps ambiguously with"
+	if conflict.Shadowed {
+		verb = "is shadowed by"
+	}
+	return fmt.Sprintf("rule %s %s rule %s of HTTPRoute %s/%s on host %s",
+		conflict.Loser.Name, verb, conflict.Winner.Name, conflict.Winner.Parent.Namespace, conflict.Winner.Parent.Name, conflict.Host)
+}
+
+func parentRefString(ref gwv1.ParentReference) string {
+	var ns, section string
+	if ref.Namespace != nil {
+		ns = string(*ref.Namespace)
+	}
+	if ref.SectionName != nil {
+		section = string(*ref.SectionName)
+	}
+	return ns + "/" + string(ref.Name) + "/" + section
+}
+
+// DetectRouteConflicts returns the conflicts between the given routes of the virtual host for host, which
+// must be sorted by precedence, highest first. A route conflicts with the first higher precedence route that
+// shadows it or, failing that, with the first higher precedence route of another HTTPRoute that has the same
+// precedence and an overlapping match.
+//
+// Delegated routes are ignored, as their precedence is governed by the parent route, as are routes that
+// delegate or were not accepted.
+func DetectRouteConflicts(host string, routes []ir.HttpRouteRuleMatchIR) []RouteConflict {
+	var conflicts []RouteConflict
+	for j := range routes {
+		loser := routes[j]
+		if !conflictCandidate(loser) {
+			continue
+		}
+		var ambiguous *ir.HttpRouteRuleMatchIR
+		shadowed := false
+		for i := range j {
+			winner := routes[i]
+			if !conflictCandidate(winner) {
+				continue
+			}
+			if matchCovers(winner.Match, loser.Match) {
+				conflicts = append(conflicts, RouteConflict{Host: host, Loser: loser, Winner: winner, Shadowed: true})
+				shadowed = true
+				break
+			}
+			if ambiguous == nil && winner.Parent.ObjectSource != loser.Parent.ObjectSource &&
+				winner.PrecedenceWeight == loser.PrecedenceWeight &&
+				equallySpecific(winner.Match, loser.Match) && matchesOverlap(winner.Match, loser.Match) {
+				ambiguous = &routes[i]
+			}
+		}
+		if !shadowed && ambiguous != nil {
+			conflicts = append(conflicts, RouteConflict{Host: host, Loser: loser, Winner: *ambiguous})
+		}
+	}
+	return conflicts
+}
+
+func conflictCandidate(route ir.HttpRouteRuleMatchIR) bool {
+	return route.Parent != nil && route.DelegatingParent == nil && !route.Delegates && route.RouteAcceptanceError == nil
+}
+
+// equallySpecific returns true when the Gateway API precedence rules do not order the matches, so the
+// order of their routes is decided by creation timestamp and name.
+func equallySpecific(a, b gwv1.HTTPRouteMatch) bool {
+	aType, aValue := routeutils.ParsePath(a.Path)
+	bType, bValue := routeutils.ParsePath(b.Path)
+	if aType != bType {
+		return false
+	}
+	if aType != gwv1.PathMatchRegularExpression && len(aValue) != len(bValue) {
+		return false
+	}
+	return (a.Method == nil) == (b.Method == nil) &&
+		len(a.Headers) == len(b.Headers) &&
+		len(a.QueryParams) == len(b.QueryParams)
+}
+
+// matchCovers returns true when every request matched by b is also matched by a.
+func matchCovers(a, b gwv1.HTTPRouteMatch) bool {
+	if !pathCovers(a.Path, b.Path) {
+		return false
+	}
+	if a.Method != nil && (b.Method == nil || *a.Method != *b.Method) {
+		return false
+	}
+	for _, h := range a.Headers {
+		if !slices.ContainsFunc(b.Headers, func(bh gwv1.HTTPHeaderMatch) bool {
+			return strings.EqualFold(string(h.Name), string(bh.Name)) && headerMatchType(h.Type) == headerMatchType(bh.Type) && h.Value == bh.Value
+		}) {
+			return false
+		}
+	}
+	for _, q := range a.QueryParams {
+		if !slices.ContainsFunc(b.QueryParams, func(bq gwv1.HTTPQueryParamMatch) bool {
+			return q.Name == bq.Name && queryParamMatchType(q.Type) == queryParamMatchType(bq.Type) && q.Value == bq.Value
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesOverlap returns true when a request may be matched by both a and b. Regular expressions are only
+// known to overlap when they are identical.
+func matchesOverlap(a, b gwv1.HTTPRouteMatch) bool {
+	if !pathCovers(a.Path, b.Path) && !pathCovers(b.Path, a.Path) {
+		return false
+	}
+	if a.Method != nil && b.Method != nil && *a.Method != *b.Method {
+		return false
+	}
+	for _, h := range a.Headers {
+		if headerMatchType(h.Type) != gwv1.HeaderMatchExact {
+			continue
+		}
+		if slices.ContainsFunc(b.Headers, func(bh gwv1.HTTPHeaderMatch) bool {
+			return strings.EqualFold(string(h.Name), string(bh.Name)) && headerMatchType(bh.Type) == gwv1.HeaderMatchExact && h.Value != bh.Value
+		}) {
+			return false
+		}
+	}
+	for _, q := range a.QueryParams {
+		if queryParamMatchType(q.Type) != gwv1.QueryParamMatchExact {
+			continue
+		}
+		if slices.ContainsFunc(b.QueryParams, func(bq gwv1.HTTPQueryParamMatch) bool {
+			return q.Name == bq.Name && queryParamMatchType(bq.Type) == gwv1.QueryParamMatchExact && q.Value != bq.Value
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// pathCovers returns true when every path matched by b is also matched by a.
+func pathCovers(a, b *gwv1.HTTPPathMatch) bool {
+	aType, aValue := routeutils.ParsePath(a)
+	bType, bValue := routeutils.ParsePath(b)
+	switch aType {
+	case gwv1.PathMatchPathPrefix:
+		if bType == gwv1.PathMatchRegularExpression {
+			return aValue == "/"
+		}
+		// prefixes match path elements: /foo matches /foo and /foo/bar, but not /foobar
+		prefix := strings.TrimSuffix(aValue, "/")
+		return prefix == "" || bValue == prefix || strings.HasPrefix(bValue, prefix+"/")
+	default:
+		return aType == bType && aValue == bValue
+	}
+}
+
+func headerMatchType(t *gwv1.HeaderMatchType) gwv1.HeaderMatchType {
+	if t == nil {
+		return gwv1.HeaderMatchExact
+	}
+	return *t
+}
+
+func queryParamMatchType(t *gwv1.QueryParamMatchType) gwv1.QueryParamMatchType {
+	if t == nil {
+		return gwv1.QueryParamMatchExact
+	}
+	return *t
+}
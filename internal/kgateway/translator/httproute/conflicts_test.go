@@ -0,0 +1,95 @@
+package httproute_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/httproute"
+)
+
+var _ = Describe("DetectRouteConflicts", func() {
+	routeA := &ir.HttpRouteIR{ObjectSource: ir.ObjectSource{Kind: "HTTPRoute", Namespace: "default", Name: "a"}}
+	routeB := &ir.HttpRouteIR{ObjectSource: ir.ObjectSource{Kind: "HTTPRoute", Namespace: "default", Name: "b"}}
+
+	match := func(parent *ir.HttpRouteIR, name string, m gwv1.HTTPRouteMatch) ir.HttpRouteRuleMatchIR {
+		return ir.HttpRouteRuleMatchIR{Parent: parent, Name: name, Match: m, PrecedenceWeight: parent.PrecedenceWeight}
+	}
+	path := func(t gwv1.PathMatchType, v string) *gwv1.HTTPPathMatch {
+		return &gwv1.HTTPPathMatch{Type: ptr.To(t), Value: ptr.To(v)}
+	}
+
+	DescribeTable("detects conflicts between routes sorted by precedence",
+		func(winner, loser gwv1.HTTPRouteMatch, shadowed, ambiguous bool) {
+			conflicts := httproute.DetectRouteConflicts("example.com", []ir.HttpRouteRuleMatchIR{
+				match(routeA, "winner", winner),
+				match(routeB, "loser", loser),
+			})
+			if !shadowed && !ambiguous {
+				Expect(conflicts).To(BeEmpty())
+				return
+			}
+			Expect(conflicts).To(HaveLen(1))
+			Expect(conflicts[0].Winner.Name).To(Equal("winner"))
+			Expect(conflicts[0].Loser.Name).To(Equal("loser"))
+			Expect(conflicts[0].Shadowed).To(Equal(shadowed))
+		},
+		Entry("identical prefixes",
+			gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchPathPrefix, "/api")},
+			gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchPathPrefix, "/api")},
+			true, false),
+		Entry("prefix covers exact path below it",
+			gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchPathPrefix, "/api")},
+			gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchExact, "/api/users")},
+			true, false),
+		Entry("prefix matches whole path elements",
+			gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchPathPrefix, "/api")},
+			gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchPathPrefix, "/apis")},
+			false, false),
+		Entry("winner without method covers loser with method",
+			gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchPathPrefix, "/")},
+			gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchPathPrefix, "/"), Method: ptr.To(gwv1.HTTPMethodGet)},
+			true, false),
+		Entry("headers of the winner must be required by the loser",
+			gwv1.HTTPRouteMatch{Headers: []gwv1.HTTPHeaderMatch{{Name: "X-Env", Value: "prod"}}},
+			gwv1.HTTPRouteMatch{Headers: []gwv1.HTTPHeaderMatch{{Name: "x-env", Value: "prod"}, {Name: "x-team", Value: "a"}}},
+			true, false),
+		Entry("different header names overlap",
+			gwv1.HTTPRouteMatch{Headers: []gwv1.HTTPHeaderMatch{{Name: "x-env", Value: "prod"}}},
+			gwv1.HTTPRouteMatch{Headers: []gwv1.HTTPHeaderMatch{{Name: "x-team", Value: "a"}}},
+			false, true),
+		Entry("different values of the same header are disjoint",
+			gwv1.HTTPRouteMatch{Headers: []gwv1.HTTPHeaderMatch{{Name: "x-env", Value: "prod"}}},
+			gwv1.HTTPRouteMatch{Headers: []gwv1.HTTPHeaderMatch{{Name: "x-env", Value: "dev"}}},
+			false, false),
+		Entry("different methods are disjoint",
+			gwv1.HTTPRouteMatch{Method: ptr.To(gwv1.HTTPMethodGet), QueryParams: []gwv1.HTTPQueryParamMatch{{Name: "a", Value: "1"}}},
+			gwv1.HTTPRouteMatch{Method: ptr.To(gwv1.HTTPMethodPost), QueryParams: []gwv1.HTTPQueryParamMatch{{Name: "b", Value: "1"}}},
+			false, false),
+		Entry("different regexes are not known to overlap",
+			gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchRegularExpression, "/a/.*")},
+			gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchRegularExpression, "/.*/b")},
+			false, false),
+	)
+
+	It("does not report an ambiguous overlap between routes of different weights", func() {
+		heavy := &ir.HttpRouteIR{ObjectSource: ir.ObjectSource{Kind: "HTTPRoute", Namespace: "default", Name: "heavy"}, PrecedenceWeight: 10}
+		conflicts := httproute.DetectRouteConflicts("example.com", []ir.HttpRouteRuleMatchIR{
+			match(heavy, "winner", gwv1.HTTPRouteMatch{Headers: []gwv1.HTTPHeaderMatch{{Name: "x-env", Value: "prod"}}}),
+			match(routeB, "loser", gwv1.HTTPRouteMatch{Headers: []gwv1.HTTPHeaderMatch{{Name: "x-team", Value: "a"}}}),
+		})
+		Expect(conflicts).To(BeEmpty())
+	})
+
+	It("ignores delegated routes", func() {
+		delegated := match(routeB, "loser", gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchPathPrefix, "/api")})
+		delegated.DelegatingParent = &ir.HttpRouteRuleMatchIR{}
+		conflicts := httproute.DetectRouteConflicts("example.com", []ir.HttpRouteRuleMatchIR{
+			match(routeA, "winner", gwv1.HTTPRouteMatch{Path: path(gwv1.PathMatchPathPrefix, "/")}),
+			delegated,
+		})
+		Expect(conflicts).To(BeEmpty())
+	})
+})
@@ -0,0 +1,63 @@
+package httproute
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
+)
+
+const (
+	routingSubsystem = "routing"
+)
+
+var (
+	shadowedRules = metrics.NewGauge(
+		metrics.GaugeOpts{
+			Subsystem: routingSubsystem,
+			Name:      "shadowed_rules",
+			Help:      "Number of route rules shadowed by a higher precedence rule per gateway",
+		},
+		[]string{"namespace", "gateway"},
+	)
+)
+
+// shadowedRulesMetricLabels is used as an argument to setShadowedRules
+type shadowedRulesMetricLabels struct {
+	Namespace   string
+	GatewayName string
+}
+
+// toMetricsLabels converts shadowedRulesMetricLabels to a slice of metrics.Labels.
+func (r shadowedRulesMetricLabels) toMetricsLabels() []metrics.Label {
+	return []metrics.Label{
+		{Name: "namespace", Value: r.Namespace},
+		{Name: "gateway", Value: r.GatewayName},
+	}
+}
+
+// setShadowedRules sets the number of shadowed rules gauge metric. The gauge is deleted when the
+// Gateway has no shadowed rules, so that it does not keep a series per Gateway.
+func setShadowedRules(labels shadowedRulesMetricLabels, rules int) {
+	if !metrics.Active() {
+		return
+	}
+
+	if rules == 0 {
+		shadowedRules.Delete(labels.toMetricsLabels()...)
+		return
+	}
+	shadowedRules.Set(float64(rules), labels.toMetricsLabels()...)
+}
+
+// DeleteShadowedRules deletes the shadowed rules gauge metric of a Gateway. It must be called
+// when the Gateway is deleted.
+func DeleteShadowedRules(gateway types.NamespacedName) {
+	if !metrics.Active() {
+		return
+	}
+
+	shadowedRules.Delete(shadowedRulesMetricLabels{
+		Namespace:   gateway.Namespace,
+		GatewayName: gateway.Name,
+	}.toMetricsLabels()...)
+}
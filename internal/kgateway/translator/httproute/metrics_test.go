@@ -0,0 +1,40 @@
+package httproute
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics/metricstest"
+)
+
+func TestShadowedRulesMetric(t *testing.T) {
+	shadowedRules.Reset()
+	defer shadowedRules.Reset()
+
+	gw1 := shadowedRulesMetricLabels{Namespace: "default", GatewayName: "gw1"}
+	gw2 := shadowedRulesMetricLabels{Namespace: "default", GatewayName: "gw2"}
+
+	setShadowedRules(gw1, 2)
+	setShadowedRules(gw2, 1)
+
+	gathered := metricstest.MustGatherMetrics(t)
+	gathered.AssertMetrics("kgateway_routing_shadowed_rules", []metricstest.ExpectMetric{
+		&metricstest.ExpectedMetric{Labels: gw1.toMetricsLabels(), Value: 2},
+		&metricstest.ExpectedMetric{Labels: gw2.toMetricsLabels(), Value: 1},
+	})
+
+	// the gauge of a Gateway whose shadowing routes were removed is deleted
+	setShadowedRules(gw1, 0)
+
+	gathered = metricstest.MustGatherMetrics(t)
+	gathered.AssertMetrics("kgateway_routing_shadowed_rules", []metricstest.ExpectMetric{
+		&metricstest.ExpectedMetric{Labels: gw2.toMetricsLabels(), Value: 1},
+	})
+
+	// the gauge of a deleted Gateway is deleted
+	DeleteShadowedRules(types.NamespacedName{Namespace: "default", Name: "gw2"})
+
+	gathered = metricstest.MustGatherMetrics(t)
+	gathered.AssertMetricNotExists("kgateway_routing_shadowed_rules")
+}
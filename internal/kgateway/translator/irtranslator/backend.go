@@ -3,6 +3,7 @@ package irtranslator
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
@@ -21,8 +22,10 @@ import (
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/endpoints"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	tmetrics "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
@@ -109,15 +112,29 @@ func (t *BackendTranslator) runPolicies(
 		}
 	}
 
+	// the role of the client identifies the Gateway the cluster is translated for
+	var gwName, gwNamespace string
+	if parts := strings.Split(ucc.Role, xds.KeyDelimiter); len(parts) >= 3 {
+		gwNamespace, gwName = parts[1], parts[2]
+	}
+
 	var errs []error
 	for gk, policyPlugin := range t.ContributedPolicies {
+		metricLabels := tmetrics.PluginMetricLabels{
+			Plugin:    gk.String(),
+			Pass:      tmetrics.PluginPassProcessBackend,
+			Name:      gwName,
+			Namespace: gwNamespace,
+		}
 		// TODO: in theory it would be nice to do `ProcessBackend` once, and only do
 		// the the per-client processing for each client.
 		// that would require refactoring and thinking about the proper IR, so we'll punt on that for
 		// now, until we have more backend plugin examples to properly understand what it should look
 		// like.
 		if policyPlugin.PerClientProcessBackend != nil {
+			finishMetrics := tmetrics.CollectPluginMetrics(metricLabels)
 			policyPlugin.PerClientProcessBackend(kctx, ctx, ucc, *backend, out)
+			finishMetrics()
 		}
 		// run endpoint plugins if we have endpoints to process
 		if endpointInputs != nil && policyPlugin.PerClientProcessEndpoints != nil {
@@ -135,7 +152,9 @@ func (t *BackendTranslator) runPolicies(
 				errs = append(errs, polAttachment.Errors...)
 				continue
 			}
+			finishMetrics := tmetrics.CollectPluginMetrics(metricLabels)
 			policyPlugin.ProcessBackend(ctx, polAttachment.PolicyIr, *backend, out)
+			finishMetrics()
 		}
 	}
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
+	tmetrics "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	sdkreporter "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 )
@@ -275,8 +276,10 @@ func (h *hcmNetworkFilterTranslator) computeHttpFilters(ctx context.Context, l i
 	var httpFilters plugins.StagedHttpFilterList
 
 	// run the HttpFilter Plugins
-	for _, plug := range h.pluginPass {
+	for gk, plug := range h.pluginPass {
+		finishMetrics := tmetrics.CollectPluginMetrics(pluginMetricLabels(gk, tmetrics.PluginPassHttpFilters, h.gateway))
 		stagedFilters, err := plug.HttpFilters(l.FilterChainCommon)
+		finishMetrics()
 		if err != nil {
 			// what to do with errors here? ignore the listener??
 			h.listenerReporter.SetCondition(sdkreporter.ListenerCondition{
@@ -17,6 +17,7 @@ import (
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/query"
+	tmetrics "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
@@ -30,6 +31,8 @@ type Translator struct {
 	ContributedPolicies map[schema.GroupKind]sdk.PolicyPlugin
 	ValidationLevel     apisettings.ValidationMode
 	Validator           validator.Validator
+	// PolicyExplorer, if set, records the translated Gateways to explain the policies of their routes.
+	PolicyExplorer *PolicyExplorer
 }
 
 type TranslationPassPlugins map[schema.GroupKind]*TranslationPass
@@ -44,6 +47,7 @@ type TranslationResult struct {
 func (t *Translator) Translate(ctx context.Context, gw ir.GatewayIR, reporter sdkreporter.Reporter) TranslationResult {
 	pass := t.newPass(reporter)
 	var res TranslationResult
+	t.PolicyExplorer.record(gw, t.ContributedPolicies)
 
 	for _, l := range gw.Listeners {
 		outListener, routes := t.ComputeListener(ctx, pass, gw, l, reporter)
@@ -222,13 +226,28 @@ func (t *Translator) runListenerPlugins(
 					Name:      gwv1.ObjectName(gw.SourceObject.GetName()),
 				},
 			}
+			finishMetrics := tmetrics.CollectPluginMetrics(pluginMetricLabels(gk, tmetrics.PluginPassApplyListenerPlugin, gw))
 			pass.ApplyListenerPlugin(pctx, out)
+			finishMetrics()
 		}
 		out.Metadata = addMergeOriginsToFilterMetadata(gk, mergeOrigins, out.GetMetadata())
 		reportPolicyAttachmentStatus(reporter, l.PolicyAncestorRef, mergeOrigins, pols...)
 	}
 }
 
+// pluginMetricLabels returns the labels of the metrics of a plugin hook run while translating gw
+func pluginMetricLabels(gk schema.GroupKind, pass tmetrics.PluginPass, gw ir.GatewayIR) tmetrics.PluginMetricLabels {
+	labels := tmetrics.PluginMetricLabels{
+		Plugin: gk.String(),
+		Pass:   pass,
+	}
+	if gw.SourceObject != nil {
+		labels.Name = gw.SourceObject.GetName()
+		labels.Namespace = gw.SourceObject.GetNamespace()
+	}
+	return labels
+}
+
 func (t *Translator) newPass(reporter sdkreporter.Reporter) TranslationPassPlugins {
 	ret := TranslationPassPlugins{}
 	for k, v := range t.ContributedPolicies {
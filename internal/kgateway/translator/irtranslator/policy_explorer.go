@@ -0,0 +1,419 @@
+package irtranslator
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// Scopes of the Envoy config policies are applied to, from the most to the least specific.
+// Envoy uses the most specific configuration of a filter, so a field set by a policy applied
+// to a route overrides the same field set at the virtual host or route configuration scope.
+const (
+	PolicyScopeRoute              = "route"
+	PolicyScopeVirtualHost        = "virtualHost"
+	PolicyScopeRouteConfiguration = "routeConfiguration"
+)
+
+// AllFields is the field reported for the policies of plugins that do not merge policies, as
+// each of these policies is applied as a whole.
+const AllFields = "*"
+
+// PolicyExplorer keeps the IR of the translated Gateways, to explain which policies apply to
+// their routes. Policies are only merged when an explanation is requested, so recording the IR
+// does not slow down translation.
+type PolicyExplorer struct {
+	lock     sync.RWMutex
+	gateways map[types.NamespacedName]explorerGateway
+}
+
+type explorerGateway struct {
+	gw       ir.GatewayIR
+	policies map[schema.GroupKind]sdk.PolicyPlugin
+}
+
+func NewPolicyExplorer() *PolicyExplorer {
+	return &PolicyExplorer{gateways: map[types.NamespacedName]explorerGateway{}}
+}
+
+// PolicyQuery selects the routes of a Gateway whose policies are explained. Empty fields match
+// every listener, route and rule.
+type PolicyQuery struct {
+	Gateway  types.NamespacedName
+	Listener string
+	Route    *types.NamespacedName
+	// Rule is the name or the index of a rule of Route
+	Rule string
+}
+
+// RoutePolicies is the effective policy of each plugin applied to a route.
+type RoutePolicies struct {
+	Listener    string `json:"listener"`
+	VirtualHost string `json:"virtualHost"`
+	// Route is the namespace/name of the route the rule belongs to
+	Route      string `json:"route,omitempty"`
+	Name       string `json:"name"`
+	MatchIndex int    `json:"matchIndex"`
+	// Plugins are sorted by GroupKind
+	Plugins []PluginPolicies `json:"plugins"`
+}
+
+// PluginPolicies explains the effective policy of a plugin (policy GroupKind) applied to a route.
+type PluginPolicies struct {
+	GroupKind string `json:"groupKind"`
+	// Errors of the policies that make the merged policy of a scope invalid. An invalid merged policy
+	// is not applied, and the route, virtual host or route configuration is replaced with a direct response.
+	Errors []string `json:"errors,omitempty"`
+	// Fields are the fields of the effective policy and the policies that set them, sorted by field
+	Fields []FieldOrigin `json:"fields"`
+	// Policies are the attached policies, per scope from high to low priority
+	Policies []ExplainedPolicy `json:"policies"`
+}
+
+// FieldOrigin is a field of an effective policy and the policies that contributed to it.
+type FieldOrigin struct {
+	Field string `json:"field"`
+	Scope string `json:"scope"`
+	// Policies are the IDs of the contributing policies. More than one policy contributes to a
+	// field when policies are deep merged. Empty if the field comes from an extensionRef.
+	Policies []string `json:"policies"`
+}
+
+// ExplainedPolicy is a policy attached to a route, with the fields it contributed to the effective
+// policy and the ones it lost to higher priority policies.
+type ExplainedPolicy struct {
+	// Policy is the ID of the policy, or "extensionRef" if the policy is referenced by a filter
+	Policy string `json:"policy"`
+	Scope  string `json:"scope"`
+	// Level is where the policy is attached in the scope, e.g. "rule" or "gateway"
+	Level                   string `json:"level"`
+	HierarchicalPriority    int    `json:"hierarchicalPriority"`
+	Weight                  int32  `json:"weight"`
+	InheritedPolicyPriority string `json:"inheritedPolicyPriority,omitempty"`
+	// Errors make the policy ignored
+	Errors      []string          `json:"errors,omitempty"`
+	Contributed []string          `json:"contributed,omitempty"`
+	Overridden  []OverriddenField `json:"overridden,omitempty"`
+}
+
+// OverriddenField is a field set by a policy that was not used for the effective policy.
+type OverriddenField struct {
+	Field string `json:"field"`
+	// By are the IDs of the policies that set the field instead
+	By     []string `json:"by"`
+	Reason string   `json:"reason"`
+}
+
+// record replaces the IR of a Gateway.
+func (e *PolicyExplorer) record(gw ir.GatewayIR, policies map[schema.GroupKind]sdk.PolicyPlugin) {
+	if e == nil || gw.SourceObject == nil || gw.SourceObject.Obj == nil {
+		return
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.gateways[types.NamespacedName{Namespace: gw.SourceObject.Obj.Namespace, Name: gw.SourceObject.Obj.Name}] = explorerGateway{
+		gw:       gw,
+		policies: policies,
+	}
+}
+
+// DeleteGateway forgets the IR of a deleted Gateway.
+func (e *PolicyExplorer) DeleteGateway(nn types.NamespacedName) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	delete(e.gateways, nn)
+}
+
+// Gateways returns the Gateways whose policies can be explained, sorted by namespace and name.
+func (e *PolicyExplorer) Gateways() []types.NamespacedName {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	out := make([]types.NamespacedName, 0, len(e.gateways))
+	for nn := range e.gateways {
+		out = append(out, nn)
+	}
+	slices.SortFunc(out, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return out
+}
+
+// Explain returns the effective policies of the routes selected by q.
+func (e *PolicyExplorer) Explain(q PolicyQuery) ([]RoutePolicies, error) {
+	e.lock.RLock()
+	g, ok := e.gateways[q.Gateway]
+	e.lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("gateway %s not found", q.Gateway)
+	}
+
+	var out []RoutePolicies
+	for _, lis := range g.gw.Listeners {
+		for _, hfc := range lis.HttpFilterChain {
+			// same order as ComputeRouteConfiguration: listener policies are more specific than gateway ones
+			rcLevels := []policyLevel{
+				{name: "listener", policies: hfc.AttachedPolicies},
+				{name: "gateway", policies: g.gw.AttachedHttpPolicies},
+			}
+			for _, vh := range hfc.Vhosts {
+				listener := string(vh.ParentRef.Name)
+				if q.Listener != "" && q.Listener != listener {
+					continue
+				}
+				vhLevels := []policyLevel{{name: "listener", policies: vh.AttachedPolicies}}
+				for _, rule := range vh.Rules {
+					if !q.matchesRoute(rule) {
+						continue
+					}
+					rp := RoutePolicies{
+						Listener:    listener,
+						VirtualHost: vh.Name,
+						Name:        rule.Name,
+						MatchIndex:  rule.MatchIndex,
+					}
+					if rule.Parent != nil {
+						rp.Route = rule.Parent.Namespace + "/" + rule.Parent.Name
+					}
+					rp.Plugins = explainRoute(g.policies, map[string][]policyLevel{
+						PolicyScopeRoute:              routePolicyLevels(rule),
+						PolicyScopeVirtualHost:        vhLevels,
+						PolicyScopeRouteConfiguration: rcLevels,
+					})
+					out = append(out, rp)
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+func (q PolicyQuery) matchesRoute(rule ir.HttpRouteRuleMatchIR) bool {
+	if q.Route == nil {
+		return true
+	}
+	if rule.Parent == nil || rule.Parent.Namespace != q.Route.Namespace || rule.Parent.Name != q.Route.Name {
+		return false
+	}
+	if q.Rule == "" {
+		return true
+	}
+	// the name of a rule match is built by query.RouteInfo.UniqueRouteName:
+	// <kind>-<name>-<namespace>-<rule index>-<match index>[-<rule name>]
+	prefix := fmt.Sprintf("%s-%s-%s-", strings.ToLower(rule.Parent.Kind), rule.Parent.Name, rule.Parent.Namespace)
+	parts := strings.SplitN(strings.TrimPrefix(rule.Name, prefix), "-", 3)
+	if _, err := strconv.Atoi(q.Rule); err == nil {
+		return parts[0] == q.Rule
+	}
+	return len(parts) == 3 && parts[2] == q.Rule
+}
+
+// explainedScope is the explanation of the policies of a plugin in a scope.
+type explainedScope struct {
+	scope    string
+	origins  ir.MergeOrigins
+	policies []explainedAtt
+	// invalid are the IDs of the policies whose errors make the merged policy invalid
+	invalid []string
+	errors  []string
+}
+
+type explainedAtt struct {
+	att      ir.PolicyAtt
+	level    string
+	levelIdx int
+	fields   []string
+	out      *ExplainedPolicy
+}
+
+func explainRoute(plugins map[schema.GroupKind]sdk.PolicyPlugin, levels map[string][]policyLevel) []PluginPolicies {
+	gks := map[schema.GroupKind]struct{}{}
+	for _, scopeLevels := range levels {
+		for _, l := range scopeLevels {
+			for gk, pols := range l.policies.Policies {
+				if len(pols) > 0 {
+					gks[gk] = struct{}{}
+				}
+			}
+		}
+	}
+
+	var out []PluginPolicies
+	for gk := range gks {
+		plugin := plugins[gk]
+		pp := PluginPolicies{GroupKind: gk.String()}
+		claimed := map[string]FieldOrigin{}
+		for _, scope := range []string{PolicyScopeRoute, PolicyScopeVirtualHost, PolicyScopeRouteConfiguration} {
+			es := explainScope(scope, plugin, gk, levels[scope])
+			for _, err := range es.errors {
+				pp.Errors = append(pp.Errors, scope+": "+err)
+			}
+			for field, ids := range es.origins {
+				if _, ok := claimed[field]; !ok {
+					claimed[field] = FieldOrigin{Field: field, Scope: scope, Policies: sortedIDs(ids.UnsortedList())}
+				}
+			}
+			for _, p := range es.policies {
+				for _, field := range p.fields {
+					winner, ok := claimed[field]
+					switch {
+					case len(p.att.Errors) > 0:
+					case len(es.invalid) > 0:
+						p.out.Overridden = append(p.out.Overridden, OverriddenField{
+							Field:  field,
+							By:     es.invalid,
+							Reason: fmt.Sprintf("not applied: policies with errors make the merged policy of the %s scope invalid", scope),
+						})
+					case ok && winner.Scope != scope:
+						p.out.Overridden = append(p.out.Overridden, OverriddenField{
+							Field:  field,
+							By:     winner.Policies,
+							Reason: fmt.Sprintf("overridden at the more specific %s scope", winner.Scope),
+						})
+					case contributes(p.att, es.origins, field):
+						p.out.Contributed = append(p.out.Contributed, field)
+					default:
+						p.out.Overridden = append(p.out.Overridden, OverriddenField{
+							Field:  field,
+							By:     winner.Policies,
+							Reason: overrideReason(p, es, field),
+						})
+					}
+				}
+				pp.Policies = append(pp.Policies, *p.out)
+			}
+		}
+		for _, f := range claimed {
+			pp.Fields = append(pp.Fields, f)
+		}
+		slices.SortFunc(pp.Fields, func(a, b FieldOrigin) int {
+			return strings.Compare(a.Field, b.Field)
+		})
+		out = append(out, pp)
+	}
+	slices.SortFunc(out, func(a, b PluginPolicies) int {
+		return strings.Compare(a.GroupKind, b.GroupKind)
+	})
+	return out
+}
+
+// explainScope merges the policies of a plugin attached in a scope the same way the translator does,
+// and the fields each of them sets.
+func explainScope(scope string, plugin sdk.PolicyPlugin, gk schema.GroupKind, levels []policyLevel) explainedScope {
+	es := explainedScope{scope: scope, origins: ir.MergeOrigins{}}
+	var all []ir.PolicyAtt
+	for i, l := range levels {
+		for _, att := range l.policies.Policies[gk] {
+			att.HierarchicalPriority = l.hierarchicalPriority
+			all = append(all, att)
+			ep := &ExplainedPolicy{
+				Policy:                  policyID(att),
+				Scope:                   scope,
+				Level:                   l.name,
+				HierarchicalPriority:    l.hierarchicalPriority,
+				Weight:                  att.PrecedenceWeight,
+				InheritedPolicyPriority: string(att.InheritedPolicyPriority),
+			}
+			for _, err := range att.Errors {
+				ep.Errors = append(ep.Errors, err.Error())
+			}
+			es.policies = append(es.policies, explainedAtt{att: att, level: l.name, levelIdx: i, out: ep})
+		}
+	}
+	if len(all) == 0 {
+		return es
+	}
+
+	if plugin.MergePolicies == nil {
+		// every valid policy is applied as a whole
+		for i, p := range es.policies {
+			if len(p.att.Errors) == 0 {
+				es.policies[i].fields = []string{AllFields}
+				es.origins.Append(AllFields, p.att.PolicyRef, nil)
+			}
+		}
+		return es
+	}
+
+	// the merged policy carries the errors of all the policies, and the translator does not apply
+	// a merged policy with errors
+	merged := plugin.MergePolicies(all)
+	if len(merged.Errors) > 0 {
+		for _, p := range es.policies {
+			if len(p.att.Errors) > 0 {
+				es.invalid = append(es.invalid, p.out.Policy)
+				es.errors = append(es.errors, p.out.Errors...)
+			}
+		}
+	} else {
+		es.origins = merged.MergeOrigins
+	}
+	for i, p := range es.policies {
+		own := plugin.MergePolicies([]ir.PolicyAtt{p.att}).MergeOrigins
+		for field := range own {
+			es.policies[i].fields = append(es.policies[i].fields, field)
+		}
+		slices.Sort(es.policies[i].fields)
+	}
+	return es
+}
+
+// contributes returns true if att contributed field to the merged policy. The origin of the fields
+// of policies without a PolicyRef (extensionRefs) is not tracked, so they are considered as the
+// contributor of the fields without an origin.
+func contributes(att ir.PolicyAtt, origins ir.MergeOrigins, field string) bool {
+	if att.PolicyRef == nil {
+		return origins[field].Len() == 0
+	}
+	return origins[field].Has(att.PolicyRef.ID())
+}
+
+// overrideReason explains why p lost field to the policies that set it in the same scope.
+func overrideReason(p explainedAtt, es explainedScope, field string) string {
+	var winner *explainedAtt
+	for i := range es.policies {
+		w := &es.policies[i]
+		if w.att.PolicyRef != nil && contributes(w.att, es.origins, field) {
+			winner = w
+			break
+		}
+	}
+	if winner == nil {
+		return "overridden by a higher priority policy"
+	}
+	w, l := winner, p
+	switch {
+	case w.att.HierarchicalPriority > l.att.HierarchicalPriority:
+		return fmt.Sprintf("%s is attached lower in the delegation chain, and child policies take precedence unless the parent route sets the %s annotation to prefer the parent",
+			policyID(w.att), apiannotations.InheritedPolicyPriority)
+	case w.att.HierarchicalPriority < l.att.HierarchicalPriority:
+		return fmt.Sprintf("%s is attached to a parent route whose %s annotation is %q",
+			policyID(w.att), apiannotations.InheritedPolicyPriority, w.att.InheritedPolicyPriority)
+	case w.levelIdx < l.levelIdx:
+		return fmt.Sprintf("%s is attached to the more specific %s level, rather than %s", policyID(w.att), w.level, l.level)
+	case w.att.PrecedenceWeight > l.att.PrecedenceWeight:
+		return fmt.Sprintf("%s has a higher weight (%d > %d)", policyID(w.att), w.att.PrecedenceWeight, l.att.PrecedenceWeight)
+	default:
+		return fmt.Sprintf("%s has the same weight and was created first", policyID(w.att))
+	}
+}
+
+func policyID(att ir.PolicyAtt) string {
+	if att.PolicyRef == nil {
+		return "extensionRef"
+	}
+	return att.PolicyRef.ID()
+}
+
+func sortedIDs(ids []string) []string {
+	slices.Sort(ids)
+	return ids
+}
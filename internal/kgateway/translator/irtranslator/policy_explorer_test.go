@@ -0,0 +1,180 @@
+package irtranslator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/policy"
+)
+
+type explorerTestPolicy struct {
+	timeout *string
+	retries *string
+}
+
+func (p *explorerTestPolicy) CreationTime() time.Time { return time.Time{} }
+func (p *explorerTestPolicy) Equals(in any) bool      { return false }
+
+func mergeExplorerTestPolicies(p1, p2 *explorerTestPolicy, p2Ref *ir.AttachedPolicyRef, p2MergeOrigins ir.MergeOrigins, opts policy.MergeOptions, mergeOrigins ir.MergeOrigins, _ string) {
+	if policy.IsMergeable(p1.timeout, p2.timeout, opts) {
+		p1.timeout = p2.timeout
+		mergeOrigins.SetOne("timeout", p2Ref, p2MergeOrigins)
+	}
+	if policy.IsMergeable(p1.retries, p2.retries, opts) {
+		p1.retries = p2.retries
+		mergeOrigins.SetOne("retries", p2Ref, p2MergeOrigins)
+	}
+}
+
+func TestPolicyExplorer(t *testing.T) {
+	gk := schema.GroupKind{Group: "test.kgateway.dev", Kind: "TestPolicy"}
+	att := func(name string, p *explorerTestPolicy, weight int32, errs ...error) ir.PolicyAtt {
+		return ir.PolicyAtt{
+			GroupKind:        gk,
+			PolicyIr:         p,
+			PolicyRef:        &ir.AttachedPolicyRef{Group: gk.Group, Kind: gk.Kind, Namespace: "default", Name: name},
+			PrecedenceWeight: weight,
+			Errors:           errs,
+		}
+	}
+	policies := func(atts ...ir.PolicyAtt) ir.AttachedPolicies {
+		return ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{gk: atts}}
+	}
+	id := func(name string) string {
+		return gk.Group + "/" + gk.Kind + "/default/" + name
+	}
+
+	route := &ir.HttpRouteIR{
+		ObjectSource:     ir.ObjectSource{Group: gwv1.GroupName, Kind: "HTTPRoute", Namespace: "default", Name: "route"},
+		AttachedPolicies: policies(att("route", &explorerTestPolicy{timeout: ptr.To("2s")}, 0)),
+	}
+	newGateway := func(rulePolicies ...ir.PolicyAtt) ir.GatewayIR {
+		rule := ir.HttpRouteRuleMatchIR{
+			Parent:           route,
+			Name:             "httproute-route-default-0-0-rule",
+			AttachedPolicies: policies(rulePolicies...),
+		}
+		return ir.GatewayIR{
+			SourceObject: &ir.Gateway{Obj: &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw"}}},
+			AttachedHttpPolicies: policies(
+				att("gateway-heavy", &explorerTestPolicy{retries: ptr.To("3")}, 1),
+				att("gateway", &explorerTestPolicy{timeout: ptr.To("5s"), retries: ptr.To("1")}, 0),
+			),
+			Listeners: []ir.ListenerIR{{
+				HttpFilterChain: []ir.HttpFilterChainIR{{
+					Vhosts: []*ir.VirtualHost{{
+						Name:      "vhost",
+						Rules:     []ir.HttpRouteRuleMatchIR{rule},
+						ParentRef: ir.Listener{Listener: gwv1.Listener{Name: "http"}},
+					}},
+				}},
+			}},
+		}
+	}
+	plugins := map[schema.GroupKind]sdk.PolicyPlugin{
+		gk: {
+			MergePolicies: func(pols []ir.PolicyAtt) ir.PolicyAtt {
+				return policy.MergePolicies(pols, mergeExplorerTestPolicies, "")
+			},
+		},
+	}
+
+	e := NewPolicyExplorer()
+	e.record(newGateway(att("rule", &explorerTestPolicy{timeout: ptr.To("1s")}, 0)), plugins)
+	assert.Equal(t, []types.NamespacedName{{Namespace: "default", Name: "gw"}}, e.Gateways())
+
+	_, err := e.Explain(PolicyQuery{Gateway: types.NamespacedName{Namespace: "default", Name: "other"}})
+	require.Error(t, err)
+
+	routes, err := e.Explain(PolicyQuery{Gateway: types.NamespacedName{Namespace: "default", Name: "gw"}, Listener: "other"})
+	require.NoError(t, err)
+	assert.Empty(t, routes)
+
+	for _, r := range []string{"0", "rule"} {
+		routes, err = e.Explain(PolicyQuery{
+			Gateway: types.NamespacedName{Namespace: "default", Name: "gw"},
+			Route:   &types.NamespacedName{Namespace: "default", Name: "route"},
+			Rule:    r,
+		})
+		require.NoError(t, err)
+		require.Len(t, routes, 1, "rule %s", r)
+	}
+	routes, err = e.Explain(PolicyQuery{
+		Gateway: types.NamespacedName{Namespace: "default", Name: "gw"},
+		Route:   &types.NamespacedName{Namespace: "default", Name: "route"},
+		Rule:    "1",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, routes)
+
+	routes, err = e.Explain(PolicyQuery{Gateway: types.NamespacedName{Namespace: "default", Name: "gw"}, Listener: "http"})
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	assert.Equal(t, "default/route", routes[0].Route)
+	assert.Equal(t, "http", routes[0].Listener)
+	require.Len(t, routes[0].Plugins, 1)
+	pp := routes[0].Plugins[0]
+
+	assert.Equal(t, []FieldOrigin{
+		{Field: "retries", Scope: PolicyScopeRouteConfiguration, Policies: []string{id("gateway-heavy")}},
+		{Field: "timeout", Scope: PolicyScopeRoute, Policies: []string{id("rule")}},
+	}, pp.Fields)
+
+	byID := map[string]ExplainedPolicy{}
+	for _, p := range pp.Policies {
+		byID[p.Policy] = p
+	}
+	require.Len(t, byID, 4)
+	assert.Empty(t, pp.Errors)
+
+	assert.Equal(t, []string{"timeout"}, byID[id("rule")].Contributed)
+
+	routePol := byID[id("route")]
+	assert.Empty(t, routePol.Contributed)
+	require.Len(t, routePol.Overridden, 1)
+	assert.Equal(t, []string{id("rule")}, routePol.Overridden[0].By)
+	assert.Contains(t, routePol.Overridden[0].Reason, "more specific rule level")
+
+	gwPol := byID[id("gateway")]
+	assert.Empty(t, gwPol.Contributed)
+	require.Len(t, gwPol.Overridden, 2)
+	assert.Equal(t, "retries", gwPol.Overridden[0].Field)
+	assert.Contains(t, gwPol.Overridden[0].Reason, "higher weight (1 > 0)")
+	assert.Equal(t, "timeout", gwPol.Overridden[1].Field)
+	assert.Contains(t, gwPol.Overridden[1].Reason, "more specific route scope")
+
+	// an invalid policy makes the merged policy of its scope invalid
+	e.record(newGateway(
+		att("rule-invalid", &explorerTestPolicy{timeout: ptr.To("1s")}, 10, errors.New("invalid policy")),
+		att("rule", &explorerTestPolicy{timeout: ptr.To("1s")}, 0),
+	), plugins)
+	routes, err = e.Explain(PolicyQuery{Gateway: types.NamespacedName{Namespace: "default", Name: "gw"}})
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	pp = routes[0].Plugins[0]
+	assert.Equal(t, []string{"route: invalid policy"}, pp.Errors)
+	assert.Equal(t, FieldOrigin{Field: "timeout", Scope: PolicyScopeRouteConfiguration, Policies: []string{id("gateway")}}, pp.Fields[1])
+	byID = map[string]ExplainedPolicy{}
+	for _, p := range pp.Policies {
+		byID[p.Policy] = p
+	}
+	assert.Equal(t, []string{"invalid policy"}, byID[id("rule-invalid")].Errors)
+	assert.Empty(t, byID[id("rule-invalid")].Overridden)
+	require.Len(t, byID[id("rule")].Overridden, 1)
+	assert.Equal(t, []string{id("rule-invalid")}, byID[id("rule")].Overridden[0].By)
+	assert.Contains(t, byID[id("rule")].Overridden[0].Reason, "merged policy of the route scope invalid")
+
+	e.DeleteGateway(types.NamespacedName{Namespace: "default", Name: "gw"})
+	assert.Empty(t, e.Gateways())
+}
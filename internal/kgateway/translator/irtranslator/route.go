@@ -18,6 +18,7 @@ import (
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	tmetrics "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/routeutils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
@@ -386,23 +387,12 @@ func (h *httpRouteConfigurationTranslator) runRoutePlugins(
 	// A policy appearing earlier in the list has a higher priority than a policy appearing later in the list during merging.
 
 	var attachedPolicies ir.AttachedPolicies
-
-	// rule-level policies in priority order (high to low)
-	attachedPolicies.Append(in.ExtensionRefs, in.AttachedPolicies)
-
-	// route-level policy
-	if in.Parent != nil {
-		attachedPolicies.Append(in.Parent.AttachedPolicies)
-	}
-
-	hierarchicalPriority := 0
-	delegatingParent := in.DelegatingParent
-	for delegatingParent != nil {
-		// parent policies are lower in priority by default, so mark them with their relative priority
-		hierarchicalPriority--
-		attachedPolicies.AppendWithPriority(hierarchicalPriority,
-			delegatingParent.ExtensionRefs, delegatingParent.AttachedPolicies, delegatingParent.Parent.AttachedPolicies)
-		delegatingParent = delegatingParent.DelegatingParent
+	for _, level := range routePolicyLevels(in) {
+		if level.hierarchicalPriority == 0 {
+			attachedPolicies.Append(level.policies)
+		} else {
+			attachedPolicies.AppendWithPriority(level.hierarchicalPriority, level.policies)
+		}
 	}
 
 	var errs []error
@@ -433,7 +423,9 @@ func (h *httpRouteConfigurationTranslator) runRoutePlugins(
 			}
 
 			pctx.Policy = pol.PolicyIr
+			finishMetrics := tmetrics.CollectPluginMetrics(pluginMetricLabels(gk, tmetrics.PluginPassApplyForRoute, h.gw))
 			err := pass.ApplyForRoute(pctx, out)
+			finishMetrics()
 			if err != nil {
 				errs = append(errs, err)
 			}
@@ -445,6 +437,41 @@ func (h *httpRouteConfigurationTranslator) runRoutePlugins(
 	return errors.Join(errs...)
 }
 
+// policyLevel holds the policies attached at one level of the config hierarchy of a route.
+type policyLevel struct {
+	// name describes where the policies are attached, e.g. "rule" or "parent route"
+	name                 string
+	hierarchicalPriority int
+	policies             ir.AttachedPolicies
+}
+
+// routePolicyLevels returns the levels of the policies applied to a route, from high to low priority:
+// the rule, the route, and then the rules and routes up the delegation chain.
+func routePolicyLevels(in ir.HttpRouteRuleMatchIR) []policyLevel {
+	// rule-level policies in priority order (high to low)
+	levels := []policyLevel{
+		{name: "rule extensionRef", policies: in.ExtensionRefs},
+		{name: "rule", policies: in.AttachedPolicies},
+	}
+
+	// route-level policy
+	if in.Parent != nil {
+		levels = append(levels, policyLevel{name: "route", policies: in.Parent.AttachedPolicies})
+	}
+
+	hierarchicalPriority := 0
+	for delegatingParent := in.DelegatingParent; delegatingParent != nil; delegatingParent = delegatingParent.DelegatingParent {
+		// parent policies are lower in priority by default, so mark them with their relative priority
+		hierarchicalPriority--
+		levels = append(levels,
+			policyLevel{name: "parent rule extensionRef", hierarchicalPriority: hierarchicalPriority, policies: delegatingParent.ExtensionRefs},
+			policyLevel{name: "parent rule", hierarchicalPriority: hierarchicalPriority, policies: delegatingParent.AttachedPolicies},
+			policyLevel{name: "parent route", hierarchicalPriority: hierarchicalPriority, policies: delegatingParent.Parent.AttachedPolicies},
+		)
+	}
+	return levels
+}
+
 func mergePolicies(pass *TranslationPass, policies []ir.PolicyAtt) ([]ir.PolicyAtt, ir.MergeOrigins) {
 	if pass.MergePolicies != nil {
 		mergedPolicy := pass.MergePolicies(policies)
@@ -10,6 +10,7 @@ import (
 
 	"istio.io/istio/pkg/kube/krt"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	corev1 "k8s.io/api/core/v1"
@@ -57,6 +58,7 @@ func TranslateListeners(
 	validatedListeners := validateGateway(gateway, reporter)
 	mergedListeners := mergeGWListeners(queries, gateway.Namespace, validatedListeners, *gateway, routesForGw, reporter, settings)
 	translatedListeners := mergedListeners.translateListeners(kctx, ctx, queries, reporter)
+	mergedListeners.conflicts.Report(types.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}, reporter)
 
 	return translatedListeners
 }
@@ -75,6 +77,7 @@ func mergeGWListeners(
 		GatewayNamespace: gatewayNamespace,
 		Queries:          queries,
 		settings:         settings,
+		conflicts:        route.NewRouteConflicts(),
 	}
 	for _, listener := range listeners {
 		result := routesForGw.GetListenerResult(listener.Parent, string(listener.Name))
@@ -100,6 +103,8 @@ type MergedListeners struct {
 	Listeners        []*MergedListener
 	Queries          query.GatewayQueries
 	settings         ListenerTranslatorConfig
+	// conflicts collects the conflicts between the routes of the virtual hosts of all the listeners
+	conflicts *route.RouteConflicts
 }
 
 func (ml *MergedListeners) AppendListener(
@@ -166,6 +171,7 @@ func (ml *MergedListeners) appendHttpListener(
 		listener:         listener,
 		gateway:          ml.parentGw,
 		settings:         ml.settings,
+		conflicts:        ml.conflicts,
 	})
 }
 
@@ -201,6 +207,7 @@ func (ml *MergedListeners) appendHttpsListener(
 		listener:          listener,
 		gateway:           ml.parentGw,
 		settings:          ml.settings,
+		conflicts:         ml.conflicts,
 	})
 }
 
@@ -236,6 +243,7 @@ func (ml *MergedListeners) AppendTcpListener(
 		listener:         listener,
 		gateway:          ml.parentGw,
 		settings:         ml.settings,
+		conflicts:        ml.conflicts,
 	})
 }
 
@@ -272,6 +280,7 @@ func (ml *MergedListeners) AppendTlsListener(
 		listenerReporter: reporter,
 		listener:         listener,
 		settings:         ml.settings,
+		conflicts:        ml.conflicts,
 	})
 }
 
@@ -300,6 +309,7 @@ type MergedListener struct {
 	listener          ir.Listener
 	gateway           ir.Gateway
 	settings          ListenerTranslatorConfig
+	conflicts         *route.RouteConflicts
 }
 
 func (ml *MergedListener) TranslateListener(
@@ -315,6 +325,7 @@ func (ml *MergedListener) TranslateListener(
 			ctx,
 			ml.name,
 			reporter,
+			ml.conflicts,
 		)
 		httpFilterChains = append(httpFilterChains, httpFilterChain)
 	}
@@ -329,6 +340,7 @@ func (ml *MergedListener) TranslateListener(
 			queries,
 			reporter,
 			ml.listenerReporter,
+			ml.conflicts,
 		)
 		if err != nil {
 			// Log and skip invalid HTTPS filter chains
@@ -571,6 +583,7 @@ func (httpFilterChain *httpFilterChain) translateHttpFilterChain(
 	ctx context.Context,
 	parentName string,
 	reporter reports.Reporter,
+	conflicts *route.RouteConflicts,
 ) ir.HttpFilterChainIR {
 	routesByHost := map[string]routeutils.SortableRoutes{}
 	for _, parent := range httpFilterChain.parents {
@@ -620,10 +633,12 @@ func (httpFilterChain *httpFilterChain) translateHttpFilterChain(
 		}
 		virtualHostNames[vhostName] = true
 
+		rules := vhostRoutes.ToRoutes()
+		conflicts.Detect(host, rules)
 		virtualHosts = append(virtualHosts, &ir.VirtualHost{
 			Name:             vhostName,
 			Hostname:         host,
-			Rules:            vhostRoutes.ToRoutes(),
+			Rules:            rules,
 			AttachedPolicies: attachedPolicies,
 			ParentRef:        listenerRef,
 		})
@@ -665,6 +680,7 @@ func (httpsFilterChain *httpsFilterChain) translateHttpsFilterChain(
 	queries query.GatewayQueries,
 	reporter reports.Reporter,
 	listenerReporter reports.ListenerReporter,
+	conflicts *route.RouteConflicts,
 ) (*ir.HttpFilterChainIR, error) {
 	// process routes first, so any route related errors are reported on the httproute.
 	routesByHost := map[string]routeutils.SortableRoutes{}
@@ -684,10 +700,12 @@ func (httpsFilterChain *httpsFilterChain) translateHttpsFilterChain(
 		vhostName := makeVhostName(ctx, parentName, host)
 		if !virtualHostNames[vhostName] {
 			virtualHostNames[vhostName] = true
+			rules := vhostRoutes.ToRoutes()
+			conflicts.Detect(host, rules)
 			virtualHost := &ir.VirtualHost{
 				Name:     vhostName,
 				Hostname: host,
-				Rules:    vhostRoutes.ToRoutes(),
+				Rules:    rules,
 			}
 			virtualHosts = append(virtualHosts, virtualHost)
 		}
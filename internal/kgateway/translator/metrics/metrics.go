@@ -1,8 +1,10 @@
 package metrics
 
 import (
+	"sync/atomic"
 	"time"
 
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
 )
 
@@ -12,6 +14,18 @@ const (
 	nameLabel           = "name"
 	namespaceLabel      = "namespace"
 	resultLabel         = "result"
+	pluginLabel         = "plugin"
+	passLabel           = "pass"
+)
+
+// PluginPass is a plugin hook run during translation
+type PluginPass string
+
+const (
+	PluginPassApplyForRoute       PluginPass = "ApplyForRoute"
+	PluginPassApplyListenerPlugin PluginPass = "ApplyListenerPlugin"
+	PluginPassHttpFilters         PluginPass = "HttpFilters"
+	PluginPassProcessBackend      PluginPass = "ProcessBackend"
 )
 
 var (
@@ -36,6 +50,19 @@ var (
 		},
 		[]string{nameLabel, namespaceLabel, translatorNameLabel},
 	)
+	pluginHistogramBuckets = []float64{0.00001, 0.000025, 0.00005, 0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1}
+	pluginDuration         = metrics.NewHistogram(
+		metrics.HistogramOpts{
+			Subsystem:                       translatorSubsystem,
+			Name:                            "plugin_duration_seconds",
+			Help:                            "Duration of the plugin hooks run during translation",
+			Buckets:                         pluginHistogramBuckets,
+			NativeHistogramBucketFactor:     1.1,
+			NativeHistogramMaxBucketNumber:  100,
+			NativeHistogramMinResetDuration: time.Hour,
+		},
+		[]string{pluginLabel, passLabel, nameLabel, namespaceLabel},
+	)
 	translationsRunning = metrics.NewGauge(
 		metrics.GaugeOpts{
 			Subsystem: translatorSubsystem,
@@ -60,6 +87,58 @@ func (t TranslatorMetricLabels) toMetricsLabels() []metrics.Label {
 	}
 }
 
+// PluginMetricLabels defines the labels of the plugin metrics. Name and Namespace are those of the Gateway
+// being translated, and are only recorded when the translator metrics detail is "gateway".
+type PluginMetricLabels struct {
+	Plugin    string
+	Pass      PluginPass
+	Name      string
+	Namespace string
+}
+
+func (p PluginMetricLabels) toMetricsLabels() []metrics.Label {
+	labels := []metrics.Label{
+		{Name: pluginLabel, Value: p.Plugin},
+		{Name: passLabel, Value: string(p.Pass)},
+	}
+	if Detail() == apisettings.TranslatorMetricsDetailGateway {
+		labels = append(labels,
+			metrics.Label{Name: nameLabel, Value: p.Name},
+			metrics.Label{Name: namespaceLabel, Value: p.Namespace},
+		)
+	}
+	return labels
+}
+
+var detail atomic.Value
+
+// SetDetail sets the detail of the plugin metrics, and of the size metrics of the generated resources.
+func SetDetail(d apisettings.TranslatorMetricsDetail) {
+	detail.Store(d)
+}
+
+// Detail returns the detail of the plugin metrics, "plugin" unless set otherwise.
+func Detail() apisettings.TranslatorMetricsDetail {
+	if d, ok := detail.Load().(apisettings.TranslatorMetricsDetail); ok {
+		return d
+	}
+	return apisettings.TranslatorMetricsDetailPlugin
+}
+
+// CollectPluginMetrics is called before a plugin hook is run and returns a function called once it
+// returns to record its duration.
+func CollectPluginMetrics(labels PluginMetricLabels) func() {
+	if !metrics.Active() || Detail() == apisettings.TranslatorMetricsDetailNone {
+		return func() {}
+	}
+
+	start := time.Now()
+
+	return func() {
+		pluginDuration.Observe(time.Since(start).Seconds(), labels.toMetricsLabels()...)
+	}
+}
+
 // CollectTranslationMetrics is called at the start of a translation function to
 // begin metrics collection and returns a function called at the end to complete
 // metrics recording.
@@ -96,4 +175,5 @@ func ResetMetrics() {
 	translationsTotal.Reset()
 	translationDuration.Reset()
 	translationsRunning.Reset()
+	pluginDuration.Reset()
 }
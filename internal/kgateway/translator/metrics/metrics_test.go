@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 	. "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/metrics"
 	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
 	"github.com/kgateway-dev/kgateway/v2/pkg/metrics/metricstest"
@@ -134,3 +135,54 @@ func TestTranslationMetricsNotActive(t *testing.T) {
 	currentMetrics.AssertMetricNotExists("kgateway_translator_translations_total")
 	currentMetrics.AssertMetricNotExists("kgateway_translator_translation_duration_seconds")
 }
+
+func TestCollectPluginMetrics(t *testing.T) {
+	labels := PluginMetricLabels{
+		Plugin:    "TrafficPolicy.gateway.kgateway.dev",
+		Pass:      PluginPassApplyForRoute,
+		Name:      testGatewayName,
+		Namespace: testNamespace,
+	}
+
+	t.Run("plugin detail", func(t *testing.T) {
+		setupTest()
+
+		CollectPluginMetrics(labels)()
+
+		currentMetrics := metricstest.MustGatherMetrics(t)
+		currentMetrics.AssertMetricLabels("kgateway_translator_plugin_duration_seconds", []metrics.Label{
+			{Name: "name", Value: ""},
+			{Name: "namespace", Value: ""},
+			{Name: "pass", Value: "ApplyForRoute"},
+			{Name: "plugin", Value: "TrafficPolicy.gateway.kgateway.dev"},
+		})
+		currentMetrics.AssertHistogramPopulated("kgateway_translator_plugin_duration_seconds")
+	})
+
+	t.Run("gateway detail", func(t *testing.T) {
+		SetDetail(apisettings.TranslatorMetricsDetailGateway)
+		defer SetDetail(apisettings.TranslatorMetricsDetailPlugin)
+		setupTest()
+
+		CollectPluginMetrics(labels)()
+
+		currentMetrics := metricstest.MustGatherMetrics(t)
+		currentMetrics.AssertMetricLabels("kgateway_translator_plugin_duration_seconds", []metrics.Label{
+			{Name: "name", Value: testGatewayName},
+			{Name: "namespace", Value: testNamespace},
+			{Name: "pass", Value: "ApplyForRoute"},
+			{Name: "plugin", Value: "TrafficPolicy.gateway.kgateway.dev"},
+		})
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		SetDetail(apisettings.TranslatorMetricsDetailNone)
+		defer SetDetail(apisettings.TranslatorMetricsDetailPlugin)
+		setupTest()
+
+		CollectPluginMetrics(labels)()
+
+		currentMetrics := metricstest.MustGatherMetrics(t)
+		currentMetrics.AssertMetricNotExists("kgateway_translator_plugin_duration_seconds")
+	})
+}
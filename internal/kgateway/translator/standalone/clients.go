@@ -0,0 +1,104 @@
+// Package standalone runs the Envoy translation of kgateway against in-memory clients, without
+// a cluster. It is shared by the offline translate command and the translator golden tests.
+package standalone
+
+import (
+	"context"
+	"fmt"
+
+	"istio.io/istio/pkg/config/schema/gvr"
+	kubeclient "istio.io/istio/pkg/kube"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/client/clientset/versioned/fake"
+)
+
+// CRDs are the resources registered with the fake client, as the translator only watches
+// resources whose CRD is installed
+var CRDs = []schema.GroupVersionResource{
+	gvr.KubernetesGateway_v1,
+	gvr.GatewayClass,
+	gvr.HTTPRoute_v1,
+	gvr.GRPCRoute,
+	gvr.Service,
+	gvr.Pod,
+	gvr.TCPRoute,
+	gvr.TLSRoute,
+	gvr.ServiceEntry,
+	gvr.WorkloadEntry,
+	gvr.AuthorizationPolicy,
+	wellknown.XListenerSetGVR,
+	wellknown.BackendTLSPolicyGVR,
+}
+
+// NewClients returns fake clients serving the given objects, with the CRDs the translator
+// depends on installed. ourObjs are the kgateway objects, served by the kgateway clientset.
+// The caller must shut down the returned client.
+func NewClients(anyObjs, ourObjs []runtime.Object) (kubeclient.CLIClient, *fake.Clientset, error) {
+	ourCli := fake.NewClientset(ourObjs...)
+	cli := kubeclient.NewFakeClient(anyObjs...)
+	for _, crd := range CRDs {
+		if err := registerCRD(cli, crd); err != nil {
+			cli.Shutdown()
+			return nil, nil, fmt.Errorf("registering CRD for %s: %w", crd, err)
+		}
+	}
+	return cli, ourCli, nil
+}
+
+// registerCRD marks the CRD of the given resource as installed. CRD presence is only looked up
+// through the metadata client, which the fake client does not keep in sync with its objects.
+func registerCRD(cli kubeclient.Client, res schema.GroupVersionResource) error {
+	fmc, ok := cli.Metadata().(*metadatafake.FakeMetadataClient)
+	if !ok {
+		return nil
+	}
+	fmd, ok := fmc.Resource(gvr.CustomResourceDefinition).(metadatafake.MetadataClient)
+	if !ok {
+		return nil
+	}
+	obj := &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s.%s", res.Resource, res.Group),
+		},
+	}
+	if _, err := fmd.CreateFake(obj, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		if _, err := fmd.UpdateFake(obj, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateGatewayClasses creates the given GatewayClasses for the controller, unless they
+// already exist
+func CreateGatewayClasses(
+	ctx context.Context,
+	cli kubeclient.Client,
+	controllerName string,
+	classNames ...string,
+) error {
+	for _, className := range classNames {
+		_, err := cli.GatewayAPI().GatewayV1().GatewayClasses().Create(ctx, &gwv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: className,
+			},
+			Spec: gwv1.GatewayClassSpec{
+				ControllerName: gwv1.GatewayController(controllerName),
+			},
+		}, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package standalone
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"google.golang.org/protobuf/proto"
+	kubeclient "istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/client-go/tools/cache"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/irtranslator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
+)
+
+// EnvoyTranslation is the outcome of the translation of every Envoy Gateway
+type EnvoyTranslation struct {
+	// Gateways are the translations of each Gateway, sorted by namespace/name
+	Gateways []GatewayTranslation
+	// Clusters are the clusters of the backends, sorted by name. They do not depend on the Gateway.
+	Clusters []*envoyclusterv3.Cluster
+	// BackendPolicyReports are the reports of the policies attached to backends, which are not
+	// reported during Gateway translation
+	BackendPolicyReports reports.ReportMap
+}
+
+// GatewayTranslation is the translation of a single Gateway. Result is nil if the Gateway
+// could not be translated.
+type GatewayTranslation struct {
+	Gateway ir.Gateway
+	Result  *irtranslator.TranslationResult
+	Reports reports.ReportMap
+}
+
+func (g GatewayTranslation) ResourceName() string {
+	return g.Gateway.ResourceName()
+}
+
+func (g GatewayTranslation) Equals(in GatewayTranslation) bool {
+	return g.Gateway.Equals(in.Gateway) && g.Result == in.Result
+}
+
+type backendCluster struct {
+	backend string
+	cluster *envoyclusterv3.Cluster
+}
+
+func (c backendCluster) ResourceName() string {
+	return c.backend
+}
+
+func (c backendCluster) Equals(in backendCluster) bool {
+	return c.backend == in.backend && proto.Equal(c.cluster, in.cluster)
+}
+
+// TranslateEnvoy translates every Envoy Gateway and backend as the proxy syncer would, using the
+// given merged plugins. The clusters are translated for the given client. It waits for the
+// collections and the given extra syncs before translating, and must be called before the
+// client is run.
+func TranslateEnvoy(
+	ctx context.Context,
+	krtOpts krtutil.KrtOptions,
+	cli kubeclient.Client,
+	commoncol *collections.CommonCollections,
+	extensions pluginsdk.Plugin,
+	settings apisettings.Settings,
+	v validator.Validator,
+	ucc ir.UniqlyConnectedClient,
+	extraSyncs ...cache.InformerSynced,
+) *EnvoyTranslation {
+	commoncol.InitPlugins(ctx, extensions, settings)
+
+	t := translator.NewCombinedTranslator(ctx, extensions, commoncol, v, nil)
+	t.Init(ctx)
+
+	cli.RunAndWait(ctx.Done())
+	commoncol.GatewayIndex.Gateways.WaitUntilSynced(ctx.Done())
+	kubeclient.WaitForCacheSync("routes", ctx.Done(), commoncol.Routes.HasSynced)
+	kubeclient.WaitForCacheSync("extensions", ctx.Done(), extensions.HasSynced)
+	kubeclient.WaitForCacheSync("commoncol", ctx.Done(), commoncol.HasSynced)
+	kubeclient.WaitForCacheSync("translator", ctx.Done(), t.HasSynced)
+	kubeclient.WaitForCacheSync("backends", ctx.Done(), commoncol.BackendIndex.HasSynced)
+	kubeclient.WaitForCacheSync("endpoints", ctx.Done(), commoncol.Endpoints.HasSynced)
+	for i, sync := range extraSyncs {
+		kubeclient.WaitForCacheSync(fmt.Sprintf("extra-%d", i), ctx.Done(), sync)
+	}
+
+	gateways := krt.NewCollection(commoncol.GatewayIndex.Gateways, func(kctx krt.HandlerContext, gw ir.Gateway) *GatewayTranslation {
+		xdsSnap, rm := t.TranslateGateway(kctx, ctx, gw)
+		return &GatewayTranslation{
+			Gateway: gw,
+			Result:  xdsSnap,
+			Reports: rm,
+		}
+	}, krtOpts.ToOptions("StandaloneGateways")...)
+
+	backends := krt.JoinCollection(commoncol.BackendIndex.BackendsWithPolicy(),
+		append(krtOpts.ToOptions("StandaloneBackends"), krt.WithJoinUnchecked())...)
+	clusters := krt.NewCollection(backends, func(kctx krt.HandlerContext, backend *ir.BackendObjectIR) *backendCluster {
+		// invalid backends are reported in their status and translated to blackhole clusters
+		c, _ := t.GetBackendTranslator().TranslateBackend(ctx, kctx, ucc, backend)
+		if c == nil {
+			return nil
+		}
+		return &backendCluster{
+			backend: backend.ResourceName(),
+			cluster: c,
+		}
+	}, krtOpts.ToOptions("StandaloneClusters")...)
+
+	gateways.WaitUntilSynced(ctx.Done())
+	clusters.WaitUntilSynced(ctx.Done())
+
+	result := &EnvoyTranslation{
+		Gateways: gateways.List(),
+	}
+	slices.SortFunc(result.Gateways, func(a, b GatewayTranslation) int {
+		return strings.Compare(a.ResourceName(), b.ResourceName())
+	})
+	for _, c := range clusters.List() {
+		result.Clusters = append(result.Clusters, c.cluster)
+	}
+	slices.SortFunc(result.Clusters, func(a, b *envoyclusterv3.Cluster) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	var backendIRs []*ir.BackendObjectIR
+	for _, col := range commoncol.BackendIndex.BackendsWithPolicyRequiringStatus() {
+		backendIRs = append(backendIRs, col.List()...)
+	}
+	result.BackendPolicyReports = proxy_syncer.GenerateBackendPolicyReport(backendIRs)
+
+	return result
+}
@@ -5,8 +5,10 @@ import (
 	"log/slog"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
+	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/krt"
 
 	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
@@ -15,9 +17,11 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/query"
 	gwtranslator "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/gateway"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/httproute"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/irtranslator"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
@@ -32,6 +36,7 @@ type CombinedTranslator struct {
 	extensions sdk.Plugin
 	commonCols *collections.CommonCollections
 	validator  validator.Validator
+	explorer   *irtranslator.PolicyExplorer
 
 	waitForSync []cache.InformerSynced
 
@@ -48,6 +53,7 @@ func NewCombinedTranslator(
 	extensions sdk.Plugin,
 	commonCols *collections.CommonCollections,
 	validator validator.Validator,
+	explorer *irtranslator.PolicyExplorer,
 ) *CombinedTranslator {
 	var endpointPlugins []sdk.EndpointPlugin
 	for _, ext := range extensions.ContributesPolicies {
@@ -61,6 +67,7 @@ func NewCombinedTranslator(
 		endpointPlugins: endpointPlugins,
 		logger:          logger,
 		validator:       validator,
+		explorer:        explorer,
 		waitForSync:     []cache.InformerSynced{extensions.HasSynced},
 	}
 }
@@ -76,6 +83,7 @@ func (s *CombinedTranslator) Init(ctx context.Context) {
 		ContributedPolicies: s.extensions.ContributesPolicies,
 		ValidationLevel:     s.commonCols.Settings.ValidationMode,
 		Validator:           s.validator,
+		PolicyExplorer:      s.explorer,
 	}
 	s.backendTranslator = &irtranslator.BackendTranslator{
 		ContributedBackends: make(map[schema.GroupKind]ir.BackendInit),
@@ -88,6 +96,12 @@ func (s *CombinedTranslator) Init(ctx context.Context) {
 		s.backendTranslator.ContributedBackends[k] = up.BackendInit
 	}
 
+	metrics.RegisterEvents(s.commonCols.GatewayIndex.Gateways, func(o krt.Event[ir.Gateway]) {
+		if o.Event == controllers.EventDelete {
+			httproute.DeleteShadowedRules(types.NamespacedName{Namespace: o.Old.Namespace, Name: o.Old.Name})
+		}
+	})
+
 	s.waitForSync = append(s.waitForSync,
 		s.commonCols.HasSynced,
 		s.extensions.HasSynced,
@@ -0,0 +1,420 @@
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/agentgateway/agentgateway/go/api"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
+)
+
+const (
+	backendTLSPolicySuffix       = ":backend-tls"
+	backendTCPPolicySuffix       = ":backend-tcp"
+	backendHTTPPolicySuffix      = ":backend-http"
+	loadBalancerPolicySuffix     = ":lb"
+	outlierDetectionPolicySuffix = ":outlier-detection"
+)
+
+// NewBackendConfigPlugin creates a new BackendConfigPolicy plugin
+func NewBackendConfigPlugin(agw *AgwCollections) AgwPlugin {
+	clusterDomain := kubeutils.GetClusterDomainName()
+	col := krt.WrapClient(kclient.NewFiltered[*v1alpha1.BackendConfigPolicy](
+		agw.Client,
+		kclient.Filter{ObjectFilter: agw.Client.ObjectFilter()},
+	), agw.KrtOpts.ToOptions("BackendConfigPolicy")...)
+	policyStatusCol, policyCol := krt.NewStatusManyCollection(col, func(krtctx krt.HandlerContext, policyCR *v1alpha1.BackendConfigPolicy) (
+		*v1alpha2.PolicyStatus,
+		[]AgwPolicy,
+	) {
+		return TranslateBackendConfigPolicy(krtctx, agw.Backends, agw.Services, agw.Secrets, policyCR, agw.ControllerName, clusterDomain)
+	})
+
+	return AgwPlugin{
+		ContributesPolicies: map[schema.GroupKind]PolicyPlugin{
+			wellknown.BackendConfigPolicyGVK.GroupKind(): {
+				Policies:       policyCol,
+				PolicyStatuses: convertStatusCollection(policyStatusCol),
+			},
+		},
+		ExtraHasSynced: func() bool {
+			return policyCol.HasSynced() && policyStatusCol.HasSynced()
+		},
+	}
+}
+
+// TranslateBackendConfigPolicy generates backend policies for a single backend config policy
+func TranslateBackendConfigPolicy(
+	krtctx krt.HandlerContext,
+	backends krt.Collection[*v1alpha1.Backend],
+	services krt.Collection[*corev1.Service],
+	secrets krt.Collection[*corev1.Secret],
+	policyCR *v1alpha1.BackendConfigPolicy,
+	controllerName string,
+	clusterDomain string,
+) (*v1alpha2.PolicyStatus, []AgwPolicy) {
+	logger := logger.With("plugin_kind", "backendconfig")
+	var agwPolicies []AgwPolicy
+	var ancestors []v1alpha2.PolicyAncestorStatus
+
+	for _, target := range backendConfigPolicyTargets(krtctx, backends, services, policyCR) {
+		parentRef := gwv1.ParentReference{
+			Group:     ptr.To(target.Group),
+			Kind:      ptr.To(target.Kind),
+			Name:      target.Name,
+			Namespace: ptr.To(gwv1.Namespace(policyCR.Namespace)),
+		}
+
+		var policyTarget *api.PolicyTarget
+		var err error
+		switch string(target.Kind) {
+		case wellknown.BackendGVK.Kind:
+			policyTarget, err = backendConfigBackendTarget(krtctx, backends, policyCR.Namespace, string(target.Name))
+		case wellknown.ServiceKind:
+			hostname := fmt.Sprintf("%s.%s.svc.%s", target.Name, policyCR.Namespace, clusterDomain)
+			policyTarget = &api.PolicyTarget{
+				Kind: &api.PolicyTarget_Service{
+					Service: fmt.Sprintf("%s/%s", policyCR.Namespace, hostname),
+				},
+			}
+		default:
+			logger.Warn("unsupported target kind", "kind", target.Kind, "policy", kubeutils.NamespacedNameFrom(policyCR))
+			continue
+		}
+
+		var translatedPolicies []AgwPolicy
+		if err == nil {
+			translatedPolicies, err = translateBackendConfigPolicyToAgw(krtctx, secrets, policyCR, policyTarget)
+			agwPolicies = append(agwPolicies, translatedPolicies...)
+		}
+
+		// Only append valid ancestors: require non-empty controllerName and parentRef name
+		if controllerName != "" && string(parentRef.Name) != "" {
+			ancestors = append(ancestors, v1alpha2.PolicyAncestorStatus{
+				AncestorRef:    parentRef,
+				ControllerName: v1alpha2.GatewayController(controllerName),
+				Conditions:     translatedPolicyAncestorConditions(translatedPolicies, err),
+			})
+		}
+	}
+
+	return buildPolicyStatus(ancestors, controllerName), agwPolicies
+}
+
+// backendConfigPolicyTargets returns the targets selected by the policy's targetRefs and
+// targetSelectors. Selectors match Services and Backends in the policy namespace.
+func backendConfigPolicyTargets(
+	krtctx krt.HandlerContext,
+	backends krt.Collection[*v1alpha1.Backend],
+	services krt.Collection[*corev1.Service],
+	policyCR *v1alpha1.BackendConfigPolicy,
+) []v1alpha1.LocalPolicyTargetReference {
+	targets := slices.Clone(policyCR.Spec.TargetRefs)
+	addTarget := func(target v1alpha1.LocalPolicyTargetReference) {
+		// a resource may be selected by both a targetRef and a targetSelector
+		if !slices.Contains(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+	for _, selector := range policyCR.Spec.TargetSelectors {
+		switch string(selector.Kind) {
+		case wellknown.BackendGVK.Kind:
+			for _, backend := range krt.Fetch(krtctx, backends, krt.FilterLabel(selector.MatchLabels)) {
+				if backend.Namespace == policyCR.Namespace {
+					addTarget(v1alpha1.LocalPolicyTargetReference{Group: selector.Group, Kind: selector.Kind, Name: gwv1.ObjectName(backend.Name)})
+				}
+			}
+		case wellknown.ServiceKind:
+			for _, svc := range krt.Fetch(krtctx, services, krt.FilterLabel(selector.MatchLabels)) {
+				if svc.Namespace == policyCR.Namespace {
+					addTarget(v1alpha1.LocalPolicyTargetReference{Group: selector.Group, Kind: selector.Kind, Name: gwv1.ObjectName(svc.Name)})
+				}
+			}
+		default:
+			logger.Warn("unsupported target selector kind", "kind", selector.Kind, "policy", kubeutils.NamespacedNameFrom(policyCR))
+		}
+	}
+	return targets
+}
+
+// backendConfigBackendTarget resolves the policy target for a kgateway Backend
+func backendConfigBackendTarget(
+	krtctx krt.HandlerContext,
+	backends krt.Collection[*v1alpha1.Backend],
+	namespace, name string,
+) (*api.PolicyTarget, error) {
+	backendRef := types.NamespacedName{Namespace: namespace, Name: name}
+	backend := krt.FetchOne(krtctx, backends, krt.FilterObjectName(backendRef))
+	if backend == nil || *backend == nil {
+		return nil, fmt.Errorf("Backend %s not found", name)
+	}
+	// Single provider AI backends use api.ProviderGroups(ref: buildAIIr), so policies must be applied per-provider using PolicyTarget_SubBackend
+	if ai := (*backend).Spec.AI; ai != nil && ai.LLM != nil {
+		return &api.PolicyTarget{
+			Kind: &api.PolicyTarget_SubBackend{
+				SubBackend: utils.InternalBackendName(namespace, name, utils.SingularLLMProviderSubBackendName),
+			},
+		}, nil
+	}
+	return &api.PolicyTarget{
+		Kind: &api.PolicyTarget_Backend{
+			Backend: utils.InternalBackendName(namespace, name, ""),
+		},
+	}, nil
+}
+
+// translateBackendConfigPolicyToAgw converts a BackendConfigPolicy to agentgateway Policy resources.
+// Fields that cannot be represented in agentgateway are reported as unsupported, while the remaining
+// fields are still translated.
+func translateBackendConfigPolicyToAgw(
+	krtctx krt.HandlerContext,
+	secrets krt.Collection[*corev1.Secret],
+	policyCR *v1alpha1.BackendConfigPolicy,
+	policyTarget *api.PolicyTarget,
+) ([]AgwPolicy, error) {
+	spec := policyCR.Spec
+	policyName := policyCR.Namespace + "/" + policyCR.Name
+	var agwPolicies []AgwPolicy
+	var errs []error
+
+	if spec.TLS != nil {
+		policies, err := processBackendTLS(krtctx, secrets, policyCR, policyName, policyTarget)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		agwPolicies = append(agwPolicies, policies...)
+	}
+
+	if spec.ConnectTimeout != nil {
+		agwPolicies = append(agwPolicies, AgwPolicy{&api.Policy{
+			Name:   policyName + backendTCPPolicySuffix + attachmentName(policyTarget),
+			Target: policyTarget,
+			Spec: &api.PolicySpec{Kind: &api.PolicySpec_BackendTcp{
+				BackendTcp: &api.PolicySpec_BackendTCP{
+					ConnectTimeout: durationpb.New(spec.ConnectTimeout.Duration),
+				},
+			}},
+		}})
+	}
+
+	policies, err := processBackendHTTP(spec, policyName, policyTarget)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	agwPolicies = append(agwPolicies, policies...)
+
+	if spec.LoadBalancer != nil {
+		policies, err := processLoadBalancer(spec.LoadBalancer, policyName, policyTarget)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		agwPolicies = append(agwPolicies, policies...)
+	}
+
+	if od := spec.OutlierDetection; od != nil {
+		outlierDetection := &api.PolicySpec_OutlierDetection{}
+		if od.Consecutive5xx != nil {
+			outlierDetection.Consecutive_5Xx = wrapperspb.UInt32(uint32(*od.Consecutive5xx)) //nolint:gosec // G115: validated to be non-negative
+		}
+		if od.Interval != nil {
+			outlierDetection.Interval = durationpb.New(od.Interval.Duration)
+		}
+		if od.BaseEjectionTime != nil {
+			outlierDetection.BaseEjectionTime = durationpb.New(od.BaseEjectionTime.Duration)
+		}
+		if od.MaxEjectionPercent != nil {
+			outlierDetection.MaxEjectionPercent = wrapperspb.UInt32(uint32(*od.MaxEjectionPercent)) //nolint:gosec // G115: validated to be between 0 and 100
+		}
+		agwPolicies = append(agwPolicies, AgwPolicy{&api.Policy{
+			Name:   policyName + outlierDetectionPolicySuffix + attachmentName(policyTarget),
+			Target: policyTarget,
+			Spec: &api.PolicySpec{Kind: &api.PolicySpec_OutlierDetection_{
+				OutlierDetection: outlierDetection,
+			}},
+		}})
+	}
+
+	if spec.PerConnectionBufferLimitBytes != nil {
+		errs = append(errs, unsupportedField("perConnectionBufferLimitBytes"))
+	}
+	if spec.TCPKeepalive != nil {
+		errs = append(errs, unsupportedField("tcpKeepalive"))
+	}
+	if spec.CommonHttpProtocolOptions != nil {
+		errs = append(errs, unsupportedField("commonHttpProtocolOptions"))
+	}
+	if spec.HealthCheck != nil {
+		errs = append(errs, unsupportedField("healthCheck"))
+	}
+
+	return agwPolicies, errors.Join(errs...)
+}
+
+// processBackendTLS translates the TLS settings of a BackendConfigPolicy into a backend TLS policy
+func processBackendTLS(
+	krtctx krt.HandlerContext,
+	secrets krt.Collection[*corev1.Secret],
+	policyCR *v1alpha1.BackendConfigPolicy,
+	policyName string,
+	policyTarget *api.PolicyTarget,
+) ([]AgwPolicy, error) {
+	tls := policyCR.Spec.TLS
+	backendTLS := &api.PolicySpec_BackendTLS{}
+	var errs []error
+
+	if tls.SecretRef != nil {
+		nn := types.NamespacedName{Namespace: policyCR.Namespace, Name: tls.SecretRef.Name}
+		secret := krt.FetchOne(krtctx, secrets, krt.FilterObjectName(nn))
+		if secret == nil || *secret == nil {
+			return nil, fmt.Errorf("Secret %s not found", nn)
+		}
+		data := (*secret).Data
+		if rootCA := data["ca.crt"]; len(rootCA) > 0 {
+			backendTLS.Root = wrapperspb.Bytes(rootCA)
+		}
+		// SimpleTLS only validates the server, so the client certificate is ignored
+		if !ptr.Deref(tls.SimpleTLS, false) {
+			if cert := data["tls.crt"]; len(cert) > 0 {
+				backendTLS.Cert = wrapperspb.Bytes(cert)
+			}
+			if key := data["tls.key"]; len(key) > 0 {
+				backendTLS.Key = wrapperspb.Bytes(key)
+			}
+		}
+	}
+	if wk := tls.WellKnownCACertificates; wk != nil && *wk != gwv1alpha3.WellKnownCACertificatesSystem {
+		errs = append(errs, fmt.Errorf("unsupported wellKnownCACertificates: %v", *wk))
+	}
+	if tls.InsecureSkipVerify != nil {
+		backendTLS.Insecure = wrapperspb.Bool(*tls.InsecureSkipVerify)
+	}
+	if tls.Sni != nil {
+		backendTLS.Hostname = wrapperspb.String(*tls.Sni)
+	}
+
+	if tls.Files != nil {
+		errs = append(errs, unsupportedField("tls.files"))
+	}
+	if len(tls.VerifySubjectAltNames) > 0 {
+		errs = append(errs, unsupportedField("tls.verifySubjectAltNames"))
+	}
+	if tls.Parameters != nil {
+		errs = append(errs, unsupportedField("tls.parameters"))
+	}
+	if len(tls.AlpnProtocols) > 0 {
+		errs = append(errs, unsupportedField("tls.alpnProtocols"))
+	}
+	if tls.AllowRenegotiation != nil {
+		errs = append(errs, unsupportedField("tls.allowRenegotiation"))
+	}
+
+	policy := &api.Policy{
+		Name:   policyName + backendTLSPolicySuffix + attachmentName(policyTarget),
+		Target: policyTarget,
+		Spec: &api.PolicySpec{Kind: &api.PolicySpec_BackendTls{
+			BackendTls: backendTLS,
+		}},
+	}
+	return []AgwPolicy{{policy}}, errors.Join(errs...)
+}
+
+// processBackendHTTP selects the HTTP version used to connect to the backend.
+// Only the protocol selection is representable; the protocol specific tuning options are not.
+func processBackendHTTP(spec v1alpha1.BackendConfigPolicySpec, policyName string, policyTarget *api.PolicyTarget) ([]AgwPolicy, error) {
+	var version api.PolicySpec_BackendHTTP_HttpVersion
+	var errs []error
+	switch {
+	case spec.Http1ProtocolOptions != nil:
+		version = api.PolicySpec_BackendHTTP_HTTP1
+		if *spec.Http1ProtocolOptions != (v1alpha1.Http1ProtocolOptions{}) {
+			errs = append(errs, unsupportedField("http1ProtocolOptions"))
+		}
+	case spec.Http2ProtocolOptions != nil:
+		version = api.PolicySpec_BackendHTTP_HTTP2
+		if *spec.Http2ProtocolOptions != (v1alpha1.Http2ProtocolOptions{}) {
+			errs = append(errs, unsupportedField("http2ProtocolOptions"))
+		}
+	default:
+		return nil, nil
+	}
+
+	policy := &api.Policy{
+		Name:   policyName + backendHTTPPolicySuffix + attachmentName(policyTarget),
+		Target: policyTarget,
+		Spec: &api.PolicySpec{Kind: &api.PolicySpec_BackendHttp{
+			BackendHttp: &api.PolicySpec_BackendHTTP{
+				Version: version,
+			},
+		}},
+	}
+	return []AgwPolicy{{policy}}, errors.Join(errs...)
+}
+
+// processLoadBalancer translates the load balancing algorithm of a BackendConfigPolicy.
+// agentgateway only supports simple algorithms, so hash based algorithms and the
+// Envoy specific tuning options are reported as unsupported.
+func processLoadBalancer(lb *v1alpha1.LoadBalancer, policyName string, policyTarget *api.PolicyTarget) ([]AgwPolicy, error) {
+	var errs []error
+	var mode api.PolicySpec_LoadBalancer_Mode
+	switch {
+	case lb.LeastRequest != nil:
+		mode = api.PolicySpec_LoadBalancer_LEAST_REQUEST
+		if lb.LeastRequest.SlowStart != nil {
+			errs = append(errs, unsupportedField("loadBalancer.leastRequest.slowStart"))
+		}
+	case lb.RoundRobin != nil:
+		mode = api.PolicySpec_LoadBalancer_ROUND_ROBIN
+		if lb.RoundRobin.SlowStart != nil {
+			errs = append(errs, unsupportedField("loadBalancer.roundRobin.slowStart"))
+		}
+	case lb.Random != nil:
+		mode = api.PolicySpec_LoadBalancer_RANDOM
+	case lb.RingHash != nil:
+		errs = append(errs, unsupportedField("loadBalancer.ringHash"))
+	case lb.Maglev != nil:
+		errs = append(errs, unsupportedField("loadBalancer.maglev"))
+	}
+
+	if lb.HealthyPanicThreshold != nil {
+		errs = append(errs, unsupportedField("loadBalancer.healthyPanicThreshold"))
+	}
+	if lb.UpdateMergeWindow != nil {
+		errs = append(errs, unsupportedField("loadBalancer.updateMergeWindow"))
+	}
+	if lb.LocalityType != nil {
+		errs = append(errs, unsupportedField("loadBalancer.localityType"))
+	}
+	if lb.CloseConnectionsOnHostSetChange != nil {
+		errs = append(errs, unsupportedField("loadBalancer.closeConnectionsOnHostSetChange"))
+	}
+
+	if mode == api.PolicySpec_LoadBalancer_UNSPECIFIED {
+		return nil, errors.Join(errs...)
+	}
+	policy := &api.Policy{
+		Name:   policyName + loadBalancerPolicySuffix + attachmentName(policyTarget),
+		Target: policyTarget,
+		Spec: &api.PolicySpec{Kind: &api.PolicySpec_LoadBalancer_{
+			LoadBalancer: &api.PolicySpec_LoadBalancer{
+				Mode: mode,
+			},
+		}},
+	}
+	return []AgwPolicy{{policy}}, errors.Join(errs...)
+}
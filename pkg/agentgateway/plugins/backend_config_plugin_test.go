@@ -0,0 +1,289 @@
+package plugins
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/agentgateway/agentgateway/go/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func TestTranslateBackendConfigPolicyToAgw(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        v1alpha1.BackendConfigPolicySpec
+		wantKinds   []string
+		unsupported []string
+		check       func(t *testing.T, policies []AgwPolicy)
+	}{
+		{
+			name: "tls from secret",
+			spec: v1alpha1.BackendConfigPolicySpec{TLS: &v1alpha1.TLS{
+				SecretRef: &corev1.LocalObjectReference{Name: "tls-secret"},
+				Sni:       ptr.To("example.com"),
+			}},
+			wantKinds: []string{"*api.PolicySpec_BackendTls"},
+			check: func(t *testing.T, policies []AgwPolicy) {
+				tls := policies[0].Policy.GetSpec().GetBackendTls()
+				assert.Equal(t, "ca", string(tls.GetRoot().GetValue()))
+				assert.Equal(t, "cert", string(tls.GetCert().GetValue()))
+				assert.Equal(t, "key", string(tls.GetKey().GetValue()))
+				assert.Equal(t, "example.com", tls.GetHostname().GetValue())
+			},
+		},
+		{
+			name: "simple tls ignores client certificate",
+			spec: v1alpha1.BackendConfigPolicySpec{TLS: &v1alpha1.TLS{
+				SecretRef: &corev1.LocalObjectReference{Name: "tls-secret"},
+				SimpleTLS: ptr.To(true),
+			}},
+			wantKinds: []string{"*api.PolicySpec_BackendTls"},
+			check: func(t *testing.T, policies []AgwPolicy) {
+				tls := policies[0].Policy.GetSpec().GetBackendTls()
+				assert.Equal(t, "ca", string(tls.GetRoot().GetValue()))
+				assert.Nil(t, tls.GetCert())
+				assert.Nil(t, tls.GetKey())
+			},
+		},
+		{
+			name: "tls unsupported fields",
+			spec: v1alpha1.BackendConfigPolicySpec{TLS: &v1alpha1.TLS{
+				InsecureSkipVerify:    ptr.To(true),
+				VerifySubjectAltNames: []string{"example.com"},
+				AlpnProtocols:         []string{"h2"},
+			}},
+			wantKinds:   []string{"*api.PolicySpec_BackendTls"},
+			unsupported: []string{"tls.verifySubjectAltNames", "tls.alpnProtocols"},
+		},
+		{
+			name:      "connect timeout",
+			spec:      v1alpha1.BackendConfigPolicySpec{ConnectTimeout: &metav1.Duration{Duration: 5 * time.Second}},
+			wantKinds: []string{"*api.PolicySpec_BackendTcp"},
+			check: func(t *testing.T, policies []AgwPolicy) {
+				assert.Equal(t, 5*time.Second, policies[0].Policy.GetSpec().GetBackendTcp().GetConnectTimeout().AsDuration())
+			},
+		},
+		{
+			name:      "http2",
+			spec:      v1alpha1.BackendConfigPolicySpec{Http2ProtocolOptions: &v1alpha1.Http2ProtocolOptions{}},
+			wantKinds: []string{"*api.PolicySpec_BackendHttp"},
+			check: func(t *testing.T, policies []AgwPolicy) {
+				assert.Equal(t, api.PolicySpec_BackendHTTP_HTTP2, policies[0].Policy.GetSpec().GetBackendHttp().GetVersion())
+			},
+		},
+		{
+			name: "http1 options",
+			spec: v1alpha1.BackendConfigPolicySpec{Http1ProtocolOptions: &v1alpha1.Http1ProtocolOptions{
+				EnableTrailers: ptr.To(true),
+			}},
+			wantKinds:   []string{"*api.PolicySpec_BackendHttp"},
+			unsupported: []string{"http1ProtocolOptions"},
+		},
+		{
+			name:      "round robin",
+			spec:      v1alpha1.BackendConfigPolicySpec{LoadBalancer: &v1alpha1.LoadBalancer{RoundRobin: &v1alpha1.LoadBalancerRoundRobinConfig{}}},
+			wantKinds: []string{"*api.PolicySpec_LoadBalancer_"},
+			check: func(t *testing.T, policies []AgwPolicy) {
+				assert.Equal(t, api.PolicySpec_LoadBalancer_ROUND_ROBIN, policies[0].Policy.GetSpec().GetLoadBalancer().GetMode())
+			},
+		},
+		{
+			name: "ring hash",
+			spec: v1alpha1.BackendConfigPolicySpec{LoadBalancer: &v1alpha1.LoadBalancer{
+				RingHash:              &v1alpha1.LoadBalancerRingHashConfig{},
+				HealthyPanicThreshold: ptr.To(int32(50)),
+			}},
+			unsupported: []string{"loadBalancer.ringHash", "loadBalancer.healthyPanicThreshold"},
+		},
+		{
+			name: "outlier detection",
+			spec: v1alpha1.BackendConfigPolicySpec{OutlierDetection: &v1alpha1.OutlierDetection{
+				Consecutive5xx:     ptr.To(int32(3)),
+				Interval:           &metav1.Duration{Duration: 10 * time.Second},
+				BaseEjectionTime:   &metav1.Duration{Duration: 30 * time.Second},
+				MaxEjectionPercent: ptr.To(int32(50)),
+			}},
+			wantKinds: []string{"*api.PolicySpec_OutlierDetection_"},
+			check: func(t *testing.T, policies []AgwPolicy) {
+				od := policies[0].Policy.GetSpec().GetOutlierDetection()
+				assert.Equal(t, uint32(3), od.GetConsecutive_5Xx().GetValue())
+				assert.Equal(t, 10*time.Second, od.GetInterval().AsDuration())
+				assert.Equal(t, 30*time.Second, od.GetBaseEjectionTime().AsDuration())
+				assert.Equal(t, uint32(50), od.GetMaxEjectionPercent().GetValue())
+			},
+		},
+		{
+			name: "unsupported fields",
+			spec: v1alpha1.BackendConfigPolicySpec{
+				PerConnectionBufferLimitBytes: ptr.To(int32(1024)),
+				TCPKeepalive:                  &v1alpha1.TCPKeepalive{KeepAliveProbes: ptr.To(int32(3))},
+				CommonHttpProtocolOptions:     &v1alpha1.CommonHttpProtocolOptions{MaxHeadersCount: ptr.To(int32(10))},
+				HealthCheck:                   &v1alpha1.HealthCheck{},
+			},
+			unsupported: []string{"perConnectionBufferLimitBytes", "tcpKeepalive", "commonHttpProtocolOptions", "healthCheck"},
+		},
+	}
+
+	mock := krttest.NewMock(t, []any{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "tls-secret", Namespace: "default"},
+			Data: map[string][]byte{
+				"ca.crt":  []byte("ca"),
+				"tls.crt": []byte("cert"),
+				"tls.key": []byte("key"),
+			},
+		},
+	})
+	secrets := krttest.GetMockCollection[*corev1.Secret](mock)
+
+	policyTarget := &api.PolicyTarget{Kind: &api.PolicyTarget_Service{Service: "default/svc.default.svc.cluster.local"}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1alpha1.BackendConfigPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			policies, err := translateBackendConfigPolicyToAgw(krt.TestingDummyContext{}, secrets, policy, policyTarget)
+
+			var kinds []string
+			for _, p := range policies {
+				kinds = append(kinds, fmt.Sprintf("%T", p.Policy.GetSpec().GetKind()))
+				assert.Equal(t, policyTarget, p.Policy.GetTarget())
+			}
+			assert.ElementsMatch(t, tt.wantKinds, kinds)
+			if tt.check != nil {
+				tt.check(t, policies)
+			}
+
+			if len(tt.unsupported) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, isUnsupportedFieldsError(err), "expected only unsupported fields, got %v", err)
+			for _, field := range tt.unsupported {
+				assert.ErrorContains(t, err, field+" is not supported for agentgateway")
+			}
+		})
+	}
+}
+
+func TestTranslateBackendConfigPolicyStatus(t *testing.T) {
+	mock := krttest.NewMock(t, []any{
+		&v1alpha1.Backend{
+			ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "default"},
+			Spec: v1alpha1.BackendSpec{
+				Type:   v1alpha1.BackendTypeStatic,
+				Static: &v1alpha1.StaticBackend{Hosts: []v1alpha1.Host{{Host: "example.com", Port: 443}}},
+			},
+		},
+	})
+	policy := &v1alpha1.BackendConfigPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+		Spec: v1alpha1.BackendConfigPolicySpec{
+			TargetRefs: []v1alpha1.LocalPolicyTargetReference{
+				{Group: "", Kind: "Service", Name: "svc"},
+				{Group: "gateway.kgateway.dev", Kind: "Backend", Name: "backend"},
+				{Group: "gateway.kgateway.dev", Kind: "Backend", Name: "missing"},
+			},
+			ConnectTimeout: &metav1.Duration{Duration: 5 * time.Second},
+			TCPKeepalive:   &v1alpha1.TCPKeepalive{KeepAliveProbes: ptr.To(int32(3))},
+		},
+	}
+
+	status, policies := TranslateBackendConfigPolicy(
+		krt.TestingDummyContext{},
+		krttest.GetMockCollection[*v1alpha1.Backend](mock),
+		krttest.GetMockCollection[*corev1.Service](mock),
+		krttest.GetMockCollection[*corev1.Secret](mock),
+		policy,
+		"kgateway.dev/agentgateway",
+		"cluster.local",
+	)
+
+	// the supported fields are still translated for the resolved targets
+	require.Len(t, policies, 2)
+	assert.Equal(t, "default/svc.default.svc.cluster.local", policies[0].Policy.GetTarget().GetService())
+	assert.Equal(t, "default/backend", policies[1].Policy.GetTarget().GetBackend())
+
+	require.Len(t, status.Ancestors, 3)
+	reasons := map[string]string{}
+	for _, ancestor := range status.Ancestors {
+		accepted := meta.FindStatusCondition(ancestor.Conditions, string(v1alpha1.PolicyConditionAccepted))
+		require.NotNil(t, accepted)
+		reasons[string(ancestor.AncestorRef.Name)] = string(accepted.Status) + "/" + accepted.Reason
+	}
+	assert.Equal(t, map[string]string{
+		"svc":     "True/" + string(v1alpha1.PolicyReasonPartiallyValid),
+		"backend": "True/" + string(v1alpha1.PolicyReasonPartiallyValid),
+		"missing": "False/" + string(v1alpha1.PolicyReasonInvalid),
+	}, reasons)
+}
+
+func TestTranslateBackendConfigPolicyTargetSelectors(t *testing.T) {
+	labels := map[string]string{"app": "example"}
+	mock := krttest.NewMock(t, []any{
+		&v1alpha1.Backend{
+			ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "default", Labels: labels},
+			Spec: v1alpha1.BackendSpec{
+				Type:   v1alpha1.BackendTypeStatic,
+				Static: &v1alpha1.StaticBackend{Hosts: []v1alpha1.Host{{Host: "example.com", Port: 443}}},
+			},
+		},
+		&v1alpha1.Backend{
+			ObjectMeta: metav1.ObjectMeta{Name: "unlabeled", Namespace: "default"},
+			Spec: v1alpha1.BackendSpec{
+				Type:   v1alpha1.BackendTypeStatic,
+				Static: &v1alpha1.StaticBackend{Hosts: []v1alpha1.Host{{Host: "example.com", Port: 443}}},
+			},
+		},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default", Labels: labels}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "other", Labels: labels}},
+	})
+	policy := &v1alpha1.BackendConfigPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+		Spec: v1alpha1.BackendConfigPolicySpec{
+			TargetRefs: []v1alpha1.LocalPolicyTargetReference{
+				{Group: "", Kind: "Service", Name: "svc"},
+			},
+			TargetSelectors: []v1alpha1.LocalPolicyTargetSelector{
+				{Group: "", Kind: "Service", MatchLabels: labels},
+				{Group: "gateway.kgateway.dev", Kind: "Backend", MatchLabels: labels},
+			},
+			ConnectTimeout: &metav1.Duration{Duration: 5 * time.Second},
+		},
+	}
+
+	status, policies := TranslateBackendConfigPolicy(
+		krt.TestingDummyContext{},
+		krttest.GetMockCollection[*v1alpha1.Backend](mock),
+		krttest.GetMockCollection[*corev1.Service](mock),
+		krttest.GetMockCollection[*corev1.Secret](mock),
+		policy,
+		"kgateway.dev/agentgateway",
+		"cluster.local",
+	)
+
+	// the Service selected by both the targetRef and the selector is only targeted once,
+	// and resources in other namespaces or without the labels are not selected
+	require.Len(t, policies, 2)
+	assert.Equal(t, "default/svc.default.svc.cluster.local", policies[0].Policy.GetTarget().GetService())
+	assert.Equal(t, "default/backend", policies[1].Policy.GetTarget().GetBackend())
+
+	require.Len(t, status.Ancestors, 2)
+	for _, ancestor := range status.Ancestors {
+		accepted := meta.FindStatusCondition(ancestor.Conditions, string(v1alpha1.PolicyConditionAccepted))
+		require.NotNil(t, accepted)
+		assert.Equal(t, metav1.ConditionTrue, accepted.Status)
+	}
+}
@@ -110,6 +110,16 @@ func registerKgwResources(kgwClient kgwversioned.Interface) {
 			return kgwClient.GatewayV1alpha1().TrafficPolicies(namespace).Watch(context.Background(), o)
 		},
 	)
+	kubeclient.Register[*v1alpha1.BackendConfigPolicy](
+		wellknown.BackendConfigPolicyGVR,
+		wellknown.BackendConfigPolicyGVK,
+		func(c kubeclient.ClientGetter, namespace string, o metav1.ListOptions) (runtime.Object, error) {
+			return kgwClient.GatewayV1alpha1().BackendConfigPolicies(namespace).List(context.Background(), o)
+		},
+		func(c kubeclient.ClientGetter, namespace string, o metav1.ListOptions) (watch.Interface, error) {
+			return kgwClient.GatewayV1alpha1().BackendConfigPolicies(namespace).Watch(context.Background(), o)
+		},
+	)
 }
 
 func registerGatewayAPITypes() {
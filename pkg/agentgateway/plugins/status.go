@@ -0,0 +1,129 @@
+package plugins
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+// maxPolicyAncestors is the maximum number of ancestors reported in a policy status
+const maxPolicyAncestors = 15
+
+// convertStatusCollection converts a policy specific status collection
+// to the generic controllers.Object status collection expected by the interface
+func convertStatusCollection[T controllers.Object](col krt.Collection[krt.ObjectWithStatus[T, v1alpha2.PolicyStatus]]) krt.StatusCollection[controllers.Object, v1alpha2.PolicyStatus] {
+	// Use krt.NewCollection to transform the collection
+	return krt.NewCollection(col, func(ctx krt.HandlerContext, item krt.ObjectWithStatus[T, v1alpha2.PolicyStatus]) *krt.ObjectWithStatus[controllers.Object, v1alpha2.PolicyStatus] {
+		return &krt.ObjectWithStatus[controllers.Object, v1alpha2.PolicyStatus]{
+			Obj:    controllers.Object(item.Obj),
+			Status: item.Status,
+		}
+	})
+}
+
+// policyAncestorConditions builds the Accepted and Attached conditions for a single policy ancestor
+// from the error returned while translating the policy for that ancestor.
+func policyAncestorConditions(err error) []metav1.Condition {
+	var conds []metav1.Condition
+	// TODO: support partial translation statuses https://github.com/kgateway-dev/kgateway/issues/12413
+	if err != nil {
+		reason := v1alpha1.PolicyReasonInvalid
+		if isUnsupportedFieldsError(err) {
+			reason = v1alpha1.PolicyReasonUnsupported
+		}
+		meta.SetStatusCondition(&conds, metav1.Condition{
+			Type:    string(v1alpha1.PolicyConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(reason),
+			Message: err.Error(),
+		})
+	} else {
+		// Build success conditions per ancestor
+		meta.SetStatusCondition(&conds, metav1.Condition{
+			Type:    string(v1alpha1.PolicyConditionAccepted),
+			Status:  metav1.ConditionTrue,
+			Reason:  string(v1alpha1.PolicyReasonValid),
+			Message: reporter.PolicyAcceptedMsg,
+		})
+	}
+	// TODO: validate the target exists with dataplane https://github.com/kgateway-dev/kgateway/issues/12275
+	meta.SetStatusCondition(&conds, metav1.Condition{
+		Type:    string(v1alpha1.PolicyConditionAttached),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(v1alpha1.PolicyReasonAttached),
+		Message: reporter.PolicyAttachedMsg,
+	})
+	// Ensure LastTransitionTime is set for all conditions
+	for i := range conds {
+		if conds[i].LastTransitionTime.IsZero() {
+			conds[i].LastTransitionTime = metav1.Now()
+		}
+	}
+	return conds
+}
+
+// translatedPolicyAncestorConditions builds the Accepted and Attached conditions for a single policy
+// ancestor from the policies translated for that ancestor and the error returned while translating them.
+// A policy that only uses unsupported fields alongside translated ones is partially accepted, since the
+// translated policies are still applied.
+func translatedPolicyAncestorConditions(policies []AgwPolicy, err error) []metav1.Condition {
+	conds := policyAncestorConditions(err)
+	if len(policies) == 0 || !isUnsupportedFieldsError(err) {
+		return conds
+	}
+	meta.SetStatusCondition(&conds, metav1.Condition{
+		Type:               string(v1alpha1.PolicyConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(v1alpha1.PolicyReasonPartiallyValid),
+		Message:            err.Error(),
+		LastTransitionTime: metav1.Now(),
+	})
+	return conds
+}
+
+// buildPolicyStatus builds the final policy status from the accumulated ancestors,
+// summarizing ancestors beyond the max status size and sorting them for stable output.
+func buildPolicyStatus(ancestors []v1alpha2.PolicyAncestorStatus, controllerName string) *v1alpha2.PolicyStatus {
+	status := v1alpha2.PolicyStatus{Ancestors: ancestors}
+
+	if len(status.Ancestors) > maxPolicyAncestors {
+		ignored := status.Ancestors[maxPolicyAncestors:]
+		status.Ancestors = status.Ancestors[:maxPolicyAncestors]
+		status.Ancestors = append(status.Ancestors, v1alpha2.PolicyAncestorStatus{
+			AncestorRef: gwv1.ParentReference{
+				Group: ptr.To(gwv1.Group("gateway.kgateway.dev")),
+				Name:  "StatusSummary",
+			},
+			ControllerName: gwv1.GatewayController(controllerName),
+			Conditions: []metav1.Condition{
+				{
+					Type:    "StatusSummarized",
+					Status:  metav1.ConditionTrue,
+					Reason:  "StatusSummary",
+					Message: fmt.Sprintf("%d AncestorRefs ignored due to max status size", len(ignored)),
+				},
+			},
+		})
+	}
+
+	// sort all parents for consistency with Equals and for Update
+	// match sorting semantics of istio/istio, see:
+	// https://github.com/istio/istio/blob/6dcaa0206bcaf20e3e3b4e45e9376f0f96365571/pilot/pkg/config/kube/gateway/conditions.go#L188-L193
+	slices.SortStableFunc(status.Ancestors, func(a, b v1alpha2.PolicyAncestorStatus) int {
+		return strings.Compare(reports.ParentString(a.AncestorRef), reports.ParentString(b.AncestorRef))
+	})
+
+	return &status
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"slices"
 	"strconv"
 	"strings"
 
@@ -13,13 +12,13 @@ import (
 	"github.com/google/cel-go/cel"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
-	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -29,9 +28,8 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
-	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
-	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/regexutils"
 )
 
 const (
@@ -41,8 +39,46 @@ const (
 	localRateLimitPolicySuffix  = ":rl-local"
 	globalRateLimitPolicySuffix = ":rl-global"
 	transformationPolicySuffix  = ":transformation"
+	extprocPolicySuffix         = ":extproc"
+	corsPolicySuffix            = ":cors"
+	csrfPolicySuffix            = ":csrf"
+	requestHeaderPolicySuffix   = ":request-header"
+	responseHeaderPolicySuffix  = ":response-header"
+	hostRewritePolicySuffix     = ":host-rewrite"
+	timeoutPolicySuffix         = ":timeout"
+	mcpUpstreamAuthPolicySuffix = ":mcp-upstream-auth"
 )
 
+// unsupportedFieldError is returned for TrafficPolicy fields that cannot be represented in agentgateway.
+type unsupportedFieldError struct {
+	field string
+}
+
+func (e *unsupportedFieldError) Error() string {
+	return e.field + " is not supported for agentgateway"
+}
+
+func unsupportedField(field string) error {
+	return &unsupportedFieldError{field: field}
+}
+
+// isUnsupportedFieldsError returns true if err only reports unsupported fields.
+func isUnsupportedFieldsError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			if !isUnsupportedFieldsError(e) {
+				return false
+			}
+		}
+		return true
+	}
+	var unsupported *unsupportedFieldError
+	return errors.As(err, &unsupported)
+}
+
 var logger = logging.New("agentgateway/plugins")
 
 // Shared CEL environment for expression validation
@@ -58,18 +94,6 @@ func init() {
 	}
 }
 
-// convertStatusCollection converts the specific TrafficPolicy status collection
-// to the generic controllers.Object status collection expected by the interface
-func convertStatusCollection(col krt.Collection[krt.ObjectWithStatus[*v1alpha1.TrafficPolicy, v1alpha2.PolicyStatus]]) krt.StatusCollection[controllers.Object, v1alpha2.PolicyStatus] {
-	// Use krt.NewCollection to transform the collection
-	return krt.NewCollection(col, func(ctx krt.HandlerContext, item krt.ObjectWithStatus[*v1alpha1.TrafficPolicy, v1alpha2.PolicyStatus]) *krt.ObjectWithStatus[controllers.Object, v1alpha2.PolicyStatus] {
-		return &krt.ObjectWithStatus[controllers.Object, v1alpha2.PolicyStatus]{
-			Obj:    controllers.Object(item.Obj),
-			Status: item.Status,
-		}
-	})
-}
-
 // NewTrafficPlugin creates a new TrafficPolicy plugin
 func NewTrafficPlugin(agw *AgwCollections) AgwPlugin {
 	col := krt.WrapClient(kclient.NewFiltered[*v1alpha1.TrafficPolicy](
@@ -80,7 +104,7 @@ func NewTrafficPlugin(agw *AgwCollections) AgwPlugin {
 		*v1alpha2.PolicyStatus,
 		[]AgwPolicy,
 	) {
-		return TranslateTrafficPolicy(krtctx, agw.GatewayExtensions, agw.Backends, agw.Secrets, policyCR, agw.ControllerName)
+		return TranslateTrafficPolicy(krtctx, agw.GatewayExtensions, agw.Backends, agw.Secrets, agw.ConfigMaps, policyCR, agw.ControllerName)
 	})
 
 	return AgwPlugin{
@@ -102,15 +126,17 @@ func TranslateTrafficPolicy(
 	gatewayExtensions krt.Collection[*v1alpha1.GatewayExtension],
 	backends krt.Collection[*v1alpha1.Backend],
 	secrets krt.Collection[*corev1.Secret],
+	configMaps krt.Collection[*corev1.ConfigMap],
 	trafficPolicy *v1alpha1.TrafficPolicy,
 	controllerName string,
 ) (*v1alpha2.PolicyStatus, []AgwPolicy) {
 	var agwPolicies []AgwPolicy
 
-	isMcpTarget := false
 	var ancestors []v1alpha2.PolicyAncestorStatus
 	for _, target := range trafficPolicy.Spec.TargetRefs {
 		var policyTarget *api.PolicyTarget
+		// backendType is the type of the targeted Backend, if any
+		var backendType v1alpha1.BackendType
 		// Build a base ParentReference for status
 		parentRef := gwv1.ParentReference{
 			Name:      gwv1.ObjectName(target.Name),
@@ -191,8 +217,20 @@ func TranslateTrafficPolicy(
 				return &status, nil
 			}
 			backendSpec := (*backend).Spec
-			if backendSpec.Type == v1alpha1.BackendTypeMCP {
-				isMcpTarget = true
+			if backendSpec.Type == v1alpha1.BackendTypeMCP && target.SectionName != nil {
+				// sectionName selects a single target of the MCP Backend
+				sectionTarget, err := mcpTargetPolicyTarget(*backend, string(*target.SectionName))
+				if err != nil {
+					ancestors = append(ancestors, v1alpha2.PolicyAncestorStatus{
+						AncestorRef:    parentRef,
+						ControllerName: v1alpha2.GatewayController(controllerName),
+						Conditions:     policyAncestorConditions(err),
+					})
+					continue
+				}
+				policyTarget = sectionTarget
+			} else if backendSpec.Type == v1alpha1.BackendTypeMCP {
+				backendType = backendSpec.Type
 				policyTarget = &api.PolicyTarget{
 					Kind: &api.PolicyTarget_Backend{
 						Backend: trafficPolicy.Namespace + "/" + string(target.Name),
@@ -211,40 +249,9 @@ func TranslateTrafficPolicy(
 		}
 
 		if policyTarget != nil {
-			translatedPolicies, err := translateTrafficPolicyToAgw(ctx, gatewayExtensions, secrets, trafficPolicy, string(target.Name), policyTarget, isMcpTarget)
+			translatedPolicies, err := translateTrafficPolicyToAgw(ctx, gatewayExtensions, secrets, configMaps, trafficPolicy, string(target.Name), policyTarget, backendType)
 			agwPolicies = append(agwPolicies, translatedPolicies...)
-			var conds []metav1.Condition
-			// TODO: support partial translation statuses https://github.com/kgateway-dev/kgateway/issues/12413
-			if err != nil {
-				// Build success conditions per ancestor
-				meta.SetStatusCondition(&conds, metav1.Condition{
-					Type:    string(v1alpha1.PolicyConditionAccepted),
-					Status:  metav1.ConditionFalse,
-					Reason:  string(v1alpha1.PolicyReasonInvalid),
-					Message: err.Error(),
-				})
-			} else {
-				// Build success conditions per ancestor
-				meta.SetStatusCondition(&conds, metav1.Condition{
-					Type:    string(v1alpha1.PolicyConditionAccepted),
-					Status:  metav1.ConditionTrue,
-					Reason:  string(v1alpha1.PolicyReasonValid),
-					Message: reporter.PolicyAcceptedMsg,
-				})
-			}
-			// TODO: validate the target exists with dataplane https://github.com/kgateway-dev/kgateway/issues/12275
-			meta.SetStatusCondition(&conds, metav1.Condition{
-				Type:    string(v1alpha1.PolicyConditionAttached),
-				Status:  metav1.ConditionTrue,
-				Reason:  string(v1alpha1.PolicyReasonAttached),
-				Message: reporter.PolicyAttachedMsg,
-			})
-			// Ensure LastTransitionTime is set for all conditions
-			for i := range conds {
-				if conds[i].LastTransitionTime.IsZero() {
-					conds[i].LastTransitionTime = metav1.Now()
-				}
-			}
+			conds := translatedPolicyAncestorConditions(translatedPolicies, err)
 			// Only append valid ancestors: require non-empty controllerName and parentRef name
 			if controllerName != "" && string(parentRef.Name) != "" {
 				ancestors = append(ancestors, v1alpha2.PolicyAncestorStatus{
@@ -256,37 +263,7 @@ func TranslateTrafficPolicy(
 		}
 	}
 
-	// Build final status from accumulated ancestors
-	status := v1alpha2.PolicyStatus{Ancestors: ancestors}
-
-	if len(status.Ancestors) > 15 {
-		ignored := status.Ancestors[15:]
-		status.Ancestors = status.Ancestors[:15]
-		status.Ancestors = append(status.Ancestors, v1alpha2.PolicyAncestorStatus{
-			AncestorRef: gwv1.ParentReference{
-				Group: ptr.To(gwv1.Group("gateway.kgateway.dev")),
-				Name:  "StatusSummary",
-			},
-			ControllerName: gwv1.GatewayController(controllerName),
-			Conditions: []metav1.Condition{
-				{
-					Type:    "StatusSummarized",
-					Status:  metav1.ConditionTrue,
-					Reason:  "StatusSummary",
-					Message: fmt.Sprintf("%d AncestorRefs ignored due to max status size", len(ignored)),
-				},
-			},
-		})
-	}
-
-	// sort all parents for consistency with Equals and for Update
-	// match sorting semantics of istio/istio, see:
-	// https://github.com/istio/istio/blob/6dcaa0206bcaf20e3e3b4e45e9376f0f96365571/pilot/pkg/config/kube/gateway/conditions.go#L188-L193
-	slices.SortStableFunc(status.Ancestors, func(a, b v1alpha2.PolicyAncestorStatus) int {
-		return strings.Compare(reports.ParentString(a.AncestorRef), reports.ParentString(b.AncestorRef))
-	})
-
-	return &status, agwPolicies
+	return buildPolicyStatus(ancestors, controllerName), agwPolicies
 }
 
 // translateTrafficPolicyToAgw converts a TrafficPolicy to agentgateway Policy resources
@@ -294,10 +271,11 @@ func translateTrafficPolicyToAgw(
 	ctx krt.HandlerContext,
 	gatewayExtensions krt.Collection[*v1alpha1.GatewayExtension],
 	secrets krt.Collection[*corev1.Secret],
+	configMaps krt.Collection[*corev1.ConfigMap],
 	trafficPolicy *v1alpha1.TrafficPolicy,
 	policyTargetName string,
 	policyTarget *api.PolicyTarget,
-	isMcpTarget bool,
+	backendType v1alpha1.BackendType,
 ) ([]AgwPolicy, error) {
 	agwPolicies := make([]AgwPolicy, 0)
 	var errs []error
@@ -306,6 +284,9 @@ func translateTrafficPolicyToAgw(
 	policyName := getTrafficPolicyName(trafficPolicy.Namespace, trafficPolicy.Name, policyTargetName)
 
 	// Convert ExtAuth policy if present
+	if trafficPolicy.Spec.ExtAuth != nil && trafficPolicy.Spec.ExtAuth.Disable != nil {
+		errs = append(errs, unsupportedField("extAuth.disable"))
+	}
 	if trafficPolicy.Spec.ExtAuth != nil && trafficPolicy.Spec.ExtAuth.ExtensionRef != nil {
 		extAuthPolicies, err := processExtAuthPolicy(ctx, gatewayExtensions, trafficPolicy, policyName, policyTarget)
 		if err != nil {
@@ -315,9 +296,9 @@ func translateTrafficPolicyToAgw(
 		agwPolicies = append(agwPolicies, extAuthPolicies...)
 	}
 
-	// Convert RBAC policy if present
-	if trafficPolicy.Spec.RBAC != nil {
-		rbacPolicies, err := processRBACPolicy(trafficPolicy, policyName, policyTarget, isMcpTarget)
+	// Convert RBAC and MCP authorization policies if present
+	if trafficPolicy.Spec.RBAC != nil || (trafficPolicy.Spec.MCP != nil && len(trafficPolicy.Spec.MCP.Authorization) > 0) {
+		rbacPolicies, err := processRBACPolicy(trafficPolicy, policyName, policyTarget, backendType == v1alpha1.BackendTypeMCP)
 		if err != nil {
 			logger.Error("error processing RBAC policy", "error", err)
 			errs = append(errs, err)
@@ -325,9 +306,19 @@ func translateTrafficPolicyToAgw(
 		agwPolicies = append(agwPolicies, rbacPolicies...)
 	}
 
+	// Convert MCP upstream credentials if present
+	if trafficPolicy.Spec.MCP != nil && trafficPolicy.Spec.MCP.UpstreamAuth != nil {
+		upstreamAuthPolicies, err := processMCPUpstreamAuthPolicy(ctx, secrets, trafficPolicy, policyName, policyTarget)
+		if err != nil {
+			logger.Error("error processing MCP upstream auth policy", "error", err)
+			errs = append(errs, err)
+		}
+		agwPolicies = append(agwPolicies, upstreamAuthPolicies...)
+	}
+
 	// Process AI policies if present
 	if trafficPolicy.Spec.AI != nil {
-		aiPolicies, err := processAIPolicy(ctx, secrets, trafficPolicy, policyName, policyTarget)
+		aiPolicies, err := processAIPolicy(ctx, secrets, configMaps, trafficPolicy, policyName, policyTarget)
 		if err != nil {
 			logger.Error("error processing AI policy", "error", err)
 			errs = append(errs, err)
@@ -354,9 +345,311 @@ func translateTrafficPolicyToAgw(
 		agwPolicies = append(agwPolicies, transformationPolicies...)
 	}
 
+	// Process ExtProc policies if present
+	if trafficPolicy.Spec.ExtProc != nil {
+		extProcPolicies, err := processExtProcPolicy(ctx, gatewayExtensions, trafficPolicy, policyName, policyTarget)
+		if err != nil {
+			logger.Error("error processing ExtProc policy", "error", err)
+			errs = append(errs, err)
+		}
+		agwPolicies = append(agwPolicies, extProcPolicies...)
+	}
+
+	// Process CORS policies if present
+	if trafficPolicy.Spec.Cors != nil {
+		corsPolicies, err := processCorsPolicy(trafficPolicy, policyName, policyTarget)
+		if err != nil {
+			logger.Error("error processing CORS policy", "error", err)
+			errs = append(errs, err)
+		}
+		agwPolicies = append(agwPolicies, corsPolicies...)
+	}
+
+	// Process CSRF policies if present
+	if trafficPolicy.Spec.Csrf != nil {
+		csrfPolicies, err := processCSRFPolicy(trafficPolicy, policyName, policyTarget)
+		if err != nil {
+			logger.Error("error processing CSRF policy", "error", err)
+			errs = append(errs, err)
+		}
+		agwPolicies = append(agwPolicies, csrfPolicies...)
+	}
+
+	// Process header modifiers if present
+	if trafficPolicy.Spec.HeaderModifiers != nil {
+		agwPolicies = append(agwPolicies, processHeaderModifiersPolicy(trafficPolicy, policyName, policyTarget)...)
+	}
+
+	// Process auto host rewrite if present
+	if trafficPolicy.Spec.AutoHostRewrite != nil {
+		agwPolicies = append(agwPolicies, processHostRewritePolicy(trafficPolicy, policyName, policyTarget)...)
+	}
+
+	// agentgateway has no policy that limits the size of buffered requests
+	if trafficPolicy.Spec.Buffer != nil {
+		errs = append(errs, unsupportedField("buffer"))
+	}
+
+	// Process timeouts if present
+	if trafficPolicy.Spec.Timeouts != nil {
+		timeoutPolicies, err := processTimeoutPolicy(trafficPolicy, policyName, policyTarget)
+		if err != nil {
+			logger.Error("error processing timeout policy", "error", err)
+			errs = append(errs, err)
+		}
+		agwPolicies = append(agwPolicies, timeoutPolicies...)
+	}
+
+	// agentgateway only retries requests of HTTPRoute rules that set their retry field, which is part of the
+	// translated route rather than a policy, so retries can not be attached to the targets of a TrafficPolicy
+	if trafficPolicy.Spec.Retry != nil {
+		errs = append(errs, unsupportedField("retry"))
+	}
+
 	return agwPolicies, errors.Join(errs...)
 }
 
+// processExtProcPolicy processes ExtProc configuration and creates corresponding agentgateway policies
+func processExtProcPolicy(
+	ctx krt.HandlerContext,
+	gatewayExtensions krt.Collection[*v1alpha1.GatewayExtension],
+	trafficPolicy *v1alpha1.TrafficPolicy,
+	policyName string,
+	policyTarget *api.PolicyTarget,
+) ([]AgwPolicy, error) {
+	extProc := trafficPolicy.Spec.ExtProc
+	// agentgateway policies add to the policies of the parent attachment points and can not remove them
+	if extProc.Disable != nil {
+		return nil, unsupportedField("extProc.disable")
+	}
+	if extProc.ExtensionRef == nil {
+		return nil, nil
+	}
+
+	var errs []error
+	if extProc.ProcessingMode != nil {
+		errs = append(errs, unsupportedField("extProc.processingMode"))
+	}
+
+	gwExt, err := lookupGatewayExtension(ctx, gatewayExtensions, *extProc.ExtensionRef, trafficPolicy.Namespace, v1alpha1.GatewayExtensionTypeExtProc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup ext proc extension: %w", err)
+	}
+	if gwExt.Spec.ExtProc == nil ||
+		gwExt.Spec.ExtProc.GrpcService == nil ||
+		gwExt.Spec.ExtProc.GrpcService.BackendRef == nil {
+		return nil, fmt.Errorf("ext proc extension missing grpcService.backendRef: %s", gwExt.Name)
+	}
+
+	target, err := buildAGWServiceRef(gwExt.Spec.ExtProc.GrpcService.BackendRef, trafficPolicy.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build AGW service reference: %w", err)
+	}
+
+	spec := &api.PolicySpec_ExtProc{
+		Target:      target,
+		FailureMode: api.PolicySpec_ExtProc_FAIL_CLOSED,
+	}
+	if gwExt.Spec.ExtProc.FailOpen {
+		spec.FailureMode = api.PolicySpec_ExtProc_FAIL_OPEN
+	}
+
+	extProcPolicy := &api.Policy{
+		Name:   policyName + extprocPolicySuffix + attachmentName(policyTarget),
+		Target: policyTarget,
+		Spec: &api.PolicySpec{
+			Kind: &api.PolicySpec_ExtProc_{
+				ExtProc: spec,
+			},
+		},
+	}
+
+	logger.Debug("generated ExtProc policy",
+		"policy", trafficPolicy.Name,
+		"agentgateway_policy", extProcPolicy.Name,
+		"target", target)
+
+	return []AgwPolicy{{Policy: extProcPolicy}}, errors.Join(errs...)
+}
+
+// processCorsPolicy processes CORS configuration and creates corresponding agentgateway policies
+func processCorsPolicy(trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) ([]AgwPolicy, error) {
+	cors := trafficPolicy.Spec.Cors
+	if cors.Disable != nil {
+		return nil, unsupportedField("cors.disable")
+	}
+	if cors.HTTPCORSFilter == nil {
+		return nil, nil
+	}
+
+	agwCors := &api.CORS{
+		AllowCredentials: bool(cors.AllowCredentials),
+		AllowHeaders:     toStrings(cors.AllowHeaders),
+		AllowMethods:     toStrings(cors.AllowMethods),
+		AllowOrigins:     toStrings(cors.AllowOrigins),
+		ExposeHeaders:    toStrings(cors.ExposeHeaders),
+	}
+	// leave MaxAge unset so agentgateway omits the Access-Control-Max-Age header
+	if cors.MaxAge > 0 {
+		agwCors.MaxAge = &durationpb.Duration{Seconds: int64(cors.MaxAge)}
+	}
+
+	corsPolicy := &api.Policy{
+		Name:   policyName + corsPolicySuffix + attachmentName(policyTarget),
+		Target: policyTarget,
+		Spec: &api.PolicySpec{
+			Kind: &api.PolicySpec_Cors{
+				Cors: agwCors,
+			},
+		},
+	}
+
+	return []AgwPolicy{{Policy: corsPolicy}}, nil
+}
+
+// processCSRFPolicy processes CSRF configuration and creates corresponding agentgateway policies.
+// agentgateway always enforces the CSRF policy, so only fully enabled policies can be translated.
+func processCSRFPolicy(trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) ([]AgwPolicy, error) {
+	csrf := trafficPolicy.Spec.Csrf
+	if csrf.PercentageShadowed != nil {
+		return nil, unsupportedField("csrf.percentageShadowed")
+	}
+	// Like envoy, the CSRF policy is not enforced unless enabled
+	enabled := ptr.Deref(csrf.PercentageEnabled, 0)
+	if enabled == 0 {
+		return nil, nil
+	}
+	if enabled != 100 {
+		return nil, unsupportedField("csrf.percentageEnabled other than 0 or 100")
+	}
+
+	var errs []error
+	origins := make([]string, 0, len(csrf.AdditionalOrigins))
+	for _, origin := range csrf.AdditionalOrigins {
+		if origin.Exact == nil || origin.IgnoreCase {
+			errs = append(errs, unsupportedField("csrf.additionalOrigins other than case-sensitive exact matches"))
+			continue
+		}
+		origins = append(origins, *origin.Exact)
+	}
+
+	csrfPolicy := &api.Policy{
+		Name:   policyName + csrfPolicySuffix + attachmentName(policyTarget),
+		Target: policyTarget,
+		Spec: &api.PolicySpec{
+			Kind: &api.PolicySpec_Csrf{
+				Csrf: &api.PolicySpec_CSRF{
+					AdditionalOrigins: origins,
+				},
+			},
+		},
+	}
+
+	return []AgwPolicy{{Policy: csrfPolicy}}, errors.Join(errs...)
+}
+
+// processHeaderModifiersPolicy processes header modifiers and creates corresponding agentgateway policies
+func processHeaderModifiersPolicy(trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) []AgwPolicy {
+	var agwPolicies []AgwPolicy
+	if req := trafficPolicy.Spec.HeaderModifiers.Request; req != nil {
+		agwPolicies = append(agwPolicies, AgwPolicy{Policy: &api.Policy{
+			Name:   policyName + requestHeaderPolicySuffix + attachmentName(policyTarget),
+			Target: policyTarget,
+			Spec: &api.PolicySpec{
+				Kind: &api.PolicySpec_RequestHeaderModifier{
+					RequestHeaderModifier: toAgwHeaderModifier(req),
+				},
+			},
+		}})
+	}
+	if resp := trafficPolicy.Spec.HeaderModifiers.Response; resp != nil {
+		agwPolicies = append(agwPolicies, AgwPolicy{Policy: &api.Policy{
+			Name:   policyName + responseHeaderPolicySuffix + attachmentName(policyTarget),
+			Target: policyTarget,
+			Spec: &api.PolicySpec{
+				Kind: &api.PolicySpec_ResponseHeaderModifier{
+					ResponseHeaderModifier: toAgwHeaderModifier(resp),
+				},
+			},
+		}})
+	}
+	return agwPolicies
+}
+
+func toAgwHeaderModifier(filter *gwv1.HTTPHeaderFilter) *api.HeaderModifier {
+	toAgwHeaders := func(headers []gwv1.HTTPHeader) []*api.Header {
+		var out []*api.Header
+		for _, h := range headers {
+			out = append(out, &api.Header{
+				Name:  string(h.Name),
+				Value: h.Value,
+			})
+		}
+		return out
+	}
+	return &api.HeaderModifier{
+		Add:    toAgwHeaders(filter.Add),
+		Set:    toAgwHeaders(filter.Set),
+		Remove: filter.Remove,
+	}
+}
+
+// processHostRewritePolicy processes the auto host rewrite and creates corresponding agentgateway policies
+func processHostRewritePolicy(trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) []AgwPolicy {
+	mode := api.PolicySpec_HostRewrite_NONE
+	if *trafficPolicy.Spec.AutoHostRewrite {
+		mode = api.PolicySpec_HostRewrite_FULL
+	}
+	hostRewritePolicy := &api.Policy{
+		Name:   policyName + hostRewritePolicySuffix + attachmentName(policyTarget),
+		Target: policyTarget,
+		Spec: &api.PolicySpec{
+			Kind: &api.PolicySpec_HostRewrite_{
+				HostRewrite: &api.PolicySpec_HostRewrite{
+					Mode: mode,
+				},
+			},
+		},
+	}
+	return []AgwPolicy{{Policy: hostRewritePolicy}}
+}
+
+// processTimeoutPolicy processes timeouts and creates corresponding agentgateway policies
+func processTimeoutPolicy(trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) ([]AgwPolicy, error) {
+	timeouts := trafficPolicy.Spec.Timeouts
+	var errs []error
+	if timeouts.StreamIdle != nil {
+		errs = append(errs, unsupportedField("timeouts.streamIdle"))
+	}
+	if timeouts.Request == nil {
+		return nil, errors.Join(errs...)
+	}
+
+	timeoutPolicy := &api.Policy{
+		Name:   policyName + timeoutPolicySuffix + attachmentName(policyTarget),
+		Target: policyTarget,
+		Spec: &api.PolicySpec{
+			Kind: &api.PolicySpec_Timeout{
+				Timeout: &api.Timeout{
+					Request: durationpb.New(timeouts.Request.Duration),
+				},
+			},
+		},
+	}
+	return []AgwPolicy{{Policy: timeoutPolicy}}, errors.Join(errs...)
+}
+
+func toStrings[T ~string](in []T) []string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		out = append(out, string(v))
+	}
+	return out
+}
+
 // processExtAuthPolicy processes ExtAuth configuration and creates corresponding agentgateway policies
 func processExtAuthPolicy(ctx krt.HandlerContext, gatewayExtensions krt.Collection[*v1alpha1.GatewayExtension], trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) ([]AgwPolicy, error) {
 	// Look up the GatewayExtension referenced by the ExtAuth policy
@@ -437,7 +730,14 @@ func processExtAuthPolicy(ctx krt.HandlerContext, gatewayExtensions krt.Collecti
 }
 
 // processAIPolicy processes AI configuration and creates corresponding Agw policies
-func processAIPolicy(krtctx krt.HandlerContext, secrets krt.Collection[*corev1.Secret], trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) ([]AgwPolicy, error) {
+func processAIPolicy(
+	krtctx krt.HandlerContext,
+	secrets krt.Collection[*corev1.Secret],
+	configMaps krt.Collection[*corev1.ConfigMap],
+	trafficPolicy *v1alpha1.TrafficPolicy,
+	policyName string,
+	policyTarget *api.PolicyTarget,
+) ([]AgwPolicy, error) {
 	var errs []error
 	aiSpec := trafficPolicy.Spec.AI
 
@@ -479,12 +779,44 @@ func processAIPolicy(krtctx krt.HandlerContext, secrets krt.Collection[*corev1.S
 		if aiPolicy.GetSpec().GetAi().PromptGuard == nil {
 			aiPolicy.GetSpec().GetAi().PromptGuard = &api.PolicySpec_Ai_PromptGuard{}
 		}
+		getConfigMap := func(name string) *corev1.ConfigMap {
+			cm := krt.FetchOne(krtctx, configMaps, krt.FilterObjectName(types.NamespacedName{
+				Namespace: trafficPolicy.Namespace,
+				Name:      name,
+			}))
+			if cm == nil {
+				return nil
+			}
+			return *cm
+		}
 		if aiSpec.PromptGuard.Request != nil {
-			aiPolicy.GetSpec().GetAi().PromptGuard.Request = processRequestGuard(krtctx, secrets, trafficPolicy.Namespace, aiSpec.PromptGuard.Request)
+			req := aiSpec.PromptGuard.Request.DeepCopy()
+			regex, err := regexutils.ExpandPatternSets(req.Regex, getConfigMap)
+			if err != nil {
+				logger.Error("error expanding regex pattern sets", "error", err)
+				errs = append(errs, err)
+			}
+			req.Regex = regex
+			reqGuard, err := processRequestGuard(krtctx, secrets, trafficPolicy.Namespace, req)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			aiPolicy.GetSpec().GetAi().PromptGuard.Request = reqGuard
 		}
 
 		if aiSpec.PromptGuard.Response != nil {
-			aiPolicy.GetSpec().GetAi().PromptGuard.Response = processResponseGuard(aiSpec.PromptGuard.Response)
+			resp := aiSpec.PromptGuard.Response.DeepCopy()
+			regex, err := regexutils.ExpandPatternSets(resp.Regex, getConfigMap)
+			if err != nil {
+				logger.Error("error expanding regex pattern sets", "error", err)
+				errs = append(errs, err)
+			}
+			resp.Regex = regex
+			respGuard, err := processResponseGuard(resp)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			aiPolicy.GetSpec().GetAi().PromptGuard.Response = respGuard
 		}
 	}
 
@@ -495,14 +827,15 @@ func processAIPolicy(krtctx krt.HandlerContext, secrets krt.Collection[*corev1.S
 	return []AgwPolicy{{Policy: aiPolicy}}, errors.Join(errs...)
 }
 
-func processRequestGuard(krtctx krt.HandlerContext, secrets krt.Collection[*corev1.Secret], namespace string, req *v1alpha1.PromptguardRequest) *api.PolicySpec_Ai_RequestGuard {
+func processRequestGuard(krtctx krt.HandlerContext, secrets krt.Collection[*corev1.Secret], namespace string, req *v1alpha1.PromptguardRequest) (*api.PolicySpec_Ai_RequestGuard, error) {
 	if req == nil {
-		return nil
+		return nil, nil
 	}
 
+	regex, err := processRegex(req.Regex, req.CustomResponse, "ai.promptGuard.request.regex")
 	pgReq := &api.PolicySpec_Ai_RequestGuard{
 		Webhook:          processWebhook(req.Webhook),
-		Regex:            processRegex(req.Regex, req.CustomResponse),
+		Regex:            regex,
 		OpenaiModeration: processModeration(krtctx, secrets, namespace, req.Moderation),
 	}
 
@@ -513,14 +846,15 @@ func processRequestGuard(krtctx krt.HandlerContext, secrets krt.Collection[*core
 		}
 	}
 
-	return pgReq
+	return pgReq, err
 }
 
-func processResponseGuard(resp *v1alpha1.PromptguardResponse) *api.PolicySpec_Ai_ResponseGuard {
+func processResponseGuard(resp *v1alpha1.PromptguardResponse) (*api.PolicySpec_Ai_ResponseGuard, error) {
+	regex, err := processRegex(resp.Regex, nil, "ai.promptGuard.response.regex")
 	return &api.PolicySpec_Ai_ResponseGuard{
 		Webhook: processWebhook(resp.Webhook),
-		Regex:   processRegex(resp.Regex, nil),
-	}
+		Regex:   regex,
+	}, err
 }
 
 func processPromptEnrichment(enrichment *v1alpha1.AIPromptEnrichment) *api.PolicySpec_Ai_PromptEnrichment {
@@ -581,6 +915,10 @@ func processWebhook(webhook *v1alpha1.Webhook) *api.PolicySpec_Ai_Webhook {
 func processBuiltinRegexRule(builtin v1alpha1.BuiltIn, logger *slog.Logger) *api.PolicySpec_Ai_RegexRule {
 	builtinValue, ok := api.PolicySpec_Ai_BuiltinRegexRule_value[string(builtin)]
 	if !ok {
+		// built-ins that are not native to agentgateway are translated to named regexes
+		if pattern, ok := regexutils.BuiltinPIIPattern(builtin); ok {
+			return processNamedRegexRule(pattern, string(builtin))
+		}
 		logger.Warn("unknown builtin regex rule", "builtin", builtin)
 		builtinValue = int32(api.PolicySpec_Ai_BUILTIN_UNSPECIFIED)
 	}
@@ -602,15 +940,35 @@ func processNamedRegexRule(pattern, name string) *api.PolicySpec_Ai_RegexRule {
 	}
 }
 
-func processRegex(regex *v1alpha1.Regex, customResponse *v1alpha1.CustomResponse) *api.PolicySpec_Ai_RegexRules {
+// processRegex translates the regex of a prompt guard. agentgateway applies a single action to all the
+// matches of a regex, so per-match actions are reported as unsupported. A match that rejects makes the
+// whole regex reject, so that a REJECT is never weakened to a MASK.
+func processRegex(regex *v1alpha1.Regex, customResponse *v1alpha1.CustomResponse, field string) (*api.PolicySpec_Ai_RegexRules, error) {
 	if regex == nil {
-		return nil
+		return nil, nil
+	}
+
+	// LOG only reports matches, so the regex does not enforce anything that is lost by dropping it
+	if ptr.Deref(regex.Action, v1alpha1.MASK) == v1alpha1.LOG {
+		return nil, unsupportedField(field + ".action LOG")
+	}
+
+	var errs []error
+	action := regex.Action
+	for i, match := range regex.Matches {
+		if match.Action == nil || *match.Action == ptr.Deref(regex.Action, v1alpha1.MASK) {
+			continue
+		}
+		errs = append(errs, unsupportedField(fmt.Sprintf("%s.matches[%d].action other than the action of the regex", field, i)))
+		if *match.Action == v1alpha1.REJECT {
+			action = ptr.To(v1alpha1.REJECT)
+		}
 	}
 
 	rules := &api.PolicySpec_Ai_RegexRules{}
-	if regex.Action != nil {
+	if action != nil {
 		rules.Action = &api.PolicySpec_Ai_Action{}
-		switch *regex.Action {
+		switch *action {
 		case v1alpha1.MASK:
 			rules.Action.Kind = api.PolicySpec_Ai_MASK
 		case v1alpha1.REJECT:
@@ -625,12 +983,22 @@ func processRegex(regex *v1alpha1.Regex, customResponse *v1alpha1.CustomResponse
 				}
 			}
 		default:
-			logger.Warn("unsupported regex action", "action", *regex.Action)
+			logger.Warn("unsupported regex action", "action", *action)
 			rules.Action.Kind = api.PolicySpec_Ai_ACTION_UNSPECIFIED
 		}
 	}
 
 	for _, match := range regex.Matches {
+		// a LOG match only reports the match, it is already reported as unsupported above
+		if ptr.Deref(match.Action, v1alpha1.MASK) == v1alpha1.LOG {
+			continue
+		}
+
+		if match.Builtin != nil {
+			rules.Rules = append(rules.Rules, processBuiltinRegexRule(*match.Builtin, logger))
+			continue
+		}
+
 		// TODO(jmcguire98): should we really allow empty patterns on regex matches?
 		// I see the CRD is omitempty, but I don't get why
 		// for now i'm just dropping them on the floor
@@ -653,7 +1021,7 @@ func processRegex(regex *v1alpha1.Regex, customResponse *v1alpha1.CustomResponse
 		rules.Rules = append(rules.Rules, processBuiltinRegexRule(builtin, logger))
 	}
 
-	return rules
+	return rules, errors.Join(errs...)
 }
 
 func processModeration(krtctx krt.HandlerContext, secrets krt.Collection[*corev1.Secret], namespace string, moderation *v1alpha1.Moderation) *api.PolicySpec_Ai_Moderation {
@@ -723,10 +1091,23 @@ func processRBACPolicy(
 	isMCP bool,
 ) ([]AgwPolicy, error) {
 	var allowPolicies, denyPolicies []string
-	if trafficPolicy.Spec.RBAC.Action == v1alpha1.AuthorizationPolicyActionDeny {
-		denyPolicies = append(denyPolicies, trafficPolicy.Spec.RBAC.Policy.MatchExpressions...)
-	} else {
-		allowPolicies = append(allowPolicies, trafficPolicy.Spec.RBAC.Policy.MatchExpressions...)
+	if rbac := trafficPolicy.Spec.RBAC; rbac != nil {
+		if rbac.Action == v1alpha1.AuthorizationPolicyActionDeny {
+			denyPolicies = append(denyPolicies, rbac.Policy.MatchExpressions...)
+		} else {
+			allowPolicies = append(allowPolicies, rbac.Policy.MatchExpressions...)
+		}
+	}
+
+	if mcp := trafficPolicy.Spec.MCP; mcp != nil && len(mcp.Authorization) > 0 {
+		if !isMCP {
+			return nil, errors.New("mcp is only supported when targeting an MCP Backend")
+		}
+		// Each rule denies the use of its items unless all of its conditions are satisfied,
+		// so that rules compose with the allow and deny expressions of the RBAC policy.
+		for _, rule := range mcp.Authorization {
+			denyPolicies = append(denyPolicies, mcpAuthorizationRuleExpr(rule))
+		}
 	}
 
 	var rbacPolicy *api.Policy
@@ -766,6 +1147,72 @@ func processRBACPolicy(
 	return []AgwPolicy{{Policy: rbacPolicy}}, nil
 }
 
+// processMCPUpstreamAuthPolicy processes the credentials injected on requests to MCP targets and
+// creates the corresponding Agw backend auth policy
+func processMCPUpstreamAuthPolicy(
+	ctx krt.HandlerContext,
+	secrets krt.Collection[*corev1.Secret],
+	trafficPolicy *v1alpha1.TrafficPolicy,
+	policyName string,
+	policyTarget *api.PolicyTarget,
+) ([]AgwPolicy, error) {
+	// only MCP Backends and their targets resolve to backend or service policy targets
+	if policyTarget.GetBackend() == "" && policyTarget.GetService() == "" {
+		return nil, errors.New("mcp.upstreamAuth is only supported when targeting an MCP Backend")
+	}
+	upstreamAuth := trafficPolicy.Spec.MCP.UpstreamAuth
+
+	secret, err := kubeutils.GetSecret(secrets, ctx, upstreamAuth.SecretRef.Name, trafficPolicy.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	token, ok := kubeutils.GetSecretAuth(secret)
+	if !ok {
+		return nil, fmt.Errorf("secret %s is missing the Authorization key", upstreamAuth.SecretRef.Name)
+	}
+
+	policy := &api.Policy{
+		Name:   policyName + mcpUpstreamAuthPolicySuffix + attachmentName(policyTarget),
+		Target: policyTarget,
+		Spec: &api.PolicySpec{
+			Kind: &api.PolicySpec_Auth{
+				Auth: &api.BackendAuthPolicy{
+					Kind: &api.BackendAuthPolicy_Key{
+						Key: &api.Key{Secret: token},
+					},
+				},
+			},
+		},
+	}
+
+	logger.Debug("generated MCP upstream auth policy",
+		"policy", trafficPolicy.Name,
+		"agentgateway_policy", policy.Name,
+		"target", policyTarget)
+
+	return []AgwPolicy{{Policy: policy}}, nil
+}
+
+// mcpTargetPolicyTarget returns the policy target of the MCP Backend target selected by sectionName
+func mcpTargetPolicyTarget(backend *v1alpha1.Backend, sectionName string) (*api.PolicyTarget, error) {
+	if backend.Spec.MCP != nil {
+		for _, target := range backend.Spec.MCP.Targets {
+			if string(target.Name) != sectionName {
+				continue
+			}
+			if target.Static == nil {
+				return nil, fmt.Errorf("MCP target %s uses a selector, policies must target the selected Services instead", sectionName)
+			}
+			return &api.PolicyTarget{
+				Kind: &api.PolicyTarget_Backend{
+					Backend: utils.InternalMCPStaticBackendName(backend.Namespace, backend.Name, sectionName),
+				},
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("MCP target %s not found in Backend %s", sectionName, backend.Name)
+}
+
 func getTrafficPolicyName(trafficPolicyNs, trafficPolicyName, policyTargetName string) string {
 	return fmt.Sprintf("trafficpolicy/%s/%s/%s", trafficPolicyNs, trafficPolicyName, policyTargetName)
 }
@@ -1004,10 +1451,39 @@ func processRateLimitDescriptor(descriptor v1alpha1.RateLimitDescriptor) *api.Po
 	if len(entries) == 0 {
 		return nil
 	}
+	// Token descriptors are debited with the prompt and completion tokens reported
+	// in the LLM response, including streaming responses.
+	descriptorType := api.PolicySpec_RemoteRateLimit_REQUESTS
+	if descriptor.Unit == v1alpha1.RateLimitUnitTokens {
+		descriptorType = api.PolicySpec_RemoteRateLimit_TOKENS
+	}
 	return &api.PolicySpec_RemoteRateLimit_Descriptor{
 		Entries: entries,
-		Type:    api.PolicySpec_RemoteRateLimit_REQUESTS,
+		Type:    descriptorType,
+	}
+}
+
+// mcpAuthorizationRuleExpr returns a CEL expression that matches the use of the items of the rule
+// when one of its conditions is not satisfied.
+func mcpAuthorizationRuleExpr(rule v1alpha1.MCPAuthorizationRule) string {
+	var item string
+	switch rule.Kind {
+	case v1alpha1.MCPItemKindPrompt:
+		item = "mcp.prompt.name"
+	case v1alpha1.MCPItemKindResource:
+		item = "mcp.resource.name"
+	default:
+		item = "mcp.tool.name"
+	}
+	names := make([]string, 0, len(rule.Names))
+	for _, name := range rule.Names {
+		names = append(names, strconv.Quote(name))
+	}
+	conditions := make([]string, 0, len(rule.MatchExpressions))
+	for _, expr := range rule.MatchExpressions {
+		conditions = append(conditions, "("+expr+")")
 	}
+	return fmt.Sprintf("%s in [%s] && !(%s)", item, strings.Join(names, ", "), strings.Join(conditions, " && "))
 }
 
 // celHeaderExpr returns a CEL expression that reads a request header.
@@ -1,12 +1,26 @@
 package plugins
 
 import (
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/agentgateway/agentgateway/go/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 )
@@ -392,3 +406,672 @@ func TestProcessTransformationPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessRateLimitDescriptor(t *testing.T) {
+	tests := []struct {
+		name         string
+		descriptor   v1alpha1.RateLimitDescriptor
+		expectedType api.PolicySpec_RemoteRateLimit_Type
+		expectedKey  string
+		expectedExpr string
+	}{
+		{
+			name: "request descriptor",
+			descriptor: v1alpha1.RateLimitDescriptor{
+				Entries: []v1alpha1.RateLimitDescriptorEntry{
+					{
+						Type:    v1alpha1.RateLimitDescriptorEntryTypeGeneric,
+						Generic: &v1alpha1.RateLimitDescriptorEntryGeneric{Key: "team", Value: "search"},
+					},
+				},
+			},
+			expectedType: api.PolicySpec_RemoteRateLimit_REQUESTS,
+			expectedKey:  "team",
+			expectedExpr: `"search"`,
+		},
+		{
+			name: "token descriptor",
+			descriptor: v1alpha1.RateLimitDescriptor{
+				Entries: []v1alpha1.RateLimitDescriptorEntry{
+					{
+						Type:   v1alpha1.RateLimitDescriptorEntryTypeHeader,
+						Header: ptr.To("X-API-Key"),
+					},
+				},
+				Unit: v1alpha1.RateLimitUnitTokens,
+			},
+			expectedType: api.PolicySpec_RemoteRateLimit_TOKENS,
+			expectedKey:  "X-API-Key",
+			expectedExpr: `request.headers["x-api-key"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			descriptor := processRateLimitDescriptor(tt.descriptor)
+			require.NotNil(t, descriptor)
+			assert.Equal(t, tt.expectedType, descriptor.GetType())
+			require.Len(t, descriptor.GetEntries(), 1)
+			assert.Equal(t, tt.expectedKey, descriptor.GetEntries()[0].GetKey())
+			assert.Equal(t, tt.expectedExpr, descriptor.GetEntries()[0].GetValue())
+		})
+	}
+}
+
+func TestTranslateTrafficPolicyToAgwFields(t *testing.T) {
+	backendRef := &gwv1.BackendRef{
+		BackendObjectReference: gwv1.BackendObjectReference{
+			Name: "svc",
+			Port: ptr.To(gwv1.PortNumber(9000)),
+		},
+	}
+	inputs := []any{
+		&v1alpha1.GatewayExtension{
+			ObjectMeta: metav1.ObjectMeta{Name: "extauth", Namespace: "default"},
+			Spec: v1alpha1.GatewayExtensionSpec{
+				Type:    v1alpha1.GatewayExtensionTypeExtAuth,
+				ExtAuth: &v1alpha1.ExtAuthProvider{GrpcService: &v1alpha1.ExtGrpcService{BackendRef: backendRef}},
+			},
+		},
+		&v1alpha1.GatewayExtension{
+			ObjectMeta: metav1.ObjectMeta{Name: "extproc", Namespace: "default"},
+			Spec: v1alpha1.GatewayExtensionSpec{
+				Type:    v1alpha1.GatewayExtensionTypeExtProc,
+				ExtProc: &v1alpha1.ExtProcProvider{GrpcService: &v1alpha1.ExtGrpcService{BackendRef: backendRef}, FailOpen: true},
+			},
+		},
+		&v1alpha1.GatewayExtension{
+			ObjectMeta: metav1.ObjectMeta{Name: "ratelimit", Namespace: "default"},
+			Spec: v1alpha1.GatewayExtensionSpec{
+				Type:      v1alpha1.GatewayExtensionTypeRateLimit,
+				RateLimit: &v1alpha1.RateLimitProvider{GrpcService: &v1alpha1.ExtGrpcService{BackendRef: backendRef}, Domain: "test"},
+			},
+		},
+	}
+	mock := krttest.NewMock(t, inputs)
+	gatewayExtensions := krttest.GetMockCollection[*v1alpha1.GatewayExtension](mock)
+	secrets := krttest.GetMockCollection[*corev1.Secret](mock)
+	configMaps := krttest.GetMockCollection[*corev1.ConfigMap](mock)
+
+	tests := []struct {
+		// field is the json name of the TrafficPolicySpec field under test
+		field       string
+		name        string
+		spec        v1alpha1.TrafficPolicySpec
+		wantKinds   []string
+		unsupported []string
+		// backendType translates the policy as if it targets a Backend of the type
+		backendType v1alpha1.BackendType
+	}{
+		{
+			field: "ai",
+			name:  "prompt enrichment",
+			spec: v1alpha1.TrafficPolicySpec{AI: &v1alpha1.AIPolicy{
+				PromptEnrichment: &v1alpha1.AIPromptEnrichment{Prepend: []v1alpha1.Message{{Role: "system", Content: "be nice"}}},
+			}},
+			wantKinds: []string{"*api.PolicySpec_Ai_"},
+		},
+		{
+			field: "transformation",
+			name:  "request headers",
+			spec: v1alpha1.TrafficPolicySpec{Transformation: &v1alpha1.TransformationPolicy{
+				Request: &v1alpha1.Transform{Set: []v1alpha1.HeaderTransformation{{Name: "x-test", Value: "'value'"}}},
+			}},
+			wantKinds: []string{"*api.PolicySpec_Transformation"},
+		},
+		{
+			field:     "extProc",
+			name:      "extension",
+			spec:      v1alpha1.TrafficPolicySpec{ExtProc: &v1alpha1.ExtProcPolicy{ExtensionRef: &v1alpha1.NamespacedObjectReference{Name: "extproc"}}},
+			wantKinds: []string{"*api.PolicySpec_ExtProc_"},
+		},
+		{
+			field: "extProc",
+			name:  "processing mode",
+			spec: v1alpha1.TrafficPolicySpec{ExtProc: &v1alpha1.ExtProcPolicy{
+				ExtensionRef:   &v1alpha1.NamespacedObjectReference{Name: "extproc"},
+				ProcessingMode: &v1alpha1.ProcessingMode{RequestBodyMode: ptr.To("BUFFERED")},
+			}},
+			wantKinds:   []string{"*api.PolicySpec_ExtProc_"},
+			unsupported: []string{"extProc.processingMode"},
+		},
+		{
+			// agentgateway policies can not remove the policies of the parent attachment points
+			field:       "extProc",
+			name:        "disable",
+			spec:        v1alpha1.TrafficPolicySpec{ExtProc: &v1alpha1.ExtProcPolicy{Disable: &v1alpha1.PolicyDisable{}}},
+			unsupported: []string{"extProc.disable"},
+		},
+		{
+			field:     "extAuth",
+			name:      "extension",
+			spec:      v1alpha1.TrafficPolicySpec{ExtAuth: &v1alpha1.ExtAuthPolicy{ExtensionRef: &v1alpha1.NamespacedObjectReference{Name: "extauth"}}},
+			wantKinds: []string{"*api.PolicySpec_ExtAuthz"},
+		},
+		{
+			// agentgateway policies can not remove the policies of the parent attachment points
+			field:       "extAuth",
+			name:        "disable",
+			spec:        v1alpha1.TrafficPolicySpec{ExtAuth: &v1alpha1.ExtAuthPolicy{Disable: &v1alpha1.PolicyDisable{}}},
+			unsupported: []string{"extAuth.disable"},
+		},
+		{
+			field: "rateLimit",
+			name:  "local and global",
+			spec: v1alpha1.TrafficPolicySpec{RateLimit: &v1alpha1.RateLimit{
+				Local: &v1alpha1.LocalRateLimitPolicy{TokenBucket: &v1alpha1.TokenBucket{
+					MaxTokens:    10,
+					FillInterval: metav1.Duration{Duration: time.Second},
+				}},
+				Global: &v1alpha1.RateLimitPolicy{
+					ExtensionRef: v1alpha1.NamespacedObjectReference{Name: "ratelimit"},
+					Descriptors: []v1alpha1.RateLimitDescriptor{{Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{Type: v1alpha1.RateLimitDescriptorEntryTypeRemoteAddress},
+					}}},
+				},
+			}},
+			wantKinds: []string{"*api.PolicySpec_LocalRateLimit_", "*api.PolicySpec_RemoteRateLimit_"},
+		},
+		{
+			field: "cors",
+			name:  "allow origins",
+			spec: v1alpha1.TrafficPolicySpec{Cors: &v1alpha1.CorsPolicy{HTTPCORSFilter: &gwv1.HTTPCORSFilter{
+				AllowOrigins: []gwv1.AbsoluteURI{"https://example.com"},
+				MaxAge:       60,
+			}}},
+			wantKinds: []string{"*api.PolicySpec_Cors"},
+		},
+		{
+			// agentgateway policies can not remove the policies of the parent attachment points
+			field:       "cors",
+			name:        "disable",
+			spec:        v1alpha1.TrafficPolicySpec{Cors: &v1alpha1.CorsPolicy{Disable: &v1alpha1.PolicyDisable{}}},
+			unsupported: []string{"cors.disable"},
+		},
+		{
+			field: "csrf",
+			name:  "enabled with exact origins",
+			spec: v1alpha1.TrafficPolicySpec{Csrf: &v1alpha1.CSRFPolicy{
+				PercentageEnabled: ptr.To(int32(100)),
+				AdditionalOrigins: []v1alpha1.StringMatcher{{Exact: ptr.To("example.com")}},
+			}},
+			wantKinds: []string{"*api.PolicySpec_Csrf"},
+		},
+		{
+			field: "csrf",
+			name:  "partially enabled with prefix origins",
+			spec: v1alpha1.TrafficPolicySpec{Csrf: &v1alpha1.CSRFPolicy{
+				PercentageEnabled: ptr.To(int32(50)),
+				AdditionalOrigins: []v1alpha1.StringMatcher{{Prefix: ptr.To("example")}},
+			}},
+			unsupported: []string{"csrf.percentageEnabled"},
+		},
+		{
+			field:       "csrf",
+			name:        "shadowed",
+			spec:        v1alpha1.TrafficPolicySpec{Csrf: &v1alpha1.CSRFPolicy{PercentageShadowed: ptr.To(int32(100))}},
+			unsupported: []string{"csrf.percentageShadowed"},
+		},
+		{
+			field: "headerModifiers",
+			name:  "request and response",
+			spec: v1alpha1.TrafficPolicySpec{HeaderModifiers: &v1alpha1.HeaderModifiers{
+				Request:  &gwv1.HTTPHeaderFilter{Set: []gwv1.HTTPHeader{{Name: "x-req", Value: "a"}}},
+				Response: &gwv1.HTTPHeaderFilter{Remove: []string{"x-resp"}},
+			}},
+			wantKinds: []string{"*api.PolicySpec_RequestHeaderModifier", "*api.PolicySpec_ResponseHeaderModifier"},
+		},
+		{
+			field:     "autoHostRewrite",
+			name:      "enabled",
+			spec:      v1alpha1.TrafficPolicySpec{AutoHostRewrite: ptr.To(true)},
+			wantKinds: []string{"*api.PolicySpec_HostRewrite_"},
+		},
+		{
+			// agentgateway has no policy that limits the size of buffered requests
+			field: "buffer",
+			name:  "max request size",
+			spec: v1alpha1.TrafficPolicySpec{Buffer: &v1alpha1.Buffer{
+				MaxRequestSize: ptr.To(resource.MustParse("1Mi")),
+			}},
+			unsupported: []string{"buffer"},
+		},
+		{
+			field:     "timeouts",
+			name:      "request",
+			spec:      v1alpha1.TrafficPolicySpec{Timeouts: &v1alpha1.Timeouts{Request: &metav1.Duration{Duration: 10 * time.Second}}},
+			wantKinds: []string{"*api.PolicySpec_Timeout"},
+		},
+		{
+			// agentgateway has no idle timeout for the streams of a route
+			field: "timeouts",
+			name:  "stream idle",
+			spec: v1alpha1.TrafficPolicySpec{Timeouts: &v1alpha1.Timeouts{
+				Request:    &metav1.Duration{Duration: 10 * time.Second},
+				StreamIdle: &metav1.Duration{Duration: time.Minute},
+			}},
+			wantKinds:   []string{"*api.PolicySpec_Timeout"},
+			unsupported: []string{"timeouts.streamIdle"},
+		},
+		{
+			// agentgateway only retries the requests of HTTPRoute rules that set their retry field
+			field: "retry",
+			name:  "status codes",
+			spec: v1alpha1.TrafficPolicySpec{Retry: &v1alpha1.Retry{
+				Attempts:    3,
+				StatusCodes: []gwv1.HTTPRouteRetryStatusCode{503},
+			}},
+			unsupported: []string{"retry"},
+		},
+		{
+			field: "rbac",
+			name:  "allow",
+			spec: v1alpha1.TrafficPolicySpec{RBAC: &v1alpha1.RBAC{
+				Policy: v1alpha1.RBACPolicy{MatchExpressions: []string{"request.headers['x-user'] == 'admin'"}},
+			}},
+			wantKinds: []string{"*api.PolicySpec_Authorization"},
+		},
+		{
+			field: "rbac",
+			name:  "mcp with tool rules",
+			spec: v1alpha1.TrafficPolicySpec{
+				RBAC: &v1alpha1.RBAC{
+					Policy: v1alpha1.RBACPolicy{MatchExpressions: []string{"jwt.sub != ''"}},
+				},
+				MCP: &v1alpha1.MCPPolicy{Authorization: []v1alpha1.MCPAuthorizationRule{{
+					Names:            []string{"delete_repo"},
+					MatchExpressions: []string{"jwt.role == 'admin'"},
+				}}},
+			},
+			wantKinds:   []string{"*api.PolicySpec_McpAuthorization"},
+			backendType: v1alpha1.BackendTypeMCP,
+		},
+		{
+			field: "mcp",
+			name:  "tool rules",
+			spec: v1alpha1.TrafficPolicySpec{MCP: &v1alpha1.MCPPolicy{Authorization: []v1alpha1.MCPAuthorizationRule{{
+				Names:            []string{"delete_repo"},
+				MatchExpressions: []string{"jwt.role == 'admin'"},
+			}}}},
+			wantKinds:   []string{"*api.PolicySpec_McpAuthorization"},
+			backendType: v1alpha1.BackendTypeMCP,
+		},
+	}
+
+	// every TrafficPolicySpec field must be covered, so that new fields are not silently dropped
+	covered := sets.New[string]()
+	for _, tt := range tests {
+		covered.Insert(tt.field)
+	}
+	specType := reflect.TypeOf(v1alpha1.TrafficPolicySpec{})
+	for i := range specType.NumField() {
+		field := strings.Split(specType.Field(i).Tag.Get("json"), ",")[0]
+		if field == "targetRefs" || field == "targetSelectors" {
+			continue
+		}
+		assert.True(t, covered.Has(field), "TrafficPolicySpec field %s is not covered", field)
+	}
+
+	policyTarget := &api.PolicyTarget{Kind: &api.PolicyTarget_Route{Route: "default/route"}}
+	for _, tt := range tests {
+		t.Run(tt.field+"/"+tt.name, func(t *testing.T) {
+			policy := &v1alpha1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			policies, err := translateTrafficPolicyToAgw(krt.TestingDummyContext{}, gatewayExtensions, secrets, configMaps, policy, "route", policyTarget, tt.backendType)
+
+			var kinds []string
+			for _, p := range policies {
+				kinds = append(kinds, fmt.Sprintf("%T", p.Policy.GetSpec().GetKind()))
+				assert.Equal(t, policyTarget, p.Policy.GetTarget())
+			}
+			assert.ElementsMatch(t, tt.wantKinds, kinds)
+
+			if len(tt.unsupported) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, isUnsupportedFieldsError(err), "expected only unsupported fields, got %v", err)
+			for _, field := range tt.unsupported {
+				assert.ErrorContains(t, err, field+" is not supported for agentgateway")
+			}
+		})
+	}
+}
+
+func TestTranslateTrafficPolicyUnsupportedStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         v1alpha1.TrafficPolicySpec
+		wantPolicies int
+		wantStatus   metav1.ConditionStatus
+		wantReason   v1alpha1.PolicyConditionReason
+	}{
+		{
+			name: "supported fields are still applied",
+			spec: v1alpha1.TrafficPolicySpec{
+				AutoHostRewrite: ptr.To(true),
+				Buffer:          &v1alpha1.Buffer{MaxRequestSize: ptr.To(resource.MustParse("1Mi"))},
+			},
+			wantPolicies: 1,
+			wantStatus:   metav1.ConditionTrue,
+			wantReason:   v1alpha1.PolicyReasonPartiallyValid,
+		},
+		{
+			name: "only unsupported fields",
+			spec: v1alpha1.TrafficPolicySpec{
+				Buffer: &v1alpha1.Buffer{MaxRequestSize: ptr.To(resource.MustParse("1Mi"))},
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha1.PolicyReasonUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := krttest.NewMock(t, []any{})
+			policy := &v1alpha1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			policy.Spec.TargetRefs = []v1alpha1.LocalPolicyTargetReferenceWithSectionName{{
+				LocalPolicyTargetReference: v1alpha1.LocalPolicyTargetReference{
+					Group: gwv1.GroupName,
+					Kind:  "HTTPRoute",
+					Name:  "route",
+				},
+			}}
+
+			status, policies := TranslateTrafficPolicy(
+				krt.TestingDummyContext{},
+				krttest.GetMockCollection[*v1alpha1.GatewayExtension](mock),
+				krttest.GetMockCollection[*v1alpha1.Backend](mock),
+				krttest.GetMockCollection[*corev1.Secret](mock),
+				krttest.GetMockCollection[*corev1.ConfigMap](mock),
+				policy,
+				"kgateway.dev/agentgateway",
+			)
+
+			require.Len(t, policies, tt.wantPolicies)
+			require.Len(t, status.Ancestors, 1)
+			accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(v1alpha1.PolicyConditionAccepted))
+			require.NotNil(t, accepted)
+			assert.Equal(t, tt.wantStatus, accepted.Status)
+			assert.Equal(t, string(tt.wantReason), accepted.Reason)
+			assert.Equal(t, "buffer is not supported for agentgateway", accepted.Message)
+		})
+	}
+}
+
+func TestProcessCorsPolicyMaxAge(t *testing.T) {
+	policyTarget := &api.PolicyTarget{Kind: &api.PolicyTarget_Route{Route: "default/route"}}
+
+	tests := []struct {
+		name       string
+		maxAge     int32
+		wantMaxAge *durationpb.Duration
+	}{
+		{
+			name:       "set",
+			maxAge:     60,
+			wantMaxAge: &durationpb.Duration{Seconds: 60},
+		},
+		{
+			name: "unset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1alpha1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+				Spec: v1alpha1.TrafficPolicySpec{Cors: &v1alpha1.CorsPolicy{HTTPCORSFilter: &gwv1.HTTPCORSFilter{
+					AllowOrigins: []gwv1.AbsoluteURI{"https://example.com"},
+					MaxAge:       tt.maxAge,
+				}}},
+			}
+
+			policies, err := processCorsPolicy(policy, "default/policy", policyTarget)
+			require.NoError(t, err)
+			require.Len(t, policies, 1)
+			assert.True(t, proto.Equal(tt.wantMaxAge, policies[0].Policy.GetSpec().GetCors().GetMaxAge()))
+		})
+	}
+}
+
+func TestProcessRegexActions(t *testing.T) {
+	tests := []struct {
+		name       string
+		regex      *v1alpha1.Regex
+		wantAction api.PolicySpec_Ai_ActionKind
+		wantRules  int
+		wantErr    string
+	}{
+		{
+			name: "matches use the action of the regex",
+			regex: &v1alpha1.Regex{
+				Action:  ptr.To(v1alpha1.REJECT),
+				Matches: []v1alpha1.RegexMatch{{Name: ptr.To("ssn"), Pattern: ptr.To("[0-9]{9}"), Action: ptr.To(v1alpha1.REJECT)}},
+			},
+			wantAction: api.PolicySpec_Ai_REJECT,
+			wantRules:  1,
+		},
+		{
+			name: "log regex",
+			regex: &v1alpha1.Regex{
+				Action:  ptr.To(v1alpha1.LOG),
+				Matches: []v1alpha1.RegexMatch{{Name: ptr.To("ssn"), Pattern: ptr.To("[0-9]{9}")}},
+			},
+			wantErr: "ai.promptGuard.request.regex.action LOG is not supported for agentgateway",
+		},
+		{
+			name: "reject match is not weakened to mask",
+			regex: &v1alpha1.Regex{
+				Action: ptr.To(v1alpha1.MASK),
+				Matches: []v1alpha1.RegexMatch{
+					{Name: ptr.To("email"), Pattern: ptr.To("[a-z]+@[a-z]+")},
+					{Name: ptr.To("ssn"), Pattern: ptr.To("[0-9]{9}"), Action: ptr.To(v1alpha1.REJECT)},
+				},
+			},
+			wantAction: api.PolicySpec_Ai_REJECT,
+			wantRules:  2,
+			wantErr:    "ai.promptGuard.request.regex.matches[1].action other than the action of the regex is not supported for agentgateway",
+		},
+		{
+			name: "log match is dropped",
+			regex: &v1alpha1.Regex{
+				Action: ptr.To(v1alpha1.MASK),
+				Matches: []v1alpha1.RegexMatch{
+					{Name: ptr.To("email"), Pattern: ptr.To("[a-z]+@[a-z]+"), Action: ptr.To(v1alpha1.LOG)},
+					{Name: ptr.To("ssn"), Pattern: ptr.To("[0-9]{9}")},
+				},
+			},
+			wantAction: api.PolicySpec_Ai_MASK,
+			wantRules:  1,
+			wantErr:    "ai.promptGuard.request.regex.matches[0].action other than the action of the regex is not supported for agentgateway",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := processRegex(tt.regex, nil, "ai.promptGuard.request.regex")
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				assert.True(t, isUnsupportedFieldsError(err))
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantRules == 0 {
+				assert.Nil(t, rules)
+				return
+			}
+			assert.Equal(t, tt.wantAction, rules.GetAction().GetKind())
+			assert.Len(t, rules.GetRules(), tt.wantRules)
+		})
+	}
+}
+
+func TestProcessRBACPolicyMCPAuthorization(t *testing.T) {
+	policy := &v1alpha1.TrafficPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+		Spec: v1alpha1.TrafficPolicySpec{
+			RBAC: &v1alpha1.RBAC{
+				Policy: v1alpha1.RBACPolicy{MatchExpressions: []string{"jwt.sub != ''"}},
+			},
+			MCP: &v1alpha1.MCPPolicy{Authorization: []v1alpha1.MCPAuthorizationRule{
+				{
+					Names:            []string{"delete_repo", "gh_create_issue"},
+					MatchExpressions: []string{"jwt.role == 'admin'", "jwt.team == 'infra'"},
+				},
+				{
+					Kind:             v1alpha1.MCPItemKindPrompt,
+					Names:            []string{"summarize"},
+					MatchExpressions: []string{"'summarize' in jwt.scopes"},
+				},
+			}},
+		},
+	}
+	policyTarget := &api.PolicyTarget{Kind: &api.PolicyTarget_Backend{Backend: "default/mcp"}}
+
+	policies, err := processRBACPolicy(policy, "default/policy", policyTarget, true)
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	rbac := policies[0].Policy.GetSpec().GetMcpAuthorization()
+	require.NotNil(t, rbac)
+	assert.Equal(t, []string{"jwt.sub != ''"}, rbac.GetAllow())
+	assert.Equal(t, []string{
+		`mcp.tool.name in ["delete_repo", "gh_create_issue"] && !((jwt.role == 'admin') && (jwt.team == 'infra'))`,
+		`mcp.prompt.name in ["summarize"] && !(('summarize' in jwt.scopes))`,
+	}, rbac.GetDeny())
+
+	_, err = processRBACPolicy(policy, "default/policy", policyTarget, false)
+	assert.EqualError(t, err, "mcp is only supported when targeting an MCP Backend")
+}
+
+func TestProcessMCPUpstreamAuthPolicy(t *testing.T) {
+	mock := krttest.NewMock(t, []any{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "default"},
+			Data:       map[string][]byte{"Authorization": []byte("Bearer static-token")},
+		},
+	})
+	secrets := krttest.GetMockCollection[*corev1.Secret](mock)
+	policyTarget := &api.PolicyTarget{Kind: &api.PolicyTarget_Backend{Backend: "default/mcp/github"}}
+
+	tests := []struct {
+		name         string
+		upstreamAuth *v1alpha1.MCPUpstreamAuth
+		policyTarget *api.PolicyTarget
+		wantErr      string
+		validate     func(t *testing.T, auth *api.BackendAuthPolicy)
+	}{
+		{
+			name:         "static token",
+			upstreamAuth: &v1alpha1.MCPUpstreamAuth{SecretRef: corev1.LocalObjectReference{Name: "token"}},
+			validate: func(t *testing.T, auth *api.BackendAuthPolicy) {
+				assert.Equal(t, "static-token", auth.GetKey().GetSecret())
+			},
+		},
+		{
+			name:         "missing secret",
+			upstreamAuth: &v1alpha1.MCPUpstreamAuth{SecretRef: corev1.LocalObjectReference{Name: "missing"}},
+			wantErr:      "failed to find secret missing",
+		},
+		{
+			name:         "route target",
+			upstreamAuth: &v1alpha1.MCPUpstreamAuth{SecretRef: corev1.LocalObjectReference{Name: "token"}},
+			policyTarget: &api.PolicyTarget{Kind: &api.PolicyTarget_Route{Route: "default/route"}},
+			wantErr:      "mcp.upstreamAuth is only supported when targeting an MCP Backend",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1alpha1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+				Spec:       v1alpha1.TrafficPolicySpec{MCP: &v1alpha1.MCPPolicy{UpstreamAuth: tt.upstreamAuth}},
+			}
+			target := policyTarget
+			if tt.policyTarget != nil {
+				target = tt.policyTarget
+			}
+			policies, err := processMCPUpstreamAuthPolicy(krt.TestingDummyContext{}, secrets, policy, "default/policy", target)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, policies, 1)
+			assert.Equal(t, "default/policy:mcp-upstream-auth:default/mcp/github", policies[0].Policy.GetName())
+			assert.Equal(t, policyTarget, policies[0].Policy.GetTarget())
+			tt.validate(t, policies[0].Policy.GetSpec().GetAuth())
+		})
+	}
+}
+
+func TestTranslateTrafficPolicyMCPTargetSectionName(t *testing.T) {
+	mock := krttest.NewMock(t, []any{
+		&v1alpha1.Backend{
+			ObjectMeta: metav1.ObjectMeta{Name: "mcp", Namespace: "default"},
+			Spec: v1alpha1.BackendSpec{
+				Type: v1alpha1.BackendTypeMCP,
+				MCP: &v1alpha1.MCP{Targets: []v1alpha1.McpTargetSelector{
+					{Name: "github", Static: &v1alpha1.McpTarget{Host: "github.example.com", Port: 443}},
+					{Name: "discovered", Selector: &v1alpha1.McpSelector{Service: &metav1.LabelSelector{}}},
+				}},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "default"},
+			Data:       map[string][]byte{"Authorization": []byte("static-token")},
+		},
+	})
+	sectionRef := func(section string) v1alpha1.LocalPolicyTargetReferenceWithSectionName {
+		return v1alpha1.LocalPolicyTargetReferenceWithSectionName{
+			LocalPolicyTargetReference: v1alpha1.LocalPolicyTargetReference{
+				Group: "gateway.kgateway.dev",
+				Kind:  "Backend",
+				Name:  "mcp",
+			},
+			SectionName: ptr.To(gwv1.SectionName(section)),
+		}
+	}
+	policy := &v1alpha1.TrafficPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+		Spec: v1alpha1.TrafficPolicySpec{
+			TargetRefs: []v1alpha1.LocalPolicyTargetReferenceWithSectionName{
+				sectionRef("github"),
+				sectionRef("discovered"),
+				sectionRef("missing"),
+			},
+			MCP: &v1alpha1.MCPPolicy{UpstreamAuth: &v1alpha1.MCPUpstreamAuth{
+				SecretRef: corev1.LocalObjectReference{Name: "token"},
+			}},
+		},
+	}
+
+	status, policies := TranslateTrafficPolicy(
+		krt.TestingDummyContext{},
+		krttest.GetMockCollection[*v1alpha1.GatewayExtension](mock),
+		krttest.GetMockCollection[*v1alpha1.Backend](mock),
+		krttest.GetMockCollection[*corev1.Secret](mock),
+		krttest.GetMockCollection[*corev1.ConfigMap](mock),
+		policy,
+		"kgateway.dev/agentgateway",
+	)
+
+	require.Len(t, policies, 1)
+	assert.Equal(t, "default/mcp/github", policies[0].Policy.GetTarget().GetBackend())
+
+	require.Len(t, status.Ancestors, 3)
+	messages := map[string]string{}
+	for _, ancestor := range status.Ancestors {
+		accepted := meta.FindStatusCondition(ancestor.Conditions, string(v1alpha1.PolicyConditionAccepted))
+		require.NotNil(t, accepted)
+		messages[string(*ancestor.AncestorRef.SectionName)] = accepted.Message
+	}
+	assert.Equal(t, "MCP target discovered uses a selector, policies must target the selected Services instead", messages["discovered"])
+	assert.Equal(t, "MCP target missing not found in Backend mcp", messages["missing"])
+}
@@ -35,6 +35,8 @@ type ControlPlaneInfo struct {
 	XdsHost    string
 	XdsPort    uint32
 	AgwXdsPort uint32
+	// XdsDelta configures the proxies to use incremental (delta) xDS
+	XdsDelta bool
 }
 
 // InferenceExtInfo defines the runtime state of Gateway API inference extensions.
@@ -2666,6 +2666,10 @@ var _ = Describe("Deployer", func() {
 					}
 					Expect(prometheusListener).NotTo(BeNil())
 
+					// state-of-the-world xDS is used by default
+					bootstrapCfg := objs.getEnvoyConfig(defaultNamespace, defaultConfigMapName)
+					Expect(bootstrapCfg.GetDynamicResources().GetAdsConfig().GetApiType()).To(Equal(envoycorev3.ApiConfigSource_GRPC))
+
 					return nil
 				},
 			}),
@@ -2714,6 +2718,17 @@ var _ = Describe("Deployer", func() {
 					return nil
 				},
 			}),
+			Entry("envoy bootstrap uses delta xDS", func() *input {
+				inp := defaultInput()
+				inp.dInputs.ControlPlane.XdsDelta = true
+				return inp
+			}(), &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					bootstrapCfg := objs.getEnvoyConfig(defaultNamespace, defaultConfigMapName)
+					Expect(bootstrapCfg.GetDynamicResources().GetAdsConfig().GetApiType()).To(Equal(envoycorev3.ApiConfigSource_DELTA_GRPC))
+					return nil
+				},
+			}),
 			Entry("failed to get GatewayParameters", &input{
 				dInputs:    defaultDeployerInputs(),
 				gw:         defaultGatewayWithGatewayParams("bad-gwp"),
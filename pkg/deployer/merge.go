@@ -767,6 +767,8 @@ func deepMergeAIExtensionStats(dst, src *v1alpha1.AiExtensionStats) *v1alpha1.Ai
 	}
 
 	dst.CustomLabels = DeepMergeSlices(dst.GetCustomLabels(), src.GetCustomLabels())
+	dst.Pricing = DeepMergeSlices(dst.GetPricing(), src.GetPricing())
+	dst.Tenant = MergePointers(dst.GetTenant(), src.GetTenant())
 
 	return dst
 }
@@ -789,6 +791,79 @@ func deepMergeAgentgateway(dst, src *v1alpha1.Agentgateway) *v1alpha1.Agentgatew
 	dst.Env = DeepMergeSlices(dst.GetEnv(), src.GetEnv())
 	dst.CustomConfigMapName = MergePointers(dst.GetCustomConfigMapName(), src.GetCustomConfigMapName())
 	dst.ExtraVolumeMounts = DeepMergeSlices(dst.ExtraVolumeMounts, src.ExtraVolumeMounts)
+	dst.McpSessionAffinity = deepMergeMcpSessionAffinity(dst.GetMcpSessionAffinity(), src.GetMcpSessionAffinity())
+	dst.Config = deepMergeAgentgatewayConfig(dst.GetConfig(), src.GetConfig())
+
+	return dst
+}
+
+func deepMergeAgentgatewayConfig(dst, src *v1alpha1.AgentgatewayConfig) *v1alpha1.AgentgatewayConfig {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.AdminAddress = MergePointers(dst.GetAdminAddress(), src.GetAdminAddress())
+	dst.StatsAddress = MergePointers(dst.GetStatsAddress(), src.GetStatsAddress())
+	dst.WorkerThreads = MergePointers(dst.GetWorkerThreads(), src.GetWorkerThreads())
+	dst.ConnectionTerminationDeadline = MergePointers(dst.GetConnectionTerminationDeadline(), src.GetConnectionTerminationDeadline())
+	dst.ConnectionMinTerminationDeadline = MergePointers(dst.GetConnectionMinTerminationDeadline(), src.GetConnectionMinTerminationDeadline())
+	dst.Logging = deepMergeAgentgatewayLogging(dst.GetLogging(), src.GetLogging())
+	dst.Tracing = deepMergeAgentgatewayTracing(dst.GetTracing(), src.GetTracing())
+
+	return dst
+}
+
+func deepMergeAgentgatewayLogging(dst, src *v1alpha1.AgentgatewayLogging) *v1alpha1.AgentgatewayLogging {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.Format = MergePointers(dst.GetFormat(), src.GetFormat())
+	dst.Filter = MergePointers(dst.GetFilter(), src.GetFilter())
+
+	return dst
+}
+
+func deepMergeAgentgatewayTracing(dst, src *v1alpha1.AgentgatewayTracing) *v1alpha1.AgentgatewayTracing {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.OtlpEndpoint = MergePointers(dst.GetOtlpEndpoint(), src.GetOtlpEndpoint())
+	dst.OtlpProtocol = MergePointers(dst.GetOtlpProtocol(), src.GetOtlpProtocol())
+	dst.RandomSampling = MergePointers(dst.GetRandomSampling(), src.GetRandomSampling())
+
+	return dst
+}
+
+func deepMergeMcpSessionAffinity(dst, src *v1alpha1.McpSessionAffinity) *v1alpha1.McpSessionAffinity {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.Mode = MergePointers(dst.GetMode(), src.GetMode())
+	dst.SessionKeySecretRef = MergePointers(dst.GetSessionKeySecretRef(), src.GetSessionKeySecretRef())
+	dst.ClientIPTimeoutSeconds = MergePointers(dst.GetClientIPTimeoutSeconds(), src.GetClientIPTimeoutSeconds())
 
 	return dst
 }
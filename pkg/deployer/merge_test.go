@@ -227,6 +227,61 @@ func TestDeepMergeGatewayParameters(t *testing.T) {
 				assert.Equal(t, expectedMap, got.Spec.Kube.ServiceAccount.ExtraAnnotations)
 			},
 		},
+		{
+			name: "merges agentgateway config overlay",
+			dst: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						Agentgateway: &gw2_v1alpha1.Agentgateway{
+							Config: &gw2_v1alpha1.AgentgatewayConfig{
+								AdminAddress:  ptr.To("localhost:15000"),
+								WorkerThreads: ptr.To[int32](2),
+								Logging: &gw2_v1alpha1.AgentgatewayLogging{
+									Format: ptr.To(gw2_v1alpha1.AgentgatewayLogFormatText),
+									Filter: ptr.To("response.code >= 400"),
+								},
+							},
+						},
+					},
+				},
+			},
+			src: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						Agentgateway: &gw2_v1alpha1.Agentgateway{
+							Config: &gw2_v1alpha1.AgentgatewayConfig{
+								WorkerThreads: ptr.To[int32](4),
+								Logging: &gw2_v1alpha1.AgentgatewayLogging{
+									Format: ptr.To(gw2_v1alpha1.AgentgatewayLogFormatJSON),
+								},
+								Tracing: &gw2_v1alpha1.AgentgatewayTracing{
+									RandomSampling: ptr.To[int32](10),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						Agentgateway: &gw2_v1alpha1.Agentgateway{
+							Config: &gw2_v1alpha1.AgentgatewayConfig{
+								AdminAddress:  ptr.To("localhost:15000"),
+								WorkerThreads: ptr.To[int32](4),
+								Logging: &gw2_v1alpha1.AgentgatewayLogging{
+									Format: ptr.To(gw2_v1alpha1.AgentgatewayLogFormatJSON),
+									Filter: ptr.To("response.code >= 400"),
+								},
+								Tracing: &gw2_v1alpha1.AgentgatewayTracing{
+									RandomSampling: ptr.To[int32](10),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
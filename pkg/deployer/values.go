@@ -108,6 +108,10 @@ type HelmService struct {
 	ExtraAnnotations      map[string]string `json:"extraAnnotations,omitempty"`
 	ExtraLabels           map[string]string `json:"extraLabels,omitempty"`
 	ExternalTrafficPolicy *string           `json:"externalTrafficPolicy,omitempty"`
+
+	// session affinity of the service, set by the deployer for MCP session affinity
+	SessionAffinity               *string `json:"sessionAffinity,omitempty"`
+	SessionAffinityTimeoutSeconds *int32  `json:"sessionAffinityTimeoutSeconds,omitempty"`
 }
 
 type HelmServiceAccount struct {
@@ -120,6 +124,8 @@ type HelmServiceAccount struct {
 type HelmXds struct {
 	Host *string `json:"host,omitempty"`
 	Port *uint32 `json:"port,omitempty"`
+	// ApiType is the API type of the ADS config source of the envoy bootstrap, GRPC or DELTA_GRPC
+	ApiType *string `json:"apiType,omitempty"`
 }
 
 type HelmIstio struct {
@@ -189,7 +195,31 @@ type HelmEndpointPickerExtension struct {
 }
 
 type HelmAgentgateway struct {
-	Enabled             bool   `json:"enabled,omitempty"`
-	LogLevel            string `json:"logLevel,omitempty"`
-	CustomConfigMapName string `json:"customConfigMapName,omitempty"`
+	Enabled             bool                    `json:"enabled,omitempty"`
+	LogLevel            string                  `json:"logLevel,omitempty"`
+	CustomConfigMapName string                  `json:"customConfigMapName,omitempty"`
+	Config              *HelmAgentgatewayConfig `json:"config,omitempty"`
+}
+
+// HelmAgentgatewayConfig is rendered as the `config` section of the generated agentgateway
+// configuration file, so its field names must match the agentgateway configuration.
+type HelmAgentgatewayConfig struct {
+	AdminAddr                        *string                  `json:"adminAddr,omitempty"`
+	StatsAddr                        *string                  `json:"statsAddr,omitempty"`
+	WorkerThreads                    *int32                   `json:"workerThreads,omitempty"`
+	ConnectionTerminationDeadline    *string                  `json:"connectionTerminationDeadline,omitempty"`
+	ConnectionMinTerminationDeadline *string                  `json:"connectionMinTerminationDeadline,omitempty"`
+	Logging                          *HelmAgentgatewayLogging `json:"logging,omitempty"`
+	Tracing                          *HelmAgentgatewayTracing `json:"tracing,omitempty"`
+}
+
+type HelmAgentgatewayLogging struct {
+	Format *string `json:"format,omitempty"`
+	Filter *string `json:"filter,omitempty"`
+}
+
+type HelmAgentgatewayTracing struct {
+	OtlpEndpoint   *string  `json:"otlpEndpoint,omitempty"`
+	OtlpProtocol   *string  `json:"otlpProtocol,omitempty"`
+	RandomSampling *float64 `json:"randomSampling,omitempty"`
 }
@@ -10,6 +10,7 @@ import (
 
 	"golang.org/x/exp/slices"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -306,9 +307,56 @@ func GetAgentgatewayValues(config *v1alpha1.Agentgateway) (*HelmAgentgateway, er
 		customConfigMapName = *config.GetCustomConfigMapName()
 	}
 
+	// The config overlay is ignored when the whole configuration is provided by a custom ConfigMap
+	var agwConfig *HelmAgentgatewayConfig
+	if customConfigMapName == "" {
+		agwConfig = getAgentgatewayConfigValues(config.GetConfig())
+	}
+
 	return &HelmAgentgateway{
 		Enabled:             *config.GetEnabled(),
 		LogLevel:            logLevel,
 		CustomConfigMapName: customConfigMapName,
+		Config:              agwConfig,
 	}, nil
 }
+
+// getAgentgatewayConfigValues converts the agentgateway config overlay to the values merged into the
+// generated agentgateway configuration file.
+func getAgentgatewayConfigValues(config *v1alpha1.AgentgatewayConfig) *HelmAgentgatewayConfig {
+	if config == nil {
+		return nil
+	}
+
+	durationString := func(d *metav1.Duration) *string {
+		if d == nil {
+			return nil
+		}
+		return ptr.To(d.Duration.String())
+	}
+
+	values := &HelmAgentgatewayConfig{
+		AdminAddr:                        config.GetAdminAddress(),
+		StatsAddr:                        config.GetStatsAddress(),
+		WorkerThreads:                    config.GetWorkerThreads(),
+		ConnectionTerminationDeadline:    durationString(config.GetConnectionTerminationDeadline()),
+		ConnectionMinTerminationDeadline: durationString(config.GetConnectionMinTerminationDeadline()),
+	}
+	if logging := config.GetLogging(); logging != nil {
+		values.Logging = &HelmAgentgatewayLogging{
+			Format: (*string)(logging.GetFormat()),
+			Filter: logging.GetFilter(),
+		}
+	}
+	if tracing := config.GetTracing(); tracing != nil {
+		values.Tracing = &HelmAgentgatewayTracing{
+			OtlpEndpoint: tracing.GetOtlpEndpoint(),
+			OtlpProtocol: tracing.GetOtlpProtocol(),
+		}
+		// agentgateway expects the sampling rate as a ratio
+		if sampling := tracing.GetRandomSampling(); sampling != nil {
+			values.Tracing.RandomSampling = ptr.To(float64(*sampling) / 100)
+		}
+	}
+	return values
+}
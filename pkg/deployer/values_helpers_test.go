@@ -2,8 +2,14 @@ package deployer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 )
 
 func TestComponentLogLevelsToString(t *testing.T) {
@@ -56,3 +62,40 @@ func TestComponentLogLevelsToString(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAgentgatewayValues(t *testing.T) {
+	config := &v1alpha1.Agentgateway{
+		Enabled: ptr.To(true),
+		Config: &v1alpha1.AgentgatewayConfig{
+			StatsAddress:                  ptr.To("[::]:15020"),
+			WorkerThreads:                 ptr.To[int32](4),
+			ConnectionTerminationDeadline: &metav1.Duration{Duration: 30 * time.Second},
+			Logging: &v1alpha1.AgentgatewayLogging{
+				Format: ptr.To(v1alpha1.AgentgatewayLogFormatJSON),
+			},
+			Tracing: &v1alpha1.AgentgatewayTracing{
+				OtlpEndpoint:   ptr.To("http://otel-collector:4317"),
+				RandomSampling: ptr.To[int32](25),
+			},
+		},
+	}
+
+	got, err := GetAgentgatewayValues(config)
+	require.NoError(t, err)
+	assert.Equal(t, &HelmAgentgatewayConfig{
+		StatsAddr:                     ptr.To("[::]:15020"),
+		WorkerThreads:                 ptr.To[int32](4),
+		ConnectionTerminationDeadline: ptr.To("30s"),
+		Logging:                       &HelmAgentgatewayLogging{Format: ptr.To("json")},
+		Tracing: &HelmAgentgatewayTracing{
+			OtlpEndpoint:   ptr.To("http://otel-collector:4317"),
+			RandomSampling: ptr.To(0.25),
+		},
+	}, got.Config)
+
+	// the overlay is ignored when a custom ConfigMap provides the whole configuration
+	config.CustomConfigMapName = ptr.To("custom")
+	got, err = GetAgentgatewayValues(config)
+	require.NoError(t, err)
+	assert.Nil(t, got.Config)
+}
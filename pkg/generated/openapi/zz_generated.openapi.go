@@ -17,17 +17,26 @@ import (
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIBackend":                                 schema_kgateway_v2_api_v1alpha1_AIBackend(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AICache":                                   schema_kgateway_v2_api_v1alpha1_AICache(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIFallback":                                schema_kgateway_v2_api_v1alpha1_AIFallback(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPolicy":                                  schema_kgateway_v2_api_v1alpha1_AIPolicy(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptEnrichment":                        schema_kgateway_v2_api_v1alpha1_AIPromptEnrichment(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptGuard":                             schema_kgateway_v2_api_v1alpha1_AIPromptGuard(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCache":                           schema_kgateway_v2_api_v1alpha1_AISemanticCache(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIVectorStore":                             schema_kgateway_v2_api_v1alpha1_AIVectorStore(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AWSGuardrailConfig":                        schema_kgateway_v2_api_v1alpha1_AWSGuardrailConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AccessLog":                                 schema_kgateway_v2_api_v1alpha1_AccessLog(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AccessLogFilter":                           schema_kgateway_v2_api_v1alpha1_AccessLogFilter(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AccessLogGrpcService":                      schema_kgateway_v2_api_v1alpha1_AccessLogGrpcService(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Agentgateway":                              schema_kgateway_v2_api_v1alpha1_Agentgateway(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentgatewayConfig":                        schema_kgateway_v2_api_v1alpha1_AgentgatewayConfig(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentgatewayLogging":                       schema_kgateway_v2_api_v1alpha1_AgentgatewayLogging(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentgatewayTracing":                       schema_kgateway_v2_api_v1alpha1_AgentgatewayTracing(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiExtension":                               schema_kgateway_v2_api_v1alpha1_AiExtension(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiExtensionStats":                          schema_kgateway_v2_api_v1alpha1_AiExtensionStats(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiExtensionTrace":                          schema_kgateway_v2_api_v1alpha1_AiExtensionTrace(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiTenant":                                  schema_kgateway_v2_api_v1alpha1_AiTenant(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiTenantMetadata":                          schema_kgateway_v2_api_v1alpha1_AiTenantMetadata(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AlwaysOnConfig":                            schema_kgateway_v2_api_v1alpha1_AlwaysOnConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AnthropicConfig":                           schema_kgateway_v2_api_v1alpha1_AnthropicConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AnyValue":                                  schema_kgateway_v2_api_v1alpha1_AnyValue(ref),
@@ -126,7 +135,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelectorWithSectionName":  schema_kgateway_v2_api_v1alpha1_LocalPolicyTargetSelectorWithSectionName(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalRateLimitPolicy":                      schema_kgateway_v2_api_v1alpha1_LocalRateLimitPolicy(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCP":                                       schema_kgateway_v2_api_v1alpha1_MCP(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPAuthorizationRule":                      schema_kgateway_v2_api_v1alpha1_MCPAuthorizationRule(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPPolicy":                                 schema_kgateway_v2_api_v1alpha1_MCPPolicy(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPUpstreamAuth":                           schema_kgateway_v2_api_v1alpha1_MCPUpstreamAuth(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpFilter":                                 schema_kgateway_v2_api_v1alpha1_McpFilter(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpNameFilter":                             schema_kgateway_v2_api_v1alpha1_McpNameFilter(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSelector":                               schema_kgateway_v2_api_v1alpha1_McpSelector(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSessionAffinity":                        schema_kgateway_v2_api_v1alpha1_McpSessionAffinity(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTarget":                                 schema_kgateway_v2_api_v1alpha1_McpTarget(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTargetSelector":                         schema_kgateway_v2_api_v1alpha1_McpTargetSelector(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Message":                                   schema_kgateway_v2_api_v1alpha1_Message(ref),
@@ -134,6 +149,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MetadataNamespaces":                        schema_kgateway_v2_api_v1alpha1_MetadataNamespaces(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MetadataOptions":                           schema_kgateway_v2_api_v1alpha1_MetadataOptions(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MetadataPathSegment":                       schema_kgateway_v2_api_v1alpha1_MetadataPathSegment(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelAlias":                                schema_kgateway_v2_api_v1alpha1_ModelAlias(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelPricing":                              schema_kgateway_v2_api_v1alpha1_ModelPricing(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Moderation":                                schema_kgateway_v2_api_v1alpha1_Moderation(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.NamedLLMProvider":                          schema_kgateway_v2_api_v1alpha1_NamedLLMProvider(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.NamespacedObjectReference":                 schema_kgateway_v2_api_v1alpha1_NamespacedObjectReference(ref),
@@ -633,11 +650,85 @@ func schema_kgateway_v2_api_v1alpha1_AIBackend(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"fallback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fallback retries requests that fail with one of the configured status codes on the providers of the next priority group. All the providers of a Backend must be of the same type with the envoy-based AI gateway, which does not accept a Backend that mixes provider types, as requests are only translated to the schema of one provider. Note: This field is not supported by agentgateway, which does not accept a Backend that sets it. With agentgateway, requests fail over to the next priority group based on the health of the providers, and providers of different types can be mixed across priority groups.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIFallback"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIFallback", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LLMProvider", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PriorityGroup"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AICache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AICache configures caching of the responses returned by the LLM provider. By default, responses are cached by exact match on the requested model and the normalized prompt messages. Optionally, semantic caching can be enabled to also serve responses for prompts that are similar to a previously cached prompt.\n\nOnly non-streaming responses with a 200 status code are cached. Clients can bypass the cache for an individual request by sending the `Cache-Control: no-cache` header. Cache hits are reported in the `ai.kgateway.io:cache_hit` dynamic metadata, which can be used in access logs, and in the AI extension metrics.\n\nThe following example caches responses for 10 minutes, and looks up semantically similar prompts using the `openai-embeddings` Backend and a Redis vector store. ```yaml cache:\n\n\tttl: 10m\n\tsemantic:\n\t  embeddings:\n\t    name: openai-embeddings\n\t  vectorStore:\n\t    redis:\n\t      host: redis.kgateway-system.svc.cluster.local\n\t      port: 6379\n\t  similarityThreshold: 90\n\n```",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long a cached response is served before it expires. Defaults to `1h`.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"semantic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enable semantic caching, which serves the cached response of a similar prompt. When unset, only prompts that exactly match a cached prompt are served from the cache.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCache"),
+						},
+					},
+					"disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable caching for the targeted routes, for example to opt a single route out of a cache configured for all routes of a Gateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PolicyDisable"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LLMProvider", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PriorityGroup"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCache", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PolicyDisable", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AIFallback(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIFallback configures the fallback of failed requests to the next priority group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"statusCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The HTTP status codes returned by a provider that trigger a fallback. Defaults to 429, 500, 502, 503 and 504.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of fallback attempts for a request. Each attempt is sent to a provider of a lower priority group than the previous attempts. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -681,11 +772,17 @@ func schema_kgateway_v2_api_v1alpha1_AIPolicy(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache responses returned by the LLM provider and serve repeated prompts from the cache. Note: This is not yet supported for agentgateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AICache"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptEnrichment", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptGuard", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.FieldDefault"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AICache", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptEnrichment", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptGuard", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.FieldDefault"},
 	}
 }
 
@@ -759,6 +856,64 @@ func schema_kgateway_v2_api_v1alpha1_AIPromptGuard(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_AISemanticCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AISemanticCache configures semantic caching, which compares the embeddings of prompts to find cached responses for similar prompts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"embeddings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to a Backend of type `AI` in the same namespace that is used to compute the embeddings of prompts. The Backend must use the `openai` or `azureopenai` LLM provider, and its model must be an embeddings model, such as `text-embedding-3-small`. The auth token of the Backend must be `inline` or a `secretRef`.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"vectorStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The vector store that holds the embeddings of cached prompts.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIVectorStore"),
+						},
+					},
+					"similarityThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The minimum similarity, as a percentage, between a prompt and a cached prompt for the cached response to be served. Defaults to 95.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"embeddings", "vectorStore"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIVectorStore", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AIVectorStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIVectorStore configures the vector store used for semantic caching.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"redis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Use a Redis server with the RediSearch module as vector store. Note: TLS is not currently supported for the vector store.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Host"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Host"},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_AWSGuardrailConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1110,11 +1265,144 @@ func schema_kgateway_v2_api_v1alpha1_Agentgateway(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"mcpSessionAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "McpSessionAffinity configures how stateful MCP sessions keep working when the Gateway runs more than one agentgateway replica.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSessionAffinity"),
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config is merged into the agentgateway configuration file generated for the Gateway, so a few settings can be changed without replacing the whole file with a custom ConfigMap. Cannot be set together with customConfigMapName.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentgatewayConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Image", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentgatewayConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Image", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSessionAffinity", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AgentgatewayConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AgentgatewayConfig defines the settings of the agentgateway configuration file that can be overridden.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"adminAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The address the admin server listens on, e.g. `localhost:15000`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"statsAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The address the stats server listens on, e.g. `[::]:15020`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workerThreads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of worker threads. Defaults to the number of CPUs available to the container.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"connectionTerminationDeadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum time connections are drained for when agentgateway shuts down.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"connectionMinTerminationDeadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The minimum time connections are drained for when agentgateway shuts down, giving load balancers time to stop sending new connections.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"logging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logging configures the access logs of agentgateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentgatewayLogging"),
+						},
+					},
+					"tracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tracing configures the default tracing settings of agentgateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentgatewayTracing"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentgatewayLogging", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentgatewayTracing", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AgentgatewayLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AgentgatewayLogging configures the access logs of agentgateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The format of the access logs. Defaults to text.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A CEL expression selecting the requests that are logged, e.g. `response.code >= 400`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AgentgatewayTracing(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AgentgatewayTracing configures the default tracing settings of agentgateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"otlpEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The OTLP endpoint traces are exported to, e.g. `http://otel-collector.monitoring:4317`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"otlpProtocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The protocol used to export traces. Defaults to grpc.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"randomSampling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The percentage of requests that are sampled, from 0 to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -1218,11 +1506,36 @@ func schema_kgateway_v2_api_v1alpha1_AiExtensionStats(ref common.ReferenceCallba
 							},
 						},
 					},
+					"pricing": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Pricing of the models served through the AI Extension. When the model of a request matches an entry, its estimated cost is added to the `ai_cost` metric and to the `input_cost`, `output_cost` and `total_cost` fields of the `ai.kgateway.io` dynamic metadata, which can be used in access logs. The model returned by the provider is matched first, then the model of the request. If several entries match a model, the last one is used.\n\nExample: ```yaml pricing:\n  - provider: openai\n    model: gpt-4o\n    inputCostPer1K: \"0.0025\"\n    outputCostPer1K: \"0.01\"\n```",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelPricing"),
+									},
+								},
+							},
+						},
+					},
+					"tenant": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tenant selects the value of the `tenant` label of the request metrics, which attributes token usage and cost to a team or customer. Every distinct tenant value creates new time series for each request metric, so the tenant must come from a bounded set of values. Values that are not in `allowedValues` are counted as `other`.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiTenant"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CustomLabel"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiTenant", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CustomLabel", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelPricing"},
 	}
 }
 
@@ -1269,6 +1582,91 @@ func schema_kgateway_v2_api_v1alpha1_AiExtensionTrace(ref common.ReferenceCallba
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_AiTenant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AiTenant selects the value identifying the tenant of a request. Requests without a value are attributed to the `unknown` tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of a request header holding the tenant, for example `x-team`. Clients can set any value in a header, so `allowedValues` must be set with a header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jwtClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of a claim of the JWT of the request holding the tenant, for example `team`. The claim is read from the payload stored by the JWT filter under the `principal` key of the `envoy.filters.http.jwt_authn` dynamic metadata namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A dynamic metadata key holding the tenant, for example the name of the API key of the request set by an external auth service.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiTenantMetadata"),
+						},
+					},
+					"allowedValues": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The tenants that are reported in the `tenant` label. Requests of any other tenant are reported with the `other` tenant, which bounds the cardinality of the request metrics. Required when the tenant is read from a header.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiTenantMetadata"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AiTenantMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AiTenantMetadata is a key of the dynamic metadata of a request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The dynamic metadata namespace to get the tenant from.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The key to use to get the tenant from the metadata namespace, using `:` as the delimiter of nested keys, for example `principal:team`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "key"},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_AlwaysOnConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1749,20 +2147,20 @@ func schema_kgateway_v2_api_v1alpha1_BackendConfigPolicySpec(ref common.Referenc
 					},
 					"perConnectionBufferLimitBytes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Soft limit on the size of the cluster's connections read and write buffers. If unspecified, an implementation-defined default is applied (1MiB).",
+							Description: "Soft limit on the size of the cluster's connections read and write buffers. If unspecified, an implementation-defined default is applied (1MiB). Note: This field is not applicable when using agentgateway",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
 					"tcpKeepalive": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configure OS-level TCP keepalive checks.",
+							Description: "Configure OS-level TCP keepalive checks. Note: This field is not applicable when using agentgateway",
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPKeepalive"),
 						},
 					},
 					"commonHttpProtocolOptions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Additional options when handling HTTP requests upstream, applicable to both HTTP1 and HTTP2 requests.",
+							Description: "Additional options when handling HTTP requests upstream, applicable to both HTTP1 and HTTP2 requests. Note: This field is not applicable when using agentgateway",
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CommonHttpProtocolOptions"),
 						},
 					},
@@ -1792,7 +2190,7 @@ func schema_kgateway_v2_api_v1alpha1_BackendConfigPolicySpec(ref common.Referenc
 					},
 					"healthCheck": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HealthCheck contains the options necessary to configure the health check.",
+							Description: "HealthCheck contains the options necessary to configure the health check. Note: This field is not applicable when using agentgateway",
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HealthCheck"),
 						},
 					},
@@ -4878,11 +5276,33 @@ func schema_kgateway_v2_api_v1alpha1_LLMProvider(ref common.ReferenceCallback) c
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AuthHeader"),
 						},
 					},
+					"models": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Models lists the models that clients can request from this provider in the `model` field of the request body. Each entry can alias the client-facing model name to a different provider model. When set, requests are dispatched to the providers that serve the requested model, and requests for a model that no provider serves are rejected with a 404. If models are set on one provider of a Backend, they must be set on all its providers. Note: This field is not supported by agentgateway, which does not accept a Backend that sets it.\n\nExample that serves `gpt-4o` with an Azure OpenAI deployment, and aliases `fast` to `gpt-4o-mini`: ```yaml models:\n  - name: gpt-4o\n    target: gpt-4o-prod\n  - name: fast\n    target: gpt-4o-mini\n```",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelAlias"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AnthropicConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AuthHeader", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureOpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.BedrockConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GeminiConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PathOverride", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.VertexAIConfig"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AnthropicConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AuthHeader", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureOpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.BedrockConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GeminiConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelAlias", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PathOverride", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.VertexAIConfig"},
 	}
 }
 
@@ -5206,12 +5626,175 @@ func schema_kgateway_v2_api_v1alpha1_LocalPolicyTargetSelector(ref common.Refere
 							Format:      "",
 						},
 					},
-					"matchLabels": {
+					"matchLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label selector to select the target resource.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"group", "kind", "matchLabels"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_LocalPolicyTargetSelectorWithSectionName(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalPolicyTargetSelectorWithSectionName the object to attach the policy by Group, Kind, MatchLabels, and optionally SectionName. The object must be in the same namespace as the policy and match the specified labels. Do not use targetSelectors when reconciliation times are critical, especially if you have a large number of policies that target the same resource. Instead, use targetRefs to attach the policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The API group of the target resource. For Kubernetes Gateway API resources, the group is `gateway.networking.k8s.io`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The API kind of the target resource, such as Gateway or HTTPRoute.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label selector to select the target resource.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"sectionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The section name of the target resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"group", "kind", "matchLabels"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_LocalRateLimitPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalRateLimitPolicy represents a policy for local rate limiting. It defines the configuration for rate limiting using a token bucket mechanism.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tokenBucket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenBucket represents the configuration for a token bucket local rate-limiting mechanism. It defines the parameters for controlling the rate at which requests are allowed.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TokenBucket"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TokenBucket"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_MCP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCP configures mcp backends",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets is a list of MCP targets to use for this backend. Policies targeting MCP targets must use targetRefs[].sectionName to select the target by name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTargetSelector"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"targets"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTargetSelector"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_MCPAuthorizationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPAuthorizationRule requires a set of conditions to be satisfied to use MCP items.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of MCP item the rule applies to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"names": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names are the names of the items the rule applies to, as exposed by the gateway including any target prefix. Resources are identified by their URI.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"matchExpressions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Label selector to select the target resource.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "MatchExpressions defines a set of Common Expression Language (CEL) conditions that must all be satisfied to use the items, for example `jwt.sub == \"admin\"`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -5223,121 +5806,143 @@ func schema_kgateway_v2_api_v1alpha1_LocalPolicyTargetSelector(ref common.Refere
 						},
 					},
 				},
-				Required: []string{"group", "kind", "matchLabels"},
+				Required: []string{"names", "matchExpressions"},
 			},
 		},
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_LocalPolicyTargetSelectorWithSectionName(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_MCPPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalPolicyTargetSelectorWithSectionName the object to attach the policy by Group, Kind, MatchLabels, and optionally SectionName. The object must be in the same namespace as the policy and match the specified labels. Do not use targetSelectors when reconciliation times are critical, especially if you have a large number of policies that target the same resource. Instead, use targetRefs to attach the policy.",
+				Description: "MCPPolicy configures policies for the tools, prompts and resources of an MCP Backend.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"group": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The API group of the target resource. For Kubernetes Gateway API resources, the group is `gateway.networking.k8s.io`.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The API kind of the target resource, such as Gateway or HTTPRoute.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"matchLabels": {
+					"authorization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Label selector to select the target resource.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Authorization defines CEL authorization rules for individual tools, prompts and resources. Using an item listed in a rule is denied, and the item is hidden from listings, unless all the match expressions of the rule evaluate to true. Items without a rule are not affected.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPAuthorizationRule"),
 									},
 								},
 							},
 						},
 					},
-					"sectionName": {
+					"upstreamAuth": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The section name of the target resource.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "UpstreamAuth injects credentials on the requests sent to the MCP targets. To use different credentials for each target, select the target with targetRefs[].sectionName.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPUpstreamAuth"),
 						},
 					},
 				},
-				Required: []string{"group", "kind", "matchLabels"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPAuthorizationRule", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPUpstreamAuth"},
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_LocalRateLimitPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_MCPUpstreamAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalRateLimitPolicy represents a policy for local rate limiting. It defines the configuration for rate limiting using a token bucket mechanism.",
+				Description: "MCPUpstreamAuth configures the credentials sent to MCP targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tokenBucket": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TokenBucket represents the configuration for a token bucket local rate-limiting mechanism. It defines the parameters for controlling the rate at which requests are allowed.",
-							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TokenBucket"),
+							Description: "SecretRef references a Secret in the same namespace as the policy whose `Authorization` key holds a static token, sent as a bearer token to the MCP targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
 				},
+				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TokenBucket"},
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_MCP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_McpFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MCP configures mcp backends",
+				Description: "McpFilter restricts the tools, prompts and resources exposed by an MCP target.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"targets": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
+					"tools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tools filters the tools exposed by the target by name.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpNameFilter"),
+						},
+					},
+					"prompts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prompts filters the prompts exposed by the target by name.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpNameFilter"),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources filters the resources exposed by the target by URI.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpNameFilter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpNameFilter"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_McpNameFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "McpNameFilter allows or denies MCP items by name. Names are matched before any target prefix is applied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allow lists the names that are exposed. When set, all other names are hidden.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
 								},
-								"x-kubernetes-list-type": "map",
 							},
 						},
+					},
+					"deny": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets is a list of MCP targets to use for this backend. Policies targeting MCP targets must use targetRefs[].sectionName to select the target by name.",
+							Description: "Deny lists the names that are hidden. Deny takes precedence over Allow.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTargetSelector"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"targets"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTargetSelector"},
 	}
 }
 
@@ -5368,6 +5973,41 @@ func schema_kgateway_v2_api_v1alpha1_McpSelector(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_McpSessionAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "McpSessionAffinity configures the affinity of MCP Streamable HTTP and SSE sessions to agentgateway replicas.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode selects when session affinity is configured. Defaults to Auto.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sessionKeySecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionKeySecretRef references a Secret in the namespace of the Gateway holding the key shared by all replicas under the `session-key` entry. When set, agentgateway issues self-describing session IDs encrypted with the key, so that any replica can resume a session. When unset, the Service of the Gateway pins each client IP to a replica instead.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"clientIPTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientIPTimeoutSeconds is the maximum session sticky time of a client IP when SessionKeySecretRef is unset. Defaults to 10800 (3 hours).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_McpTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5439,12 +6079,25 @@ func schema_kgateway_v2_api_v1alpha1_McpTargetSelector(ref common.ReferenceCallb
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTarget"),
 						},
 					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is prepended to the names of the tools and prompts exposed by this target, to avoid name collisions between targets. Policies must use the prefixed names.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter restricts the tools, prompts and resources exposed by this target.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpFilter"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSelector", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTarget"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpFilter", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSelector", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTarget"},
 	}
 }
 
@@ -5600,6 +6253,80 @@ func schema_kgateway_v2_api_v1alpha1_MetadataPathSegment(ref common.ReferenceCal
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_ModelAlias(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ModelAlias maps a model name requested by clients to the model of an LLM provider.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The model name sent by clients in the `model` field of the request body.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The model sent to the LLM provider for requests of this model, such as the deployment name for Azure OpenAI. Defaults to the client-facing name.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_ModelPricing(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ModelPricing is the price of the tokens of a model, in the currency used for chargeback.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The LLM provider serving the model. If not specified, the price applies to the model regardless of the provider.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"model": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the model, as sent in the request or returned by the provider.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"inputCostPer1K": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The price of 1000 input (prompt) tokens, as a decimal number.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"outputCostPer1K": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The price of 1000 output (completion) tokens, as a decimal number.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"model", "inputCostPer1K", "outputCostPer1K"},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_Moderation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5698,12 +6425,34 @@ func schema_kgateway_v2_api_v1alpha1_NamedLLMProvider(ref common.ReferenceCallba
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AuthHeader"),
 						},
 					},
+					"models": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Models lists the models that clients can request from this provider in the `model` field of the request body. Each entry can alias the client-facing model name to a different provider model. When set, requests are dispatched to the providers that serve the requested model, and requests for a model that no provider serves are rejected with a 404. If models are set on one provider of a Backend, they must be set on all its providers. Note: This field is not supported by agentgateway, which does not accept a Backend that sets it.\n\nExample that serves `gpt-4o` with an Azure OpenAI deployment, and aliases `fast` to `gpt-4o-mini`: ```yaml models:\n  - name: gpt-4o\n    target: gpt-4o-prod\n  - name: fast\n    target: gpt-4o-mini\n```",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelAlias"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AnthropicConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AuthHeader", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureOpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.BedrockConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GeminiConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PathOverride", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.VertexAIConfig"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AnthropicConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AuthHeader", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureOpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.BedrockConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GeminiConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelAlias", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PathOverride", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.VertexAIConfig"},
 	}
 }
 
@@ -6545,6 +7294,13 @@ func schema_kgateway_v2_api_v1alpha1_RateLimitDescriptor(ref common.ReferenceCal
 							},
 						},
 					},
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit specifies what is counted against the limits configured for this descriptor in the rate limit service. Requests counts one hit per request. Tokens counts the prompt and completion tokens reported in the LLM response, including streaming responses, and is only meaningful for routes that target AI backends. With Tokens, the request is rejected with a 429 once the budget is exhausted, and the tokens consumed by a request are debited from the budget when the response completes. Remaining budget headers are returned when enabled on the rate limit GatewayExtension.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"entries"},
 			},
@@ -6744,6 +7500,20 @@ func schema_kgateway_v2_api_v1alpha1_Regex(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"patternSets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A list of ConfigMaps in the namespace of the policy holding regex patterns to match against the request or response, in addition to matches and built-ins. Each key of the ConfigMap data is the name of a match, and its value is the pattern. This allows sharing a set of patterns across policies.\n\nExample: ```yaml apiVersion: v1 kind: ConfigMap metadata:\n  name: internal-identifiers\ndata:\n  EMPLOYEE_ID: \"EMP-[0-9]{6}\"\n  PROJECT_CODE: \"PRJ-[A-Z]{3}-[0-9]{4}\"\n```",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
 					"action": {
 						SchemaProps: spec.SchemaProps{
 							Description: "The action to take if a regex pattern is matched in a request or response. This setting applies only to request matches. PromptguardResponse matches are always masked by default. Defaults to `MASK`.",
@@ -6755,7 +7525,7 @@ func schema_kgateway_v2_api_v1alpha1_Regex(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RegexMatch"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RegexMatch", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -6773,6 +7543,13 @@ func schema_kgateway_v2_api_v1alpha1_RegexMatch(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"builtin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A built-in regex pattern to match against the request or response. This can be used instead of `builtins` to set an action for a single built-in.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
 							Description: "An optional name for this match, which can be used for debugging purposes.",
@@ -6780,6 +7557,13 @@ func schema_kgateway_v2_api_v1alpha1_RegexMatch(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The action to take if this match is found, which overrides the action of the regex. Matches in responses are never rejected, the `REJECT` action masks them instead. Note: agentgateway uses a single action for all the matches of a regex. A match with a different action is reported as unsupported, and a `REJECT` match makes the regex reject all its matches.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -7971,11 +8755,17 @@ func schema_kgateway_v2_api_v1alpha1_TrafficPolicySpec(ref common.ReferenceCallb
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RBAC"),
 						},
 					},
+					"mcp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCP specifies policies that apply to the tools, prompts and resources of an MCP Backend. Note: This field is only applicable when using agentgateway and targeting an MCP Backend.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Buffer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CSRFPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CorsPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtAuthPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtProcPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HeaderModifiers", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReferenceWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelectorWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RBAC", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimit", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Retry", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Timeouts", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TransformationPolicy"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Buffer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CSRFPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CorsPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtAuthPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtProcPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HeaderModifiers", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReferenceWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelectorWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RBAC", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimit", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Retry", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Timeouts", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TransformationPolicy"},
 	}
 }
 
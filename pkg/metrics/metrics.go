@@ -189,6 +189,7 @@ type Gauge interface {
 	Set(float64, ...Label)
 	Add(float64, ...Label)
 	Sub(float64, ...Label)
+	Delete(...Label) bool
 	Reset()
 }
 
@@ -247,6 +248,11 @@ func (g *prometheusGauge) Sub(value float64, labels ...Label) {
 	g.m.WithLabelValues(g.validateLabels(labels)...).Sub(value)
 }
 
+// Delete removes the gauge with the given labels. It returns true if the gauge was found.
+func (g *prometheusGauge) Delete(labels ...Label) bool {
+	return g.m.DeleteLabelValues(g.validateLabels(labels)...)
+}
+
 // Reset resets the gauge to zero.
 func (g *prometheusGauge) Reset() {
 	g.m.Reset()
@@ -266,6 +266,13 @@ func TestGaugeInterface(t *testing.T) {
 		Value:  12.0,
 	})
 
+	assert.True(t, gauge.Delete(labels...))
+	assert.False(t, gauge.Delete(labels...))
+	gathered = metricstest.MustGatherMetrics(t)
+	gathered.AssertMetricNotExists("kgateway_tests")
+
+	gauge.Set(12.0, labels...)
+
 	gauge.Reset()
 	gathered = metricstest.MustGatherMetrics(t)
 	gathered.AssertMetricNotExists("kgateway_tests")
@@ -35,6 +35,21 @@ const (
 	GatewayReplacedReason = "GatewayReplaced"
 )
 
+const (
+	// RouteConditionConflicted is set to True on the parent status of a route with rules that lose to an
+	// overlapping rule of the same or another route. It is a warning: the route is still accepted and programmed.
+	RouteConditionConflicted gwv1.RouteConditionType = "Conflicted"
+
+	// RouteReasonRuleShadowed is used with the Conflicted=True condition when a rule never receives traffic
+	// because a higher precedence rule matches every request it matches.
+	RouteReasonRuleShadowed gwv1.RouteConditionReason = "RuleShadowed"
+
+	// RouteReasonAmbiguousOverlap is used with the Conflicted=True condition when a rule overlaps with a rule
+	// of another route of the same precedence, so only the creation timestamp or name of the routes decides
+	// which rule matches the requests both rules match.
+	RouteReasonAmbiguousOverlap gwv1.RouteConditionReason = "AmbiguousOverlap"
+)
+
 // PolicyAttachmentState represents the state of a policy attachment
 type PolicyAttachmentState int
 
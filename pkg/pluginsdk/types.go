@@ -6,6 +6,7 @@ import (
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -44,6 +45,11 @@ type (
 	GetPolicyStatusFn func(context.Context, types.NamespacedName) (gwv1alpha2.PolicyStatus, error)
 	// PatchPolicyStatusFn is a type that plugins can implement to patch the PolicyStatus for the given policy
 	PatchPolicyStatusFn func(context.Context, types.NamespacedName, gwv1alpha2.PolicyStatus) error
+	// ValidatePolicyFn is a type that plugins can implement to validate a policy before it is persisted.
+	// It only validates the policy itself: the resources it references are not resolved, as they may be
+	// created after the policy, and are reported in the policy status instead.
+	// It returns the errors the policy would be reported with, see utils.WithFieldPath to report the field at fault.
+	ValidatePolicyFn func(metav1.Object) []error
 )
 
 type PolicyPlugin struct {
@@ -67,6 +73,9 @@ type PolicyPlugin struct {
 
 	GetPolicyStatus   GetPolicyStatusFn
 	PatchPolicyStatus PatchPolicyStatusFn
+
+	// ValidatePolicy is used by the validating admission webhook to reject invalid policies
+	ValidatePolicy ValidatePolicyFn
 }
 
 type BackendPlugin struct {
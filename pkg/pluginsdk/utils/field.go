@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// FieldError is a policy error caused by the value of a field. Its message is the message of the
+// wrapped error, so that annotating an error with its field does not change policy statuses.
+type FieldError struct {
+	Path *field.Path
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// WithFieldPath annotates err with the path of the field that caused it. Returns nil if err is nil.
+func WithFieldPath(path *field.Path, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &FieldError{Path: path, Err: err}
+}
+
+// ToFieldErrors converts policy errors to field errors. Errors that are not annotated with
+// WithFieldPath are reported for defaultPath.
+func ToFieldErrors(errs []error, defaultPath *field.Path) field.ErrorList {
+	var out field.ErrorList
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		path := defaultPath
+		var fieldErr *FieldError
+		if errors.As(err, &fieldErr) {
+			path = fieldErr.Path
+		}
+		out = append(out, &field.Error{
+			Type:     field.ErrorTypeInvalid,
+			Field:    path.String(),
+			BadValue: field.OmitValueType{},
+			Detail:   err.Error(),
+		})
+	}
+	return out
+}
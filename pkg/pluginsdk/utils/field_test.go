@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestWithFieldPath(t *testing.T) {
+	assert.NoError(t, WithFieldPath(field.NewPath("spec"), nil))
+
+	cause := errors.New("invalid duration")
+	err := WithFieldPath(field.NewPath("spec", "timeouts"), cause)
+	assert.EqualError(t, err, "invalid duration")
+	assert.ErrorIs(t, err, cause)
+}
+
+func TestToFieldErrors(t *testing.T) {
+	errs := []error{
+		WithFieldPath(field.NewPath("spec", "rateLimit", "local"), errors.New("tokens must be positive")),
+		fmt.Errorf("wrapped: %w", WithFieldPath(field.NewPath("metadata", "annotations").Key("weight"), errors.New("not an integer"))),
+		errors.New("xds validation failed"),
+		nil,
+	}
+
+	got := ToFieldErrors(errs, field.NewPath("spec"))
+
+	assert.Equal(t, []string{
+		"spec.rateLimit.local: Invalid value: tokens must be positive",
+		"metadata.annotations[weight]: Invalid value: wrapped: not an integer",
+		"spec: Invalid value: xds validation failed",
+	}, []string{got[0].Error(), got[1].Error(), got[2].Error()})
+	assert.Len(t, got, 3)
+}
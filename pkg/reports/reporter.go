@@ -3,6 +3,7 @@ package reports
 import (
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -205,6 +206,22 @@ func (g *GatewayReport) GetConditions() []metav1.Condition {
 	return g.conditions
 }
 
+// Clone returns a copy of the report whose conditions can be set without modifying g.
+func (g *GatewayReport) Clone() *GatewayReport {
+	var listeners map[string]*ListenerReport
+	if g.listeners != nil {
+		listeners = make(map[string]*ListenerReport, len(g.listeners))
+		for name, l := range g.listeners {
+			listeners[name] = &ListenerReport{Status: *l.Status.DeepCopy()}
+		}
+	}
+	return &GatewayReport{
+		conditions:         slices.Clone(g.conditions),
+		listeners:          listeners,
+		observedGeneration: g.observedGeneration,
+	}
+}
+
 func (g *GatewayReport) SetCondition(gc reporter.GatewayCondition) {
 	condition := metav1.Condition{
 		Type:    string(gc.Type),
@@ -104,6 +104,40 @@ var _ = Describe("Reporting Infrastructure", func() {
 			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
 		})
 
+		It("should not modify the original report when a clone is modified", func() {
+			gw := gw()
+			rm := reports.NewReportMap()
+			r := reports.NewReporter(&rm)
+			r.Gateway(gw).Listener(listener()).SetCondition(reporter.ListenerCondition{
+				Type:   gwv1.ListenerConditionResolvedRefs,
+				Status: metav1.ConditionFalse,
+				Reason: gwv1.ListenerReasonInvalidRouteKinds,
+			})
+
+			clone := rm.Gateway(gw).Clone()
+			clone.SetCondition(reporter.GatewayCondition{
+				Type:   gwv1.GatewayConditionProgrammed,
+				Status: metav1.ConditionFalse,
+				Reason: gwv1.GatewayReasonAddressNotUsable,
+			})
+			clone.Listener(listener()).SetCondition(reporter.ListenerCondition{
+				Type:   gwv1.ListenerConditionResolvedRefs,
+				Status: metav1.ConditionTrue,
+				Reason: gwv1.ListenerReasonResolvedRefs,
+			})
+			clone.ListenerName("other").SetCondition(reporter.ListenerCondition{
+				Type:   gwv1.ListenerConditionAccepted,
+				Status: metav1.ConditionFalse,
+				Reason: gwv1.ListenerReasonPortUnavailable,
+			})
+
+			Expect(meta.FindStatusCondition(rm.Gateway(gw).GetConditions(), string(gwv1.GatewayConditionProgrammed))).To(BeNil())
+			status := rm.BuildGWStatus(context.Background(), *gw, nil)
+			Expect(status.Listeners).To(HaveLen(1))
+			resolvedRefs := meta.FindStatusCondition(status.Listeners[0].Conditions, string(gwv1.ListenerConditionResolvedRefs))
+			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+		})
+
 		It("should not modify LastTransitionTime for existing conditions that have not changed", func() {
 			gw := gw()
 			rm := reports.NewReportMap()
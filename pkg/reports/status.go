@@ -33,6 +33,10 @@ const (
 	RouteAcceptedMessage         = "Successfully accepted Route"
 )
 
+// GatewayReasonProxyRejectedConfig is the reason of the Programmed=False condition of a Gateway
+// whose proxies rejected (NACKed) the config sent to them.
+const GatewayReasonProxyRejectedConfig gwv1.GatewayConditionReason = "ProxyRejectedConfig"
+
 // TODO: refactor this struct + methods to better reflect the usage now in proxy_syncer
 
 func (r *ReportMap) BuildGWStatus(ctx context.Context, gw gwv1.Gateway, attachedRoutes map[string]uint) *gwv1.GatewayStatus {
@@ -317,6 +321,10 @@ func (r *ReportMap) BuildRouteStatus(
 		// If there are conditions on the route that are not owned by our reporter, include
 		// them in the final list of conditions to preseve conditions we do not own
 		for _, condition := range currentParentRefConditions {
+			// the Conflicted condition is only reported while the conflict exists
+			if condition.Type == string(reporter.RouteConditionConflicted) {
+				continue
+			}
 			if meta.FindStatusCondition(finalConditions, condition.Type) == nil {
 				finalConditions = append(finalConditions, condition)
 			}
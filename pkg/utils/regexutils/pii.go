@@ -0,0 +1,101 @@
+package regexutils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// contextSeparator matches what separates a context keyword from the number that follows it,
+// as in `passport number: A12345678` or `TFN 123 456 782`.
+// US passport, CA SIN and AU TFN numbers are plain digit groups that cannot be told apart from
+// other numbers without a checksum, which regexes cannot compute, so they are only matched after
+// a context keyword. The keyword is part of the match and is masked with the number.
+const contextSeparator = `(?:\s*(?i:number|no\.?|#))?\s*:?\s*`
+
+// builtinPIIPatterns are the patterns of the built-in prompt guard regexes that are not natively
+// implemented by the data planes. The patterns only use the syntax shared by RE2, the Rust regex
+// crate and the Python regex module, as they are evaluated by all of them.
+var builtinPIIPatterns = map[v1alpha1.BuiltIn]string{
+	v1alpha1.IBAN:        `\b[A-Z]{2}[0-9]{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`,
+	v1alpha1.IP_ADDRESS:  `\b(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\b|\b(?:[0-9A-Fa-f]{1,4}:){7}[0-9A-Fa-f]{1,4}\b`,
+	v1alpha1.US_PASSPORT: `\b(?i:passport)` + contextSeparator + `(?:[A-Z][0-9]{8}|[0-9]{9})\b`,
+	v1alpha1.DE_PASSPORT: `\b[CFGHJKLMNPRTVWXYZ][CFGHJKLMNPRTVWXYZ0-9]{8}\b`,
+	v1alpha1.IN_PASSPORT: `\b[A-Z][0-9]{7}\b`,
+	v1alpha1.API_KEY: `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b` +
+		`|\bgh[pousr]_[A-Za-z0-9]{36}\b` +
+		`|\bAIza[0-9A-Za-z_-]{35}\b` +
+		`|\bsk-(?:proj-)?[A-Za-z0-9_-]{20,}\b` +
+		`|\bxox[abprs]-[A-Za-z0-9-]{10,}\b` +
+		`|\b[rs]k_live_[0-9A-Za-z]{24,}\b`,
+	v1alpha1.PRIVATE_KEY:    `-----BEGIN (?:[A-Z]+ )*PRIVATE KEY-----`,
+	v1alpha1.JWT:            `\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`,
+	v1alpha1.UK_NINO:        `\b[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?[0-9]{2} ?[0-9]{2} ?[0-9]{2} ?[A-D]\b`,
+	v1alpha1.CA_SIN:         `\b(?i:SIN|social insurance)` + contextSeparator + `[1-79][0-9]{2}[- ]?[0-9]{3}[- ]?[0-9]{3}\b`,
+	v1alpha1.IN_AADHAAR:     `\b[2-9][0-9]{3} ?[0-9]{4} ?[0-9]{4}\b`,
+	v1alpha1.IN_PAN:         `\b[A-Z]{5}[0-9]{4}[A-Z]\b`,
+	v1alpha1.SG_NRIC:        `\b[STFGM][0-9]{7}[A-Z]\b`,
+	v1alpha1.ES_DNI:         `\b[0-9]{8}-?[A-Z]\b`,
+	v1alpha1.IT_FISCAL_CODE: `\b[A-Z]{6}[0-9]{2}[A-EHLMPR-T][0-9]{2}[A-Z][0-9]{3}[A-Z]\b`,
+	v1alpha1.BR_CPF:         `\b[0-9]{3}\.[0-9]{3}\.[0-9]{3}-[0-9]{2}\b`,
+	v1alpha1.AU_TFN:         `\b(?i:TFN|tax file)` + contextSeparator + `[0-9]{3} ?[0-9]{3} ?[0-9]{2,3}\b`,
+}
+
+// BuiltinPIIPattern returns the pattern of a built-in prompt guard regex.
+// Returns false for the built-ins that are natively implemented by the data planes.
+func BuiltinPIIPattern(builtin v1alpha1.BuiltIn) (string, bool) {
+	pattern, ok := builtinPIIPatterns[builtin]
+	return pattern, ok
+}
+
+// PatternSetMatches returns the regex matches of a pattern set ConfigMap, where each key of the
+// data is the name of a match and its value is the pattern. Matches are sorted by name.
+func PatternSetMatches(cm *corev1.ConfigMap) []v1alpha1.RegexMatch {
+	names := make([]string, 0, len(cm.Data))
+	for name := range cm.Data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	matches := make([]v1alpha1.RegexMatch, 0, len(names))
+	for _, name := range names {
+		matches = append(matches, v1alpha1.RegexMatch{
+			Name:    ptr.To(name),
+			Pattern: ptr.To(cm.Data[name]),
+		})
+	}
+	return matches
+}
+
+// ExpandPatternSets returns a copy of the regex with the matches of its pattern sets appended to its matches,
+// using getConfigMap to look up the pattern set ConfigMaps by name. Pattern sets that cannot be found and
+// invalid patterns are skipped and reported in the returned error.
+func ExpandPatternSets(regex *v1alpha1.Regex, getConfigMap func(name string) *corev1.ConfigMap) (*v1alpha1.Regex, error) {
+	if regex == nil || len(regex.PatternSets) == 0 {
+		return regex, nil
+	}
+
+	out := regex.DeepCopy()
+	out.PatternSets = nil
+	var errs []error
+	for _, ref := range regex.PatternSets {
+		cm := getConfigMap(ref.Name)
+		if cm == nil {
+			errs = append(errs, fmt.Errorf("pattern set ConfigMap %s not found", ref.Name))
+			continue
+		}
+		for _, match := range PatternSetMatches(cm) {
+			if err := CheckRegexString(*match.Pattern); err != nil {
+				errs = append(errs, fmt.Errorf("invalid pattern %s in pattern set ConfigMap %s: %w", *match.Name, ref.Name, err))
+				continue
+			}
+			out.Matches = append(out.Matches, match)
+		}
+	}
+	return out, errors.Join(errs...)
+}
@@ -0,0 +1,202 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	envoybootstrapv3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"golang.org/x/net/http/httpguts"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"sigs.k8s.io/yaml"
+
+	// register all the envoy types that may be packed in a typed_config
+	_ "github.com/kgateway-dev/kgateway/v2/pkg/utils/filter_types"
+)
+
+// protoValidator validates envoy config in-process, without an envoy binary.
+type protoValidator struct{}
+
+var _ Validator = &protoValidator{}
+
+// NewProto creates a new validator that checks the config against the protoc-gen-validate rules of
+// the Envoy API, including the messages packed in Any fields, and performs the cross-reference checks
+// Envoy does when loading a config: regexes must compile, routes must reference defined clusters and
+// headers modified by routes must be valid. It does not catch every error Envoy validate mode would,
+// but needs neither an envoy binary nor docker.
+func NewProto() Validator {
+	return &protoValidator{}
+}
+
+func (p *protoValidator) Validate(_ context.Context, config string) error {
+	jsn, err := yaml.YAMLToJSON([]byte(config))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidXDS, err)
+	}
+	bs := &envoybootstrapv3.Bootstrap{}
+	if err := protojson.Unmarshal(jsn, bs); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidXDS, err)
+	}
+	return ValidateMessages(bs)
+}
+
+// ValidateMessages validates the given envoy resources the way the proto validator validates a bootstrap.
+// Cluster references are resolved against the clusters among msgs, including the static clusters of a
+// bootstrap. The returned error wraps ErrInvalidXDS.
+func ValidateMessages(msgs ...proto.Message) error {
+	c := &protoChecker{clusters: map[string]struct{}{}}
+	for _, m := range msgs {
+		c.check(m)
+	}
+	for _, ref := range c.clusterRefs {
+		if _, ok := c.clusters[ref.cluster]; !ok {
+			c.errorf("%s: unknown cluster '%s'", ref.path, ref.cluster)
+		}
+	}
+	if len(c.errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidXDS, errors.Join(c.errs...))
+	}
+	return nil
+}
+
+type clusterRef struct {
+	path    string
+	cluster string
+}
+
+type protoChecker struct {
+	clusters    map[string]struct{}
+	clusterRefs []clusterRef
+	errs        []error
+}
+
+func (c *protoChecker) errorf(format string, args ...any) {
+	c.errs = append(c.errs, fmt.Errorf(format, args...))
+}
+
+// check validates m and the messages it contains. The generated ValidateAll methods validate nested
+// messages but not the content of Any fields, so every Any is unpacked and checked on its own.
+func (c *protoChecker) check(m proto.Message) {
+	if v, ok := m.(interface{ ValidateAll() error }); ok {
+		if err := v.ValidateAll(); err != nil {
+			c.errs = append(c.errs, err)
+		}
+	}
+	c.walk(string(m.ProtoReflect().Descriptor().Name()), m.ProtoReflect(), true)
+}
+
+func (c *protoChecker) walk(path string, m protoreflect.Message, validateClusters bool) {
+	switch msg := m.Interface().(type) {
+	case *anypb.Any:
+		inner, err := msg.UnmarshalNew()
+		if err != nil {
+			c.errorf("%s: unable to resolve %s: %v", path, msg.GetTypeUrl(), err)
+			return
+		}
+		c.check(inner)
+		return
+	case *envoyclusterv3.Cluster:
+		c.clusters[msg.GetName()] = struct{}{}
+	case *envoyroutev3.RouteConfiguration:
+		// envoy only checks the cluster references of a route configuration when validate_clusters is
+		// set, or when it is unset and the routes are static
+		if msg.GetValidateClusters() != nil {
+			validateClusters = msg.GetValidateClusters().GetValue()
+		}
+		c.checkHeadersToRemove(path+".request_headers_to_remove", msg.GetRequestHeadersToRemove())
+	case *envoyroutev3.VirtualHost:
+		c.checkHeadersToRemove(path+".request_headers_to_remove", msg.GetRequestHeadersToRemove())
+	case *envoyroutev3.Route:
+		c.checkHeadersToRemove(path+".request_headers_to_remove", msg.GetRequestHeadersToRemove())
+	case *envoyroutev3.RouteAction:
+		if validateClusters {
+			c.collectClusterRefs(path, msg)
+		}
+	case *envoymatcherv3.RegexMatcher:
+		if _, err := regexp.Compile(msg.GetRegex()); err != nil {
+			c.errorf("%s: invalid regex '%s': %v", path, msg.GetRegex(), err)
+		}
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil {
+			return true
+		}
+		fieldPath := path + "." + string(fd.Name())
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := range list.Len() {
+				elemPath := fmt.Sprintf("%s[%d]", fieldPath, i)
+				c.checkHeaderToAdd(elemPath, m, fd, list.Get(i).Message())
+				c.walk(elemPath, list.Get(i).Message(), validateClusters)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				c.walk(fmt.Sprintf("%s[%s]", fieldPath, k.String()), mv.Message(), validateClusters)
+				return true
+			})
+		default:
+			c.walk(fieldPath, v.Message(), validateClusters)
+		}
+		return true
+	})
+}
+
+func (c *protoChecker) collectClusterRefs(path string, action *envoyroutev3.RouteAction) {
+	if cluster := action.GetCluster(); cluster != "" {
+		c.clusterRefs = append(c.clusterRefs, clusterRef{path: path + ".cluster", cluster: cluster})
+	}
+	for i, wc := range action.GetWeightedClusters().GetClusters() {
+		if wc.GetName() != "" {
+			c.clusterRefs = append(c.clusterRefs, clusterRef{path: fmt.Sprintf("%s.weighted_clusters.clusters[%d]", path, i), cluster: wc.GetName()})
+		}
+	}
+	for i, mirror := range action.GetRequestMirrorPolicies() {
+		if mirror.GetCluster() != "" {
+			c.clusterRefs = append(c.clusterRefs, clusterRef{path: fmt.Sprintf("%s.request_mirror_policies[%d]", path, i), cluster: mirror.GetCluster()})
+		}
+	}
+}
+
+// checkHeaderToAdd checks the headers added by the route configuration, virtual hosts and routes.
+func (c *protoChecker) checkHeaderToAdd(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, elem protoreflect.Message) {
+	if !strings.HasPrefix(string(parent.Descriptor().FullName()), "envoy.config.route.v3.") ||
+		!strings.HasSuffix(string(fd.Name()), "_headers_to_add") {
+		return
+	}
+	hvo, ok := elem.Interface().(*envoycorev3.HeaderValueOption)
+	if !ok {
+		return
+	}
+	c.checkModifiedHeader(path, hvo.GetHeader().GetKey())
+}
+
+func (c *protoChecker) checkHeadersToRemove(path string, headers []string) {
+	for i, h := range headers {
+		c.checkModifiedHeader(fmt.Sprintf("%s[%d]", path, i), h)
+	}
+}
+
+// checkModifiedHeader mirrors the checks of envoy's HeaderParser: pseudo-headers and the host header
+// may not be modified by routes.
+func (c *protoChecker) checkModifiedHeader(path, name string) {
+	switch {
+	case strings.HasPrefix(name, ":") || strings.EqualFold(name, "host"):
+		c.errorf("%s: ':-prefixed' or host headers may not be modified: '%s'", path, name)
+	case !httpguts.ValidHeaderFieldName(name):
+		c.errorf("%s: invalid header name '%s'", path, name)
+	}
+}
@@ -0,0 +1,175 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// protoTestConfig returns a bootstrap with a single listener routing to the service_foo cluster, with
+// the given HttpConnectionManager stat_prefix and route.
+func protoTestConfig(statPrefix, route string) string {
+	return fmt.Sprintf(`node:
+  id: test-id
+  cluster: test-cluster
+static_resources:
+  listeners:
+    - name: listener_0
+      address:
+        socket_address:
+          address: 0.0.0.0
+          port_value: 10000
+      filter_chains:
+        - filters:
+            - name: envoy.filters.network.http_connection_manager
+              typed_config:
+                "@type": type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                stat_prefix: "%s"
+                http_filters:
+                  - name: envoy.filters.http.router
+                    typed_config:
+                      "@type": type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                route_config:
+                  name: local_route
+                  virtual_hosts:
+                    - name: local_service
+                      domains: ["*"]
+                      routes:
+%s
+  clusters:
+    - name: service_foo
+      connect_timeout: 0.25s
+      type: STATIC
+      lb_policy: ROUND_ROBIN
+      load_assignment:
+        cluster_name: service_foo
+        endpoints:
+          - lb_endpoints:
+              - endpoint:
+                  address:
+                    socket_address:
+                      address: 127.0.0.1
+                      port_value: 8080`, statPrefix, route)
+}
+
+func TestProtoValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		errorMsg string
+	}{
+		{
+			name: "valid configuration",
+			yaml: protoTestConfig("ingress_http", `
+                        - match:
+                            prefix: "/"
+                          route:
+                            cluster: service_foo`),
+		},
+		{
+			name: "unknown field",
+			yaml: protoTestConfig("ingress_http", `
+                        - match:
+                            prefix: "/"
+                          route:
+                            not_a_field: service_foo`),
+			errorMsg: `unknown field "not_a_field"`,
+		},
+		{
+			name: "invalid field in typed_config",
+			yaml: protoTestConfig("", `
+                        - match:
+                            prefix: "/"
+                          route:
+                            cluster: service_foo`),
+			errorMsg: "invalid HttpConnectionManager.StatPrefix",
+		},
+		{
+			name: "invalid regex in route match",
+			yaml: protoTestConfig("ingress_http", `
+                        - match:
+                            safe_regex:
+                              regex: "[[invalid.regex"
+                          route:
+                            cluster: service_foo`),
+			errorMsg: "invalid regex '[[invalid.regex'",
+		},
+		{
+			name: "unknown cluster",
+			yaml: protoTestConfig("ingress_http", `
+                        - match:
+                            prefix: "/"
+                          route:
+                            weighted_clusters:
+                              clusters:
+                                - name: service_foo
+                                  weight: 1
+                                - name: service_bar
+                                  weight: 1`),
+			errorMsg: "unknown cluster 'service_bar'",
+		},
+		{
+			name: "pseudo-header added by route",
+			yaml: protoTestConfig("ingress_http", `
+                        - match:
+                            prefix: "/"
+                          route:
+                            cluster: service_foo
+                          request_headers_to_add:
+                            - header:
+                                key: ":authority"
+                                value: example.com`),
+			errorMsg: "':-prefixed' or host headers may not be modified: ':authority'",
+		},
+		{
+			name: "invalid header removed by route",
+			yaml: protoTestConfig("ingress_http", `
+                        - match:
+                            prefix: "/"
+                          route:
+                            cluster: service_foo
+                          request_headers_to_remove:
+                            - "x bad"`),
+			errorMsg: "invalid header name 'x bad'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewProto().Validate(context.Background(), tt.yaml)
+			if tt.errorMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidXDS)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+		})
+	}
+}
+
+type funcValidator func(context.Context, string) error
+
+func (f funcValidator) Validate(ctx context.Context, yaml string) error {
+	return f(ctx, yaml)
+}
+
+func TestChainValidator_Validate(t *testing.T) {
+	var calls []string
+	validator := func(name string, err error) Validator {
+		return funcValidator(func(context.Context, string) error {
+			calls = append(calls, name)
+			return err
+		})
+	}
+
+	require.NoError(t, NewChain(validator("a", nil), validator("b", nil)).Validate(context.Background(), ""))
+	assert.Equal(t, []string{"a", "b"}, calls)
+
+	calls = nil
+	err := NewChain(validator("a", ErrInvalidXDS), validator("b", nil)).Validate(context.Background(), "")
+	require.ErrorIs(t, err, ErrInvalidXDS)
+	assert.Equal(t, []string{"a"}, calls)
+}
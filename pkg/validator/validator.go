@@ -8,6 +8,8 @@ import (
 	"os/exec"
 	"slices"
 	"strings"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 )
 
 var (
@@ -132,3 +134,53 @@ func extractEnvoyError(stderr string) string {
 	}
 	return strings.Join(remainingLines, " ")
 }
+
+// chainValidator runs validators in order.
+type chainValidator struct {
+	validators []Validator
+}
+
+var _ Validator = &chainValidator{}
+
+// NewChain creates a validator that runs the given validators in order, and returns the error of the
+// first one that rejects the config. Cheap validators should come first so that obviously invalid
+// config does not reach the expensive ones. Without validators, the binary validator is used, so that
+// the config is never accepted unvalidated.
+func NewChain(validators ...Validator) Validator {
+	switch len(validators) {
+	case 0:
+		return NewBinary()
+	case 1:
+		return validators[0]
+	}
+	return &chainValidator{validators: validators}
+}
+
+func (c *chainValidator) Validate(ctx context.Context, yaml string) error {
+	for _, v := range c.validators {
+		if err := v.Validate(ctx, yaml); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// New creates a validator chaining the given kinds of validators, in order. Without kinds, the binary
+// validator is used. If envoyPath is empty, the binary validator uses the default path.
+func New(kinds []apisettings.XdsValidator, envoyPath ...string) Validator {
+	validators := make([]Validator, 0, len(kinds))
+	for _, kind := range kinds {
+		switch kind {
+		case apisettings.XdsValidatorBinary:
+			validators = append(validators, NewBinary(envoyPath...))
+		case apisettings.XdsValidatorDocker:
+			validators = append(validators, NewDocker())
+		case apisettings.XdsValidatorProto:
+			validators = append(validators, NewProto())
+		}
+	}
+	if len(validators) == 0 {
+		validators = append(validators, NewBinary(envoyPath...))
+	}
+	return NewChain(validators...)
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 )
 
 func TestBinaryValidator_Validate(t *testing.T) {
@@ -311,3 +313,13 @@ func createMockBinary(t *testing.T, script string) string {
 
 	return mockPath
 }
+
+func TestNew(t *testing.T) {
+	assert.Equal(t, NewProto(), New([]apisettings.XdsValidator{apisettings.XdsValidatorProto}))
+	assert.Equal(t, NewChain(NewProto(), NewBinary("/bin/envoy")),
+		New([]apisettings.XdsValidator{apisettings.XdsValidatorProto, apisettings.XdsValidatorBinary}, "/bin/envoy"))
+
+	// without validators, the config is validated by the binary validator rather than accepted
+	assert.Equal(t, NewBinary("/bin/envoy"), New(nil, "/bin/envoy"))
+	assert.Equal(t, NewBinary(), NewChain())
+}
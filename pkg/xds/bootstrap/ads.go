@@ -0,0 +1,15 @@
+package bootstrap
+
+import (
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+)
+
+// AdsApiType returns the API type of the ADS config source of the proxy bootstrap: DELTA_GRPC for
+// incremental xDS, where only the resources that changed are sent on each update, or GRPC for
+// state-of-the-world xDS.
+func AdsApiType(delta bool) envoycorev3.ApiConfigSource_ApiType {
+	if delta {
+		return envoycorev3.ApiConfigSource_DELTA_GRPC
+	}
+	return envoycorev3.ApiConfigSource_GRPC
+}
@@ -0,0 +1,80 @@
+import json
+from dataclasses import dataclass
+from typing import Optional
+
+
+@dataclass
+class Embeddings:
+    host: str
+    port: int
+    path: str
+    auth_header: str
+    auth_token: str
+    auth_token_prefix: str = ""
+    model: Optional[str] = None
+
+    @staticmethod
+    def from_json(data: dict) -> "Embeddings":
+        return Embeddings(
+            host=data.get("host", ""),
+            port=data.get("port", 443),
+            path=data.get("path", "/v1/embeddings"),
+            auth_header=data.get("authHeader", "Authorization"),
+            auth_token=data.get("authToken", ""),
+            auth_token_prefix=data.get("authTokenPrefix", ""),
+            model=data.get("model"),
+        )
+
+    def url(self) -> str:
+        scheme = "https" if self.port == 443 else "http"
+        return f"{scheme}://{self.host}:{self.port}{self.path}"
+
+    def auth_header_value(self) -> str:
+        return self.auth_token_prefix + self.auth_token
+
+
+@dataclass
+class Redis:
+    host: str
+    port: int
+
+    @staticmethod
+    def from_json(data: dict) -> "Redis":
+        return Redis(host=data.get("host", ""), port=data.get("port", 6379))
+
+
+@dataclass
+class SemanticCache:
+    embeddings: Embeddings
+    redis: Redis
+    similarity_threshold: int = 95
+
+    @staticmethod
+    def from_json(data: dict) -> "SemanticCache":
+        return SemanticCache(
+            embeddings=Embeddings.from_json(data["embeddings"]),
+            redis=Redis.from_json(data["redis"]),
+            similarity_threshold=data.get("similarityThreshold", 95),
+        )
+
+
+@dataclass
+class Cache:
+    policy: str = ""
+    ttl_seconds: int = 3600
+    semantic: Optional[SemanticCache] = None
+
+
+def cache_from_json(data: str) -> Cache:
+    cache_data = json.loads(data)
+
+    semantic_data = cache_data.get("semantic")
+    semantic = None
+    if semantic_data:
+        semantic = SemanticCache.from_json(semantic_data)
+
+    return Cache(
+        policy=cache_data.get("policy", ""),
+        ttl_seconds=cache_data.get("ttlSeconds", 3600),
+        semantic=semantic,
+    )
@@ -21,16 +21,6 @@ class CustomResponse:
         )
 
 
-@dataclass
-class RegexMatch:
-    pattern: Optional[str] = None
-    name: Optional[str] = None
-
-    @staticmethod
-    def from_json(data: dict) -> "RegexMatch":
-        return RegexMatch(pattern=data.get("pattern"), name=data.get("name"))
-
-
 class BuiltIn(Enum):
     SSN = "SSN"
     CREDIT_CARD = "CREDIT_CARD"
@@ -41,6 +31,28 @@ class BuiltIn(Enum):
 class Action(Enum):
     MASK = "MASK"
     REJECT = "REJECT"
+    LOG = "LOG"
+
+
+@dataclass
+class RegexMatch:
+    pattern: Optional[str] = None
+    name: Optional[str] = None
+    # The other built-ins are translated to patterns by the control plane
+    builtin: Optional[BuiltIn] = None
+    # Overrides the action of the regex for this match
+    action: Optional[Action] = None
+
+    @staticmethod
+    def from_json(data: dict) -> "RegexMatch":
+        builtin = data.get("builtin")
+        action = data.get("action")
+        return RegexMatch(
+            pattern=data.get("pattern"),
+            name=data.get("name"),
+            builtin=BuiltIn[builtin] if builtin else None,
+            action=Action(action) if action else None,
+        )
 
 
 @dataclass
@@ -0,0 +1,282 @@
+import hashlib
+import json
+import logging
+import time
+
+from array import array
+from collections import OrderedDict
+from dataclasses import dataclass
+
+import httpx
+import redis.asyncio as aioredis
+
+from api.kgateway.policy.ai import cache as cache_api
+
+logger = logging.getLogger().getChild("kgateway-ai-ext.cache")
+
+# Upper bound of entries kept by the exact match cache of a single AI extension instance
+DEFAULT_MAX_ENTRIES = 1000
+
+SEMANTIC_INDEX_PREFIX = "kgateway-ai-cache"
+
+
+def normalize_content(content: str) -> str:
+    """
+    Normalize the content of a prompt message so that prompts that only differ
+    in whitespace or letter case share the same cache entry.
+    """
+    return " ".join(content.split()).lower()
+
+
+# Request fields that change the generated response, besides the model and the messages.
+# Two requests only share a cache entry if all of these fields are equal.
+GENERATION_FIELDS = (
+    # OpenAI and compatible providers
+    "tools",
+    "tool_choice",
+    "parallel_tool_calls",
+    "functions",
+    "function_call",
+    "temperature",
+    "top_p",
+    "max_tokens",
+    "max_completion_tokens",
+    "response_format",
+    "n",
+    "seed",
+    "stop",
+    "presence_penalty",
+    "frequency_penalty",
+    "logit_bias",
+    "logprobs",
+    "top_logprobs",
+    "reasoning_effort",
+    # Anthropic
+    "top_k",
+    "stop_sequences",
+    "thinking",
+    # Gemini and Vertex AI
+    "generationConfig",
+    "toolConfig",
+    "safetySettings",
+)
+
+
+def generation_params(body: dict) -> dict:
+    """
+    generation_params returns the fields of the request body that change the generated
+    response, besides the model and the messages.
+    """
+    return {field: body[field] for field in GENERATION_FIELDS if field in body}
+
+
+def cache_scope(policy: str, route: str, backend: str) -> str:
+    """
+    cache_scope returns the scope of cached responses, so that a response is only served
+    for requests of the same policy, route and backend that it was cached for.
+    """
+    return f"{policy}|{route}|{backend}"
+
+
+def cache_key(
+    scope: str,
+    llm_provider: str,
+    model: str,
+    messages: list[tuple[str, str]],
+    params: dict,
+) -> str:
+    """
+    cache_key returns the exact match key of a prompt, which is the hash of the cache scope,
+    the provider, the requested model, the generation parameters and the normalized prompt messages.
+    """
+    normalized = [
+        [role.lower(), normalize_content(content)] for role, content in messages
+    ]
+    data = json.dumps(
+        {
+            "scope": scope,
+            "llm": llm_provider,
+            "model": model,
+            "params": params,
+            "messages": normalized,
+        },
+        sort_keys=True,
+    )
+    return hashlib.sha256(data.encode("utf-8")).hexdigest()
+
+
+def semantic_tag(scope: str, llm_provider: str, model: str, params: dict) -> str:
+    """
+    semantic_tag scopes semantic cache entries to a cache scope, provider, model and
+    generation parameters, as only the prompt may differ between similar requests. It is
+    hex encoded so that it never needs to be escaped in a RediSearch tag query.
+    """
+    data = json.dumps(
+        {"scope": scope, "llm": llm_provider, "model": model, "params": params},
+        sort_keys=True,
+    )
+    return hashlib.sha256(data.encode("utf-8")).hexdigest()[:16]
+
+
+@dataclass
+class CachedResponse:
+    body: bytes
+    expires_at: float
+
+
+class ExactCache:
+    """
+    ExactCache is an in-memory LRU cache of responses keyed by cache_key().
+    Entries expire after the TTL of the policy that stored them. There is one
+    ExactCache per cache scope, so that routes cannot evict each other's entries.
+    """
+
+    def __init__(self, max_entries: int = DEFAULT_MAX_ENTRIES):
+        self._entries: OrderedDict[str, CachedResponse] = OrderedDict()
+        self._max_entries = max_entries
+
+    def get(self, key: str, now: float | None = None) -> bytes | None:
+        entry = self._entries.get(key)
+        if entry is None:
+            return None
+        if entry.expires_at <= (now if now is not None else time.monotonic()):
+            del self._entries[key]
+            return None
+        self._entries.move_to_end(key)
+        return entry.body
+
+    def set(self, key: str, body: bytes, ttl_seconds: int, now: float | None = None):
+        now = now if now is not None else time.monotonic()
+        self._entries[key] = CachedResponse(body=body, expires_at=now + ttl_seconds)
+        self._entries.move_to_end(key)
+        while len(self._entries) > self._max_entries:
+            self._entries.popitem(last=False)
+
+    def __len__(self) -> int:
+        return len(self._entries)
+
+
+async def create_embedding(
+    embeddings: cache_api.Embeddings, text: str, timeout: float = 5.0
+) -> list[float]:
+    """
+    create_embedding calls an OpenAI compatible embeddings endpoint for the given text.
+    """
+    body: dict = {"input": text}
+    if embeddings.model:
+        body["model"] = embeddings.model
+    async with httpx.AsyncClient(timeout=timeout) as client:
+        response = await client.post(
+            url=embeddings.url(),
+            headers={embeddings.auth_header: embeddings.auth_header_value()},
+            json=body,
+        )
+        response.raise_for_status()
+        return response.json()["data"][0]["embedding"]
+
+
+class SemanticStore:
+    """
+    SemanticStore keeps the embeddings of cached prompts and their responses in Redis,
+    and finds the response of the most similar prompt with a RediSearch KNN query.
+    """
+
+    def __init__(self, config: cache_api.SemanticCache, client=None):
+        self._config = config
+        self._client = client or aioredis.Redis(
+            host=config.redis.host, port=config.redis.port
+        )
+        # The index is specific to the embeddings model, as embeddings of different
+        # models cannot be compared.
+        embeddings_id = hashlib.sha256(
+            f"{config.embeddings.host}{config.embeddings.path}{config.embeddings.model}".encode(
+                "utf-8"
+            )
+        ).hexdigest()[:16]
+        self._index = f"{SEMANTIC_INDEX_PREFIX}-{embeddings_id}"
+        self._prefix = f"{self._index}:"
+        self._index_dim = 0
+
+    async def _ensure_index(self, dim: int):
+        if self._index_dim == dim:
+            return
+        try:
+            await self._client.execute_command(
+                "FT.CREATE",
+                self._index,
+                "ON",
+                "HASH",
+                "PREFIX",
+                "1",
+                self._prefix,
+                "SCHEMA",
+                "model",
+                "TAG",
+                "embedding",
+                "VECTOR",
+                "HNSW",
+                "6",
+                "TYPE",
+                "FLOAT32",
+                "DIM",
+                str(dim),
+                "DISTANCE_METRIC",
+                "COSINE",
+            )
+        except aioredis.ResponseError as e:
+            if "already exists" not in str(e).lower():
+                raise
+        self._index_dim = dim
+
+    async def get(self, tag: str, embedding: list[float]) -> bytes | None:
+        await self._ensure_index(len(embedding))
+        result = await self._client.execute_command(
+            "FT.SEARCH",
+            self._index,
+            f"(@model:{{{tag}}})=>[KNN 1 @embedding $vec AS score]",
+            "PARAMS",
+            "2",
+            "vec",
+            array("f", embedding).tobytes(),
+            "SORTBY",
+            "score",
+            "RETURN",
+            "2",
+            "response",
+            "score",
+            "LIMIT",
+            "0",
+            "1",
+            "DIALECT",
+            "2",
+        )
+        # The result is [total, key, [field, value, ...]]
+        if not result or result[0] == 0 or len(result) < 3:
+            return None
+        fields = result[2]
+        values = {
+            (k.decode("utf-8") if isinstance(k, bytes) else k): v
+            for k, v in zip(fields[::2], fields[1::2])
+        }
+        if "response" not in values or "score" not in values:
+            return None
+        # The cosine distance is between 0 and 2, where 0 means identical
+        similarity = 1 - float(values["score"])
+        if similarity * 100 < self._config.similarity_threshold:
+            return None
+        return values["response"]
+
+    async def set(
+        self, tag: str, key: str, embedding: list[float], body: bytes, ttl_seconds: int
+    ):
+        await self._ensure_index(len(embedding))
+        redis_key = f"{self._prefix}{key}"
+        await self._client.hset(
+            redis_key,
+            mapping={
+                "model": tag,
+                "embedding": array("f", embedding).tobytes(),
+                "response": body,
+            },
+        )
+        await self._client.expire(redis_key, ttl_seconds)
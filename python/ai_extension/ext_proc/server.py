@@ -18,6 +18,7 @@ import signal
 import grpc
 import gzip
 
+from telemetry import stats
 from telemetry.stats import Config as StatsConfig
 import telemetry.attributes as ai_attributes
 from telemetry.tracing import (
@@ -27,7 +28,7 @@ from telemetry.tracing import (
     RejectResult,
 )
 from .stream import Handler as StreamHandler
-from guardrails.regex import RegexRejection
+from guardrails.regex import Detection, RegexRejection
 
 from openai import AsyncOpenAI as OpenAIClient
 from google.protobuf import struct_pb2 as struct_pb2
@@ -38,7 +39,18 @@ from grpc_health.v1 import health_pb2_grpc
 
 from api.envoy.service.ext_proc.v3 import external_processor_pb2
 from api.envoy.service.ext_proc.v3 import external_processor_pb2_grpc
+from api.envoy.config.core.v3 import base_pb2
 from api.kgateway.policy.ai import prompt_guard
+from api.kgateway.policy.ai import cache as cache_api
+from cache.response_cache import (
+    ExactCache,
+    SemanticStore,
+    cache_key,
+    cache_scope,
+    create_embedding,
+    generation_params,
+    semantic_tag,
+)
 from util.proto import (
     extproc_clear_request_body,
     extproc_clear_response_body,
@@ -78,6 +90,12 @@ logger = logging.getLogger().getChild("kgateway-ai-ext.external_processor")
 
 llm_label_name: Final[str] = "llm"
 model_label_name: Final[str] = "model"
+route_label_name: Final[str] = "route"
+backend_label_name: Final[str] = "backend"
+cost_type_label_name: Final[str] = "type"
+detection_label_name: Final[str] = "detection"
+action_label_name: Final[str] = "action"
+direction_label_name: Final[str] = "direction"
 
 ai_stat_namespace: Final[str] = "ai"
 
@@ -87,6 +105,10 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
     _completion_tokens_ctr: Counter
     _rate_limited_tokens_ctr: Counter
     _exception_raised: Counter
+    _cache_hits_ctr: Counter
+    _cache_misses_ctr: Counter
+    _cost_ctr: Counter
+    _pii_detections_ctr: Counter
     _webhook_req_time_sec: Histogram
     _stats_config: StatsConfig
 
@@ -97,9 +119,18 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
     ):
         self._req_guard: dict[str, list[EntityRecognizer]] = {}
         self._resp_guard: dict[str, list[EntityRecognizer]] = {}
+        # Exact match caches by cache scope, see cache_scope()
+        self._exact_caches: dict[str, ExactCache] = {}
+        self._semantic_stores: dict[str, SemanticStore] = {}
         self._stats_config = stats_config
 
-        labels = [llm_label_name, model_label_name]
+        labels = [
+            llm_label_name,
+            model_label_name,
+            route_label_name,
+            backend_label_name,
+            stats.tenant_label_name,
+        ]
 
         for custom_label in stats_config.custom_labels:
             labels.append(custom_label.name)
@@ -126,6 +157,31 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
             ai_stat_namespace,
         )
 
+        self._cache_hits_ctr = Counter(
+            "cache_hits",
+            "Requests served from the response cache",
+            labels,
+            ai_stat_namespace,
+        )
+        self._cache_misses_ctr = Counter(
+            "cache_misses",
+            "Requests not found in the response cache",
+            labels,
+            ai_stat_namespace,
+        )
+        self._cost_ctr = Counter(
+            "cost",
+            "Estimated cost of the tokens, based on the pricing of the model",
+            labels + [cost_type_label_name],
+            ai_stat_namespace,
+        )
+        self._pii_detections_ctr = Counter(
+            "pii_detections",
+            "Prompt guard regex matches",
+            labels + [detection_label_name, action_label_name, direction_label_name],
+            ai_stat_namespace,
+        )
+
     @contextmanager
     def _set_remote_context(self, servicer_context):
         metadata = servicer_context.invocation_metadata()
@@ -233,6 +289,10 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                             ),
                             request.response_headers,
                         )
+                        status = get_http_header(
+                            request.response_headers.headers, ":status"
+                        )
+                        handler.resp.status = int(status) if status.isdigit() else 0
                         # calling this after set_headers will avoid parsing the content-type header again
                         handler.resp.is_streaming = (
                             handler.provider.is_streaming_response(
@@ -326,15 +386,34 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
             if guardrails_obj.webhook:
                 handler.resp_webhook = guardrails_obj.webhook
             if guardrails_obj.regex:
+                # responses are never rejected, so only the LOG action is used
+                if guardrails_obj.regex.action == prompt_guard.Action.LOG:
+                    handler.resp_regex_action = prompt_guard.Action.LOG
                 if config_hash in self._resp_guard:
                     handler.resp_regex = self._resp_guard.get(config_hash)
                     logger.debug("reusing cached response regex")
                 else:
-                    recognizers = init_presidio_config(guardrails_obj.regex)
+                    recognizers = init_presidio_config(
+                        guardrails_obj.regex, allow_reject=False
+                    )
                     handler.resp_regex = recognizers
                     if handler.resp_regex is not None:
                         self._resp_guard[config_hash] = handler.resp_regex
 
+        if (models := metadict.get("x-llm-models", "")) != "":
+            handler.models = json.loads(models)
+
+        if (cache_config := metadict.get("x-cache-config", "")) != "":
+            handler.cache = cache_api.cache_from_json(cache_config)
+            config_hash = metadict.get("x-cache-config-hash", "")
+            if handler.cache.semantic:
+                if config_hash in self._semantic_stores:
+                    handler.cache_store = self._semantic_stores[config_hash]
+                    logger.debug("reusing semantic cache store")
+                else:
+                    handler.cache_store = SemanticStore(handler.cache.semantic)
+                    self._semantic_stores[config_hash] = handler.cache_store
+
         return handler
 
     def handle_request_headers(
@@ -348,6 +427,13 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         auth_header = get_http_header(headers.headers, "authorization").removeprefix(
             "Bearer "
         )
+        handler.cache_bypass = "no-cache" in get_http_header(
+            headers.headers, "cache-control"
+        )
+        if self._stats_config.tenant and self._stats_config.tenant.header:
+            handler.tenant = self._stats_config.tenant.label_value(
+                get_http_header(headers.headers, self._stats_config.tenant.header)
+            )
 
         return external_processor_pb2.ProcessingResponse(
             dynamic_metadata=struct_pb2.Struct(
@@ -472,6 +558,8 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                 return error_response(
                     handler.req_custom_response, "Rejected by guardrails regex", e
                 )
+            finally:
+                self.record_detections(handler, handler.req_detections, "request")
 
     async def handle_request_body(
         self,
@@ -493,6 +581,17 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
             )
 
             body = body_jsn
+            if (
+                handler.models is not None
+                and handler.request_model not in handler.models
+            ):
+                return error_response(
+                    prompt_guard.CustomResponse(
+                        message=f"model {handler.request_model} not found",
+                        status_code=404,
+                    ),
+                    "Model not found",
+                )
             operation_name = handler.get_operation_name()
 
             tracer = OtelTracer.get()
@@ -550,25 +649,43 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                         moderation_span.set_attribute(
                             ai_attributes.AI_MODERATION_FLAGGED, False
                         )
+                if handler.cache and (
+                    cache_resp := await self.handle_request_body_cache(
+                        body, handler, gen_ai_client_span
+                    )
+                ):
+                    return cache_resp
+
                 # currently we only count the prompt token for ratelimiting. So,
                 # this is only set here. If we change to count completion token as well
                 # will need to add those into rate_limited_tokens for stats purpose.
                 handler.rate_limited_tokens = tokens
-            return external_processor_pb2.ProcessingResponse(
-                dynamic_metadata=struct_pb2.Struct(
-                    # increment tokens for rate limiting
-                    fields={
-                        "envoy.ratelimit": struct_pb2.Value(
-                            struct_value=struct_pb2.Struct(
-                                fields={
-                                    "hits_addend": struct_pb2.Value(
-                                        number_value=float(tokens),
-                                    )
-                                }
+            dynamic_metadata = {}
+            if not handler.token_rate_limited:
+                # increment tokens for rate limiting. Routes rate limited on the total tokens are
+                # debited once the response completes instead.
+                dynamic_metadata["envoy.ratelimit"] = struct_pb2.Value(
+                    struct_value=struct_pb2.Struct(
+                        fields={
+                            "hits_addend": struct_pb2.Value(
+                                number_value=float(tokens),
                             )
-                        )
-                    },
-                ),
+                        }
+                    )
+                )
+            if handler.models is not None:
+                # select the upstream endpoints serving the requested model
+                dynamic_metadata["envoy.lb"] = struct_pb2.Value(
+                    struct_value=struct_pb2.Struct(
+                        fields={
+                            "ai_model": struct_pb2.Value(
+                                string_value=handler.request_model
+                            )
+                        }
+                    )
+                )
+            return external_processor_pb2.ProcessingResponse(
+                dynamic_metadata=struct_pb2.Struct(fields=dynamic_metadata),
                 request_body=external_processor_pb2.BodyResponse(
                     response=external_processor_pb2.CommonResponse(
                         body_mutation=external_processor_pb2.BodyMutation(
@@ -581,6 +698,120 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         # If it's not end of stream, clear the body so envoy doesn't forward to upstream.
         return extproc_clear_request_body()
 
+    async def handle_request_body_cache(
+        self,
+        body: dict,
+        handler: StreamHandler,
+        parent_span: trace.Span,
+    ) -> external_processor_pb2.ProcessingResponse | None:
+        # Only non-streaming responses are cached
+        if handler.req.is_streaming:
+            return None
+
+        messages: list[tuple[str, str]] = []
+
+        def collect(role: str, content: str) -> str:
+            messages.append((role, content))
+            return content
+
+        handler.provider.iterate_str_req_messages(body=body, cb=collect)
+        if not messages:
+            return None
+        handler.cache_scope = cache_scope(
+            handler.cache.policy, handler.route, handler.backend
+        )
+        params = generation_params(body)
+        handler.cache_key = cache_key(
+            handler.cache_scope,
+            handler.llm_provider,
+            handler.request_model,
+            messages,
+            params,
+        )
+        handler.cache_tag = semantic_tag(
+            handler.cache_scope, handler.llm_provider, handler.request_model, params
+        )
+        handler.cache_prompt = handler.provider.all_req_content(body)
+        if handler.cache_bypass:
+            return None
+
+        with OtelTracer.get().start_as_current_span(
+            "handle_request_body_cache",
+            context=trace.set_span_in_context(parent_span),
+        ) as cache_span:
+            cached = self.exact_cache(handler.cache_scope).get(handler.cache_key)
+            if cached is None and handler.cache_store and handler.cache.semantic:
+                try:
+                    handler.cache_embedding = await create_embedding(
+                        handler.cache.semantic.embeddings, handler.cache_prompt
+                    )
+                    cached = await handler.cache_store.get(
+                        handler.cache_tag,
+                        handler.cache_embedding,
+                    )
+                except Exception as e:
+                    # The cache must never fail the request, fall back to the LLM provider
+                    cache_span.record_exception(e)
+                    logger.error("Error looking up semantic cache, %s", e)
+
+            labels = self.build_labels(handler)
+            if cached is None:
+                increment_counter(self._cache_misses_ctr, labels, 1)
+                return None
+
+            handler.cache_hit = True
+            increment_counter(self._cache_hits_ctr, labels, 1)
+            return external_processor_pb2.ProcessingResponse(
+                immediate_response=external_processor_pb2.ImmediateResponse(
+                    status=dict(code=map_int_to_grpc_status_code(200)),
+                    headers=external_processor_pb2.HeaderMutation(
+                        set_headers=[
+                            base_pb2.HeaderValueOption(
+                                header=base_pb2.HeaderValue(
+                                    key="content-type", raw_value=b"application/json"
+                                )
+                            )
+                        ]
+                    ),
+                    body=cached,
+                    details="Served from the response cache",
+                ),
+                dynamic_metadata=handler.build_metadata(),
+            )
+
+    def exact_cache(self, scope: str) -> ExactCache:
+        if (cache := self._exact_caches.get(scope)) is None:
+            cache = ExactCache()
+            self._exact_caches[scope] = cache
+        return cache
+
+    async def store_response_cache(self, handler: StreamHandler, body: bytes):
+        if (
+            handler.cache is None
+            or handler.cache_key == ""
+            or handler.resp.status != 200
+        ):
+            return
+
+        self.exact_cache(handler.cache_scope).set(
+            handler.cache_key, body, handler.cache.ttl_seconds
+        )
+        if handler.cache_store and handler.cache.semantic:
+            try:
+                if handler.cache_embedding is None:
+                    handler.cache_embedding = await create_embedding(
+                        handler.cache.semantic.embeddings, handler.cache_prompt
+                    )
+                await handler.cache_store.set(
+                    handler.cache_tag,
+                    handler.cache_key,
+                    handler.cache_embedding,
+                    body,
+                    handler.cache.ttl_seconds,
+                )
+            except Exception as e:
+                logger.error("Error storing response in semantic cache, %s", e)
+
     async def handle_response_body_resp_webhook(
         self,
         body: dict,
@@ -774,16 +1005,18 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                                 jsn, handler, non_streaming_span
                             )
 
+                        resp_bytes = json.dumps(jsn).encode("utf-8")
+                        if handler.cache and not has_function_call_resp:
+                            await self.store_response_cache(handler, resp_bytes)
+
                         return external_processor_pb2.ProcessingResponse(
                             response_body=external_processor_pb2.BodyResponse(
                                 response=external_processor_pb2.CommonResponse(
                                     body_mutation=external_processor_pb2.BodyMutation(
                                         body=(
-                                            gzip.compress(
-                                                json.dumps(jsn).encode("utf-8")
-                                            )
+                                            gzip.compress(resp_bytes)
                                             if handler.content_encoding == "gzip"
-                                            else json.dumps(jsn).encode("utf-8")
+                                            else resp_bytes
                                         ),
                                     ),
                                 )
@@ -791,23 +1024,86 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                             dynamic_metadata=self.build_dynamic_meta(handler),
                         )
 
-    def build_dynamic_meta(self, handler: StreamHandler) -> struct_pb2.Struct:
+    def build_labels(self, handler: StreamHandler) -> dict[str, str]:
         labels = handler.extra_labels.copy()
         labels[llm_label_name] = handler.llm_provider
         labels[model_label_name] = handler.request_model
+        labels[route_label_name] = handler.route
+        labels[backend_label_name] = handler.backend
+        labels[stats.tenant_label_name] = handler.tenant
+        return labels
+
+    def build_dynamic_meta(self, handler: StreamHandler) -> struct_pb2.Struct:
+        labels = self.build_labels(handler)
 
         tokens = handler.get_tokens()
+        if handler.token_rate_limited:
+            # the rate limit is debited with the total tokens reported in the metadata
+            handler.rate_limited_tokens = tokens.total_tokens()
         increment_counter(self._completion_tokens_ctr, labels, tokens.completion)
         increment_counter(self._prompt_tokens_ctr, labels, tokens.prompt)
         increment_counter(
             self._rate_limited_tokens_ctr, labels, handler.rate_limited_tokens
         )
 
+        if pricing := self._stats_config.get_pricing(
+            handler.llm_provider,
+            [handler.get_response_model(), handler.request_model],
+        ):
+            handler.input_cost, handler.output_cost = pricing.cost(
+                tokens.prompt, tokens.completion
+            )
+            increment_counter(
+                self._cost_ctr,
+                {**labels, cost_type_label_name: "input"},
+                handler.input_cost,
+            )
+            increment_counter(
+                self._cost_ctr,
+                {**labels, cost_type_label_name: "output"},
+                handler.output_cost,
+            )
+
+        self.record_detections(
+            handler,
+            handler.resp_detections + handler.stream_chunks.detections,
+            "response",
+        )
+
         return handler.build_metadata()
 
+    def record_detections(
+        self, handler: StreamHandler, detections: list[Detection], direction: str
+    ):
+        """
+        record_detections counts the regex matches, and logs the ones with the LOG action.
+        """
+        if not detections:
+            return
+        labels = self.build_labels(handler)
+        for detection in detections:
+            if detection.action == prompt_guard.Action.LOG:
+                logger.info(
+                    "prompt guard regex match",
+                    extra={
+                        "detection": detection.name,
+                        "direction": direction,
+                        "route": handler.route,
+                    },
+                )
+            increment_counter(
+                self._pii_detections_ctr,
+                {
+                    **labels,
+                    detection_label_name: detection.name,
+                    action_label_name: detection.action.value,
+                    direction_label_name: direction,
+                },
+                1,
+            )
+
     def increment_exception_raised(self, handler: StreamHandler):
-        labels = handler.extra_labels.copy()
-        labels[llm_label_name] = handler.llm_provider
+        labels = self.build_labels(handler)
         labels[model_label_name] = (
             handler.request_model
             if handler.request_model
@@ -822,7 +1118,9 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
 
 # Function to increment a counter and log any errors rather than
 # stopping the request/response logic flow.
-def increment_counter(counter: Counter, labels: dict[str, str], value: int):
+def increment_counter(
+    counter: Counter, labels: dict[str, str], value: int | float
+):
     try:
         counter.labels(**labels).inc(value)
     except ValueError as e:
@@ -22,13 +22,15 @@ from google.protobuf import struct_pb2 as struct_pb2
 from api.envoy.service.ext_proc.v3 import external_processor_pb2
 from api.envoy.config.core.v3 import base_pb2 as base_pb2
 from api.kgateway.policy.ai import prompt_guard
+from api.kgateway.policy.ai import cache as cache_api
+from cache.response_cache import SemanticStore
 from presidio_analyzer import EntityRecognizer
 from presidio_anonymizer import AnonymizerEngine
 from dataclasses import dataclass, field
 from openai.resources import AsyncModerations
 from ext_proc.streamchunks import StreamChunks
 from util.http import parse_content_type
-from guardrails.regex import regex_transform
+from guardrails.regex import Detection, regex_transform
 from opentelemetry.semconv._incubating.attributes import gen_ai_attributes
 from opentelemetry.util.types import Attributes
 
@@ -65,6 +67,12 @@ class Info:
     This is set while we are looping through the header in set_header()
     """
 
+    status: int = 0
+    """
+    status is the response status code from the :status pseudo header.
+    This is only set for the response, after we handled response_headers.
+    """
+
     def append(self, data: bytes):
         """
         Append data to the body of the Info object.
@@ -119,11 +127,22 @@ class Handler:
     req_moderation: tuple[AsyncModerations, str] | None = None
     req_custom_response: prompt_guard.CustomResponse | None = None
     resp_regex: list[EntityRecognizer] | None = None
+    resp_regex_action: prompt_guard.Action = prompt_guard.Action.MASK
+    # The regex matches found in the request and the non-streaming response
+    req_detections: list[Detection] = field(default_factory=list)
+    resp_detections: list[Detection] = field(default_factory=list)
     anon: AnonymizerEngine = field(default_factory=AnonymizerEngine)
     req: Info = field(default_factory=Info)
     resp: Info = field(default_factory=Info)
     stream_chunks: StreamChunks = field(default_factory=StreamChunks)
     extra_labels: dict[str, str] = field(default_factory=dict)
+    # The route and the backend of the request, used to attribute usage and cost
+    route: str = ""
+    backend: str = ""
+    tenant: str = stats.unknown_tenant
+    # The estimated cost of the request, set on the response path when the model has a pricing
+    input_cost: float | None = None
+    output_cost: float | None = None
     _tokens: Tokens = field(default_factory=Tokens)
     """
         Tokens for non-streaming response. streaming response token is stored 
@@ -136,6 +155,9 @@ class Handler:
     # It is then used on the response path to increment the rate_limited_tokens counter
     # when we've received the exact model used by the backend.
     rate_limited_tokens: int = 0
+    # Set when the route rate limits on the total tokens reported in the response metadata. The prompt
+    # tokens are then not added to the rate limit on the request path, so they are not counted twice.
+    token_rate_limited: bool = False
     # The request model is set on the request path, this is the model as specified by the user
     request_model: str = ""
     _response_model: str = ""
@@ -148,6 +170,26 @@ class Handler:
 
     content_encoding = ""
 
+    models: list[str] | None = None
+    """
+    models is the list of client-facing models served by the backend. When set, the request is
+    routed to the providers serving the requested model and rejected if no provider serves it.
+    """
+
+    cache: cache_api.Cache | None = None
+    cache_store: SemanticStore | None = None
+    cache_bypass: bool = False
+    """
+    cache_bypass is set when the client sends the `Cache-Control: no-cache` header. The response is
+    still stored in the cache, but the cache is not used to serve the request.
+    """
+    cache_scope: str = ""
+    cache_key: str = ""
+    cache_tag: str = ""
+    cache_prompt: str = ""
+    cache_embedding: list[float] | None = None
+    cache_hit: bool = False
+
     _is_function_calling_response: bool = False
     """
     This boolean indicate if the response is a function calling response. For non-streaming response, 
@@ -207,6 +249,9 @@ class Handler:
             handler = Handler(
                 logger=sub_logger, provider=OpenAI(), llm_provider=llm_provider
             )
+        handler.route = metadict.get("x-ai-route", "")
+        handler.backend = metadict.get("x-ai-backend", "")
+        handler.token_rate_limited = metadict.get("x-token-rate-limit", "") == "true"
         return handler
 
     def build_metadata(self) -> struct_pb2.Struct:
@@ -238,11 +283,18 @@ class Handler:
                             "streaming": struct_pb2.Value(
                                 bool_value=self.resp.is_streaming
                             ),
+                            "cache_hit": struct_pb2.Value(bool_value=self.cache_hit),
+                            "tenant": struct_pb2.Value(string_value=self.tenant),
                         }
                     )
                 )
             },
         )
+        if self.input_cost is not None and self.output_cost is not None:
+            fields = dynamic_meta.fields["ai.kgateway.io"].struct_value.fields
+            fields["input_cost"].number_value = self.input_cost
+            fields["output_cost"].number_value = self.output_cost
+            fields["total_cost"].number_value = self.input_cost + self.output_cost
         return dynamic_meta
 
     def increment_tokens(self, jsn: dict):
@@ -256,10 +308,23 @@ class Handler:
             self.extra_labels[custom_label.name] = custom_label.get_field(
                 meta.filter_metadata.get_or_create(custom_label.metadata_namespace)
             )
+        if stats_config.tenant and (
+            tenant_label := stats_config.tenant.metadata_label()
+        ):
+            tenant = tenant_label.get_field(
+                meta.filter_metadata.get_or_create(tenant_label.metadata_namespace)
+            )
+            if tenant != "<unknown>":
+                self.tenant = stats_config.tenant.label_value(tenant)
 
     def req_regex_transform(self, role: str, content: str) -> str:
         return regex_transform(
-            role, content, self.req_regex, self.anon, self.req_regex_action
+            role,
+            content,
+            self.req_regex,
+            self.anon,
+            self.req_regex_action,
+            self.req_detections,
         )
 
     def resp_regex_transform(self, role: str, content: str) -> str:
@@ -268,6 +333,8 @@ class Handler:
             content,
             self.resp_regex,
             self.anon,
+            self.resp_regex_action,
+            self.resp_detections,
         )
 
     def get_operation_name(self) -> str:
@@ -18,7 +18,7 @@ from presidio_analyzer import EntityRecognizer
 from presidio_anonymizer import AnonymizerEngine
 from telemetry.tracing import OtelTracer
 from typing import Any, Callable, Deque, Dict, List, Tuple
-from guardrails.regex import regex_transform
+from guardrails.regex import Detection, regex_transform
 from guardrails.webhook import call_response_webhook
 from util import sse
 
@@ -245,6 +245,11 @@ class StreamChunks:
         This indicated the stream is completed properly base on the provider specific indicator
         """
 
+        self.detections: List[Detection] = list()
+        """
+        The regex matches found in the response stream
+        """
+
     def get_role(self, choice_index: int) -> str:
         if choice_index < 0 or choice_index >= len(self.__roles):
             return ""
@@ -847,7 +852,13 @@ class StreamChunks:
                 for i, item in enumerate(contents):
                     logger.debug(f"regex: choice_index: {i} content: {item.content}")
                     regex_modified_contents.append(
-                        regex_transform("", item.content, regex, anonymizer_engine)
+                        regex_transform(
+                            "",
+                            item.content,
+                            regex,
+                            anonymizer_engine,
+                            detections=self.detections,
+                        )
                     )
                     if item.content != regex_modified_contents[i]:
                         # as long as there is one choice that got modified, we need to collapse
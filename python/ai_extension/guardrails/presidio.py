@@ -15,31 +15,68 @@ from presidio_analyzer.predefined_recognizers import (
 global_regex_flage = re.DOTALL | re.MULTILINE | re.IGNORECASE
 
 
+def builtin_recognizer(builtin: prompt_guard.BuiltIn) -> EntityRecognizer:
+    match builtin:
+        case prompt_guard.BuiltIn.CREDIT_CARD:
+            return CreditCardRecognizer()
+        case prompt_guard.BuiltIn.SSN:
+            return UsSsnRecognizer()
+        case prompt_guard.BuiltIn.PHONE_NUMBER:
+            return PhoneRecognizer()
+        case prompt_guard.BuiltIn.EMAIL:
+            return EmailRecognizer()
+
+
+def compile_pattern(name: str, regex_pattern: str) -> Pattern:
+    compiled_re = re.compile(regex_pattern, global_regex_flage)
+    pattern = Pattern(
+        name,
+        regex_pattern,
+        1.0,  # Set the score to 1.0 for now, this indicates that a match is always 100% accurate
+    )
+    pattern.compiled_regex = compiled_re  # type: ignore
+    pattern.compiled_with_flags = global_regex_flage  # type: ignore
+    return pattern
+
+
 def init_presidio_config(
     guardrails_regex: prompt_guard.Regex,
+    allow_reject: bool = True,
 ) -> list[EntityRecognizer]:
+    """
+    init_presidio_config returns the recognizers of the regex. Recognizers of matches with
+    their own action have an `action` attribute, and all the recognizers of matches have a
+    `detection_name` attribute used to report detections.
+
+    Responses cannot be rejected, so REJECT actions are replaced with MASK when allow_reject
+    is False.
+    """
     recognizers: list[EntityRecognizer] = []
     compiled_regex: list[Pattern] = []
     for builtin in guardrails_regex.builtins:
-        match builtin:
-            case prompt_guard.BuiltIn.CREDIT_CARD:
-                recognizers.append(CreditCardRecognizer())
-            case prompt_guard.BuiltIn.SSN:
-                recognizers.append(UsSsnRecognizer())
-            case prompt_guard.BuiltIn.PHONE_NUMBER:
-                recognizers.append(PhoneRecognizer())
-            case prompt_guard.BuiltIn.EMAIL:
-                recognizers.append(EmailRecognizer())
+        recognizers.append(builtin_recognizer(builtin))
     for idx, regex_match in enumerate(guardrails_regex.matches):
-        compiled_re = re.compile(regex_match.pattern, global_regex_flage)
-        pattern = Pattern(
-            regex_match.name if regex_match.name != "" else f"regex_{idx}",
-            regex_match.pattern,
-            1.0,  # Set the score to 1.0 for now, this indicates that a match is always 100% accurate
-        )
-        pattern.compiled_regex = compiled_re  # type: ignore
-        pattern.compiled_with_flags = global_regex_flage  # type: ignore
-        compiled_regex.append(pattern)
+        name = regex_match.name if regex_match.name else f"regex_{idx}"
+        if regex_match.action is None and regex_match.builtin is None:
+            compiled_regex.append(compile_pattern(name, regex_match.pattern))
+            continue
+
+        if regex_match.builtin is not None:
+            if not regex_match.name:
+                name = regex_match.builtin.value
+            recognizer = builtin_recognizer(regex_match.builtin)
+        else:
+            recognizer = PatternRecognizer(
+                supported_entity=name,
+                name=name,
+                patterns=[compile_pattern(name, regex_match.pattern)],
+            )
+        action = regex_match.action
+        if action == prompt_guard.Action.REJECT and not allow_reject:
+            action = prompt_guard.Action.MASK
+        recognizer.action = action  # type: ignore
+        recognizer.detection_name = name  # type: ignore
+        recognizers.append(recognizer)
     if len(compiled_regex) > 0:
         recognizer = PatternRecognizer(
             supported_entity="CUSTOM", name="custom", patterns=compiled_regex
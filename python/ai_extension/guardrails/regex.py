@@ -1,27 +1,66 @@
+from dataclasses import dataclass
+
 from api.kgateway.policy.ai import prompt_guard
-from presidio_analyzer import EntityRecognizer
+from presidio_analyzer import EntityRecognizer, RecognizerResult as AnalyzerResult
 from presidio_anonymizer.entities import RecognizerResult
 from presidio_anonymizer import AnonymizerEngine
 
 
+@dataclass
+class Detection:
+    """
+    Detection is a match of a regex, reported in the metrics and logs.
+    """
+
+    name: str
+    action: prompt_guard.Action
+
+
+def detection_name(rule: EntityRecognizer, result: AnalyzerResult) -> str:
+    if name := getattr(rule, "detection_name", None):
+        return name
+    explanation = result.analysis_explanation
+    if explanation is not None and explanation.pattern_name:
+        return explanation.pattern_name
+    return result.entity_type
+
+
 def regex_transform(
     role: str,
     content: str,
     rules: list[EntityRecognizer] | None,
     anon: AnonymizerEngine,
     action: prompt_guard.Action = prompt_guard.Action.MASK,
+    detections: list[Detection] | None = None,
 ) -> str:
+    """
+    regex_transform masks the matches of the rules in the content. Rules with an `action`
+    attribute use it instead of the given action. Matches of LOG rules are only reported in
+    detections, and a RegexRejection is raised if a REJECT rule matches.
+    """
     if rules:
-        matrix = [
-            i.analyze(content, [], nlp_artifacts=None)  # type: ignore
-            for i in rules
-        ]
-        results = [item for row in matrix for item in row]
-        EntityRecognizer.remove_duplicates(results)
+        masked: list[AnalyzerResult] = []
+        rejected: list[AnalyzerResult] = []
+        for rule in rules:
+            rule_action = getattr(rule, "action", None) or action
+            results = rule.analyze(content, [], nlp_artifacts=None)  # type: ignore
+            for result in results:
+                if detections is not None:
+                    detections.append(
+                        Detection(name=detection_name(rule, result), action=rule_action)
+                    )
+            match rule_action:
+                case prompt_guard.Action.REJECT:
+                    rejected.extend(results)
+                case prompt_guard.Action.MASK:
+                    masked.extend(results)
         # if we have results and the action is to reject, raise an error
-        if len(results) > 0 and action == prompt_guard.Action.REJECT:
-            raise RegexRejection(" ".join([str(i) for i in results]))
+        if len(rejected) > 0:
+            raise RegexRejection(" ".join([str(i) for i in rejected]))
 
+        EntityRecognizer.remove_duplicates(masked)
+        if len(masked) == 0:
+            return content
         anonymized = anon.anonymize(
             text=content,
             analyzer_results=[
@@ -31,7 +70,7 @@ def regex_transform(
                     end=i.end,
                     score=i.score,
                 )
-                for i in results
+                for i in masked
             ],
         )
         return anonymized.text
@@ -10,6 +10,12 @@ logger: logging.Logger = logging.getLogger().getChild(
     "kgateway-ai-ext.external_processor"
 )
 
+tenant_label_name = "tenant"
+# The tenant of requests without a tenant value
+unknown_tenant = "unknown"
+# The tenant of requests with a tenant value that is not allowed
+other_tenant = "other"
+
 
 class CustomLabel(BaseModel):
     """
@@ -49,6 +55,75 @@ class CustomLabel(BaseModel):
         return "<unknown>"
 
 
+class ModelPricing(BaseModel):
+    """
+    Represents the price of the tokens of a model, per 1000 tokens.
+    """
+
+    provider: str | None = Field(alias="provider", default=None)
+    model: str = Field(alias="model")
+    input_cost_per_1k: float = Field(alias="inputCostPer1K")
+    output_cost_per_1k: float = Field(alias="outputCostPer1K")
+
+    def cost(self, prompt_tokens: int, completion_tokens: int) -> tuple[float, float]:
+        """cost returns the estimated input and output cost of the given tokens."""
+        return (
+            prompt_tokens * self.input_cost_per_1k / 1000,
+            completion_tokens * self.output_cost_per_1k / 1000,
+        )
+
+
+class TenantMetadata(BaseModel):
+    namespace: str = Field(alias="namespace")
+    key: str = Field(alias="key")
+
+
+class Tenant(BaseModel):
+    """
+    Selects the value identifying the tenant of a request, which is used to attribute
+    token usage and cost.
+    """
+
+    header: str | None = Field(alias="header", default=None)
+    jwt_claim: str | None = Field(alias="jwtClaim", default=None)
+    metadata: TenantMetadata | None = Field(alias="metadata", default=None)
+    allowed_values: list[str] | None = Field(alias="allowedValues", default=None)
+
+    def label_value(self, tenant: str) -> str:
+        """
+        label_value returns the value of the tenant label for the given tenant. Tenants that are
+        not allowed are reported as `other`, so that the cardinality of the metrics stays bounded
+        even if the tenant comes from a value set by clients.
+        """
+        if not tenant:
+            return unknown_tenant
+        if self.allowed_values is not None and tenant not in self.allowed_values:
+            return other_tenant
+        if self.allowed_values is None and self.header is not None:
+            # The API requires an allowlist with a header, never trust a header value without one
+            return other_tenant
+        return tenant
+
+    def metadata_label(self) -> CustomLabel | None:
+        """
+        metadata_label returns the label reading the tenant from the dynamic metadata,
+        or None if the tenant is read from a header.
+        """
+        if self.jwt_claim is not None:
+            return CustomLabel(
+                name=tenant_label_name,
+                metadataNamespace="envoy.filters.http.jwt_authn",
+                metadataKey=f"principal:{self.jwt_claim}",
+            )
+        if self.metadata is not None:
+            return CustomLabel(
+                name=tenant_label_name,
+                metadataNamespace=self.metadata.namespace,
+                metadataKey=self.metadata.key,
+            )
+        return None
+
+
 class Config(BaseModel):
     """
     Python representation of the AI Stats config struct from the Gateway Parameters API.
@@ -56,7 +131,22 @@ class Config(BaseModel):
     https://github.com/kgateway-dev/kgateway/blob/75dca1e66e894325ee1b57db04c0455432228dcf/api/v1alpha1/gateway_parameters_types.go#L668
     """
 
-    custom_labels: list[CustomLabel] = Field(alias="customLabels")
+    custom_labels: list[CustomLabel] = Field(alias="customLabels", default=[])
+    pricing: list[ModelPricing] = Field(alias="pricing", default=[])
+    tenant: Tenant | None = Field(alias="tenant", default=None)
+
+    def get_pricing(self, llm_provider: str, models: list[str]) -> ModelPricing | None:
+        """
+        get_pricing returns the pricing of the first of the given models with a pricing entry.
+        If several entries match a model, the last one is used.
+        """
+        for model in models:
+            if not model:
+                continue
+            for pricing in reversed(self.pricing):
+                if pricing.model == model and pricing.provider in (None, llm_provider):
+                    return pricing
+        return None
 
     @classmethod
     def from_file(cls, file_path: str):
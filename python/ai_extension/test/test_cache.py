@@ -0,0 +1,185 @@
+import asyncio
+import json
+
+from api.kgateway.policy.ai import cache as cache_api
+from cache.response_cache import (
+    ExactCache,
+    SemanticStore,
+    cache_key,
+    cache_scope,
+    generation_params,
+    semantic_tag,
+)
+
+SCOPE = cache_scope("default/policy", "httproute/default/openai/rule/0/match/0", "default/openai")
+
+
+class TestCacheKey:
+    def test_normalized_messages_share_key(self):
+        a = cache_key(
+            SCOPE, "openai", "gpt-4o", [("user", "What is  the capital of France?")], {}
+        )
+        b = cache_key(
+            SCOPE, "openai", "gpt-4o", [("USER", " what is the capital of france? ")], {}
+        )
+        assert a == b
+
+    def test_model_changes_key(self):
+        messages = [("user", "What is the capital of France?")]
+        assert cache_key(SCOPE, "openai", "gpt-4o", messages, {}) != cache_key(
+            SCOPE, "openai", "gpt-4o-mini", messages, {}
+        )
+
+    def test_role_changes_key(self):
+        assert cache_key(SCOPE, "openai", "gpt-4o", [("user", "hi")], {}) != cache_key(
+            SCOPE, "openai", "gpt-4o", [("system", "hi")], {}
+        )
+
+    def test_scope_changes_key(self):
+        messages = [("user", "hi")]
+        other = cache_scope("default/policy", "other-route", "default/openai")
+        assert cache_key(SCOPE, "openai", "gpt-4o", messages, {}) != cache_key(
+            other, "openai", "gpt-4o", messages, {}
+        )
+
+    def test_generation_params_change_key(self):
+        messages = [("user", "What is the weather in Paris?")]
+        base = {"model": "gpt-4o", "messages": [], "stream": False}
+        variants = [
+            {"temperature": 0.2},
+            {"top_p": 0.5},
+            {"max_tokens": 10},
+            {"n": 2},
+            {"response_format": {"type": "json_object"}},
+            {"tool_choice": "required"},
+            {"tools": [{"type": "function", "function": {"name": "weather"}}]},
+        ]
+        keys = {
+            cache_key(SCOPE, "openai", "gpt-4o", messages, generation_params(base))
+        }
+        for variant in variants:
+            params = generation_params({**base, **variant})
+            assert params == variant
+            keys.add(cache_key(SCOPE, "openai", "gpt-4o", messages, params))
+        assert len(keys) == len(variants) + 1
+
+    def test_semantic_tag(self):
+        assert semantic_tag(SCOPE, "openai", "gpt-4o", {}) != semantic_tag(
+            SCOPE, "openai", "gpt-4o", {"temperature": 1}
+        )
+        assert semantic_tag(SCOPE, "openai", "gpt-4o", {}) != semantic_tag(
+            cache_scope("other/policy", "", ""), "openai", "gpt-4o", {}
+        )
+
+
+class TestExactCache:
+    def test_get_set(self):
+        cache = ExactCache()
+        cache.set("key", b"response", ttl_seconds=60, now=0)
+        assert cache.get("key", now=30) == b"response"
+        assert cache.get("missing", now=30) is None
+
+    def test_expiry(self):
+        cache = ExactCache()
+        cache.set("key", b"response", ttl_seconds=60, now=0)
+        assert cache.get("key", now=60) is None
+        assert len(cache) == 0
+
+    def test_evicts_least_recently_used(self):
+        cache = ExactCache(max_entries=2)
+        cache.set("a", b"a", ttl_seconds=60, now=0)
+        cache.set("b", b"b", ttl_seconds=60, now=0)
+        # touch a so that b is the least recently used entry
+        assert cache.get("a", now=1) == b"a"
+        cache.set("c", b"c", ttl_seconds=60, now=2)
+        assert cache.get("b", now=3) is None
+        assert cache.get("a", now=3) == b"a"
+        assert cache.get("c", now=3) == b"c"
+
+
+class TestCacheFromJson:
+    def test_exact(self):
+        cache = cache_api.cache_from_json(
+            json.dumps({"policy": "default/policy", "ttlSeconds": 600})
+        )
+        assert cache.policy == "default/policy"
+        assert cache.ttl_seconds == 600
+        assert cache.semantic is None
+
+    def test_semantic(self):
+        cache = cache_api.cache_from_json(
+            json.dumps(
+                {
+                    "ttlSeconds": 60,
+                    "semantic": {
+                        "embeddings": {
+                            "host": "api.openai.com",
+                            "port": 443,
+                            "path": "/v1/embeddings",
+                            "model": "text-embedding-3-small",
+                            "authHeader": "Authorization",
+                            "authTokenPrefix": "Bearer ",
+                            "authToken": "token",
+                        },
+                        "redis": {"host": "redis", "port": 6379},
+                        "similarityThreshold": 90,
+                    },
+                }
+            )
+        )
+        assert cache.semantic is not None
+        assert cache.semantic.similarity_threshold == 90
+        assert cache.semantic.redis.host == "redis"
+        assert (
+            cache.semantic.embeddings.url() == "https://api.openai.com:443/v1/embeddings"
+        )
+        assert cache.semantic.embeddings.auth_header_value() == "Bearer token"
+
+
+class FakeRedis:
+    def __init__(self, search_result):
+        self.search_result = search_result
+        self.commands = []
+
+    async def execute_command(self, *args):
+        self.commands.append(args)
+        if args[0] == "FT.SEARCH":
+            return self.search_result
+        return b"OK"
+
+
+def semantic_config(threshold: int) -> cache_api.SemanticCache:
+    return cache_api.SemanticCache(
+        embeddings=cache_api.Embeddings(
+            host="api.openai.com",
+            port=443,
+            path="/v1/embeddings",
+            auth_header="Authorization",
+            auth_token="token",
+            auth_token_prefix="Bearer ",
+            model="text-embedding-3-small",
+        ),
+        redis=cache_api.Redis(host="redis", port=6379),
+        similarity_threshold=threshold,
+    )
+
+
+class TestSemanticStore:
+    def test_hit_above_threshold(self):
+        client = FakeRedis([1, b"key", [b"response", b"cached", b"score", b"0.05"]])
+        store = SemanticStore(semantic_config(90), client=client)
+        result = asyncio.run(store.get(semantic_tag(SCOPE, "openai", "gpt-4o", {}), [0.1, 0.2]))
+        assert result == b"cached"
+        assert client.commands[0][0] == "FT.CREATE"
+
+    def test_miss_below_threshold(self):
+        client = FakeRedis([1, b"key", [b"response", b"cached", b"score", b"0.2"]])
+        store = SemanticStore(semantic_config(90), client=client)
+        result = asyncio.run(store.get(semantic_tag(SCOPE, "openai", "gpt-4o", {}), [0.1, 0.2]))
+        assert result is None
+
+    def test_no_result(self):
+        client = FakeRedis([0])
+        store = SemanticStore(semantic_config(90), client=client)
+        result = asyncio.run(store.get(semantic_tag(SCOPE, "openai", "gpt-4o", {}), [0.1, 0.2]))
+        assert result is None
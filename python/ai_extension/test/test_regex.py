@@ -0,0 +1,83 @@
+import unittest
+
+from api.kgateway.policy.ai import prompt_guard
+from guardrails.presidio import init_presidio_config
+from guardrails.regex import Detection, RegexRejection, regex_transform
+from presidio_anonymizer import AnonymizerEngine
+
+
+class TestRegexTransform(unittest.TestCase):
+    def setUp(self):
+        self.anon = AnonymizerEngine()
+
+    def test_per_match_actions(self):
+        regex = prompt_guard.Regex.from_json(
+            {
+                "matches": [
+                    {"name": "EMPLOYEE_ID", "pattern": "EMP-[0-9]{6}"},
+                    {
+                        "name": "PROJECT_CODE",
+                        "pattern": "PRJ-[0-9]{4}",
+                        "action": "LOG",
+                    },
+                ],
+                "action": "MASK",
+            }
+        )
+        detections: list[Detection] = []
+        content = regex_transform(
+            "",
+            "EMP-123456 works on PRJ-1234",
+            init_presidio_config(regex),
+            self.anon,
+            regex.action,
+            detections,
+        )
+
+        assert content == "<CUSTOM> works on PRJ-1234"
+        assert detections == [
+            Detection(name="PROJECT_CODE", action=prompt_guard.Action.LOG),
+            Detection(name="EMPLOYEE_ID", action=prompt_guard.Action.MASK),
+        ]
+
+    def test_per_match_reject(self):
+        regex = prompt_guard.Regex.from_json(
+            {
+                "matches": [
+                    {"name": "SECRET", "pattern": "secret", "action": "REJECT"},
+                ],
+            }
+        )
+        with self.assertRaises(RegexRejection):
+            regex_transform("", "a secret", init_presidio_config(regex), self.anon)
+
+    def test_responses_are_never_rejected(self):
+        regex = prompt_guard.Regex.from_json(
+            {
+                "matches": [
+                    {"name": "SECRET", "pattern": "secret", "action": "REJECT"},
+                ],
+            }
+        )
+        content = regex_transform(
+            "", "a secret", init_presidio_config(regex, allow_reject=False), self.anon
+        )
+        assert content == "a <SECRET>"
+
+    def test_builtin_match(self):
+        regex = prompt_guard.Regex.from_json(
+            {"matches": [{"builtin": "EMAIL", "action": "LOG"}]}
+        )
+        detections: list[Detection] = []
+        content = regex_transform(
+            "",
+            "contact me at test@example.com",
+            init_presidio_config(regex),
+            self.anon,
+            detections=detections,
+        )
+
+        assert content == "contact me at test@example.com"
+        assert detections == [
+            Detection(name="EMAIL", action=prompt_guard.Action.LOG),
+        ]
@@ -315,6 +315,53 @@ def test_handle_response_body_with_end_of_stream(
     ) == json.loads(resp_body_content)
 
 
+def test_token_rate_limit_debits_total_tokens_once():
+    metadict = {"x-llm-provider": "openai", "x-token-rate-limit": "true"}
+    handler = StreamHandler.from_metadata(metadict)
+    handler.req.set_headers(
+        headers=external_processor_pb2.HttpHeaders(), header_rules=[]
+    )
+    span = trace.NonRecordingSpan(trace.SpanContext(0, 0, False))
+
+    # the prompt tokens are not added to the rate limit on the request path
+    response = asyncio.run(
+        extproc_server.handle_request_body(
+            external_processor_pb2.HttpBody(
+                body=b"""{
+  "model": "gpt-4o-mini",
+  "messages": [{"role": "user", "content": "Are you ok?"}]
+}""",
+                end_of_stream=True,
+            ),
+            metadict,
+            handler,
+            span,
+        )
+    )
+    assert "envoy.ratelimit" not in response.dynamic_metadata.fields
+
+    # the total tokens of the response are reported once, for the rate limit applied on stream done
+    response = asyncio.run(
+        extproc_server.handle_response_body(
+            external_processor_pb2.HttpBody(
+                body=b"""{
+  "id": "fake",
+  "object": "chat.completion",
+  "model": "gpt-4o-mini-2024-07-18",
+  "choices": [{"index": 0, "message": {"role": "assistant", "content": "Yes"}, "finish_reason": "stop"}],
+  "usage": {"prompt_tokens": 11, "completion_tokens": 310, "total_tokens": 321}
+}""",
+                end_of_stream=True,
+            ),
+            handler,
+            span,
+        )
+    )
+    ai_metadata = response.dynamic_metadata.fields["ai.kgateway.io"].struct_value
+    assert ai_metadata.fields["total_tokens"].number_value == 321
+    assert ai_metadata.fields["rate_limited_tokens"].number_value == 321
+
+
 @pytest.fixture
 def setup_in_memory_tracer():
     """Setup in-memory span exporter for testing instrumentation"""
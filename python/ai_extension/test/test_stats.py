@@ -1,4 +1,4 @@
-from telemetry.stats import CustomLabel
+from telemetry.stats import Config, CustomLabel
 from google.protobuf import struct_pb2 as struct_pb2
 from google.protobuf.json_format import ParseDict
 
@@ -41,3 +41,97 @@ class TestStats:
         proto_struct = struct_pb2.Struct()
         ParseDict(struct, proto_struct)
         assert custom_label.get_field(proto_struct) == "test"
+
+    def test_pricing(self):
+        config = Config(
+            **{
+                "pricing": [
+                    {
+                        "model": "gpt-4o",
+                        "inputCostPer1K": "0.0025",
+                        "outputCostPer1K": "0.01",
+                    },
+                    {
+                        "provider": "azure_openai",
+                        "model": "gpt-4o",
+                        "inputCostPer1K": "0.005",
+                        "outputCostPer1K": "0.015",
+                    },
+                    {
+                        "provider": "openai",
+                        "model": "gpt-4o-2024-08-06",
+                        "inputCostPer1K": "0.002",
+                        "outputCostPer1K": "0.008",
+                    },
+                ]
+            }
+        )
+        assert config.custom_labels == []
+
+        # the response model is matched before the request model
+        pricing = config.get_pricing("openai", ["gpt-4o-2024-08-06", "gpt-4o"])
+        assert pricing is not None
+        assert pricing.cost(1000, 500) == (0.002, 0.004)
+
+        # the last matching entry is used
+        pricing = config.get_pricing("azure_openai", ["", "gpt-4o"])
+        assert pricing is not None
+        assert pricing.cost(2000, 1000) == (0.01, 0.015)
+
+        # entries without a provider match any provider
+        pricing = config.get_pricing("openai", ["gpt-4o-mini-2024-07-18", "gpt-4o"])
+        assert pricing is not None
+        assert pricing.input_cost_per_1k == 0.0025
+
+        assert config.get_pricing("openai", ["gpt-4o-mini"]) is None
+
+    def test_tenant_jwt_claim(self):
+        config = Config(**{"tenant": {"jwtClaim": "team"}})
+        label = config.tenant.metadata_label()
+        assert label is not None
+        assert label.metadata_namespace == "envoy.filters.http.jwt_authn"
+        proto_struct = struct_pb2.Struct()
+        ParseDict({"principal": {"team": "payments"}}, proto_struct)
+        assert label.get_field(proto_struct) == "payments"
+
+    def test_tenant_metadata(self):
+        config = Config(
+            **{
+                "tenant": {
+                    "metadata": {
+                        "namespace": "envoy.filters.http.ext_authz",
+                        "key": "api_key_name",
+                    }
+                }
+            }
+        )
+        label = config.tenant.metadata_label()
+        assert label is not None
+        assert label.metadata_namespace == "envoy.filters.http.ext_authz"
+        assert label.metadata_key == "api_key_name"
+
+    def test_tenant_header(self):
+        config = Config(
+            **{"tenant": {"header": "x-team", "allowedValues": ["payments", "search"]}}
+        )
+        assert config.tenant.header == "x-team"
+        assert config.tenant.metadata_label() is None
+        assert config.tenant.label_value("payments") == "payments"
+        # values set by clients outside of the allowlist do not create new time series
+        assert config.tenant.label_value("attacker-1234") == "other"
+        assert config.tenant.label_value("") == "unknown"
+
+    def test_tenant_header_without_allowed_values(self):
+        config = Config(**{"tenant": {"header": "x-team"}})
+        assert config.tenant.label_value("payments") == "other"
+
+    def test_tenant_allowed_values(self):
+        config = Config(
+            **{"tenant": {"jwtClaim": "team", "allowedValues": ["payments"]}}
+        )
+        assert config.tenant.label_value("payments") == "payments"
+        assert config.tenant.label_value("search") == "other"
+
+        # trusted sources without an allowlist report the tenant as is
+        config = Config(**{"tenant": {"jwtClaim": "team"}})
+        assert config.tenant.label_value("search") == "search"
@@ -22,14 +22,12 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
-	"istio.io/istio/pkg/config/schema/gvr"
-	kubeclient "istio.io/istio/pkg/kube"
-	"istio.io/istio/pkg/kube/kclient/clienttest"
 	"istio.io/istio/pkg/kube/krt"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -39,12 +37,10 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/registry"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
-	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
-	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/irtranslator"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/listener"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/standalone"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
-	"github.com/kgateway-dev/kgateway/v2/pkg/client/clientset/versioned/fake"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
@@ -582,24 +578,9 @@ func (tc TestCase) Run(
 		}
 	}
 
-	ourCli := fake.NewClientset(ourObjs...)
-	cli := kubeclient.NewFakeClient(anyObjs...)
-	for _, crd := range []schema.GroupVersionResource{
-		gvr.KubernetesGateway_v1,
-		gvr.GatewayClass,
-		gvr.HTTPRoute_v1,
-		gvr.GRPCRoute,
-		gvr.Service,
-		gvr.Pod,
-		gvr.TCPRoute,
-		gvr.TLSRoute,
-		gvr.ServiceEntry,
-		gvr.WorkloadEntry,
-		gvr.AuthorizationPolicy,
-		wellknown.XListenerSetGVR,
-		wellknown.BackendTLSPolicyGVR,
-	} {
-		clienttest.MakeCRD(t, cli, crd)
+	cli, ourCli, err := standalone.NewClients(anyObjs, ourObjs)
+	if err != nil {
+		return nil, err
 	}
 	defer cli.Shutdown()
 
@@ -612,15 +593,8 @@ func (tc TestCase) Run(
 		wellknown.DefaultWaypointClassName,
 		"example-gateway-class",
 	}
-	for _, className := range gwClasses {
-		cli.GatewayAPI().GatewayV1().GatewayClasses().Create(ctx, &gwv1.GatewayClass{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: string(className),
-			},
-			Spec: gwv1.GatewayClassSpec{
-				ControllerName: wellknown.DefaultGatewayControllerName,
-			},
-		}, metav1.CreateOptions{})
+	if err := standalone.CreateGatewayClasses(ctx, cli, wellknown.DefaultGatewayControllerName, gwClasses...); err != nil {
+		return nil, err
 	}
 
 	krtOpts := krtutil.KrtOptions{
@@ -685,23 +659,14 @@ func (tc TestCase) Run(
 		},
 	}
 
-	commoncol.InitPlugins(ctx, extensions, *settings)
-
-	translator := translator.NewCombinedTranslator(ctx, extensions, commoncol, v)
-	translator.Init(ctx)
-
-	cli.RunAndWait(ctx.Done())
-	commoncol.GatewayIndex.Gateways.WaitUntilSynced(ctx.Done())
-
-	kubeclient.WaitForCacheSync("routes", ctx.Done(), commoncol.Routes.HasSynced)
-	kubeclient.WaitForCacheSync("extensions", ctx.Done(), extensions.HasSynced)
-	kubeclient.WaitForCacheSync("commoncol", ctx.Done(), commoncol.HasSynced)
-	kubeclient.WaitForCacheSync("translator", ctx.Done(), translator.HasSynced)
-	kubeclient.WaitForCacheSync("backends", ctx.Done(), commoncol.BackendIndex.HasSynced)
-	kubeclient.WaitForCacheSync("endpoints", ctx.Done(), commoncol.Endpoints.HasSynced)
-	for i, plug := range extraPlugs {
-		kubeclient.WaitForCacheSync(fmt.Sprintf("extra-%d", i), ctx.Done(), plug.HasSynced)
+	var extraSyncs []cache.InformerSynced
+	for _, plug := range extraPlugs {
+		extraSyncs = append(extraSyncs, plug.HasSynced)
 	}
+	ucc := ir.NewUniqlyConnectedClient("test", "test", nil, ir.PodLocality{})
+	// In strict mode, backend validation errors are expected and should not fail the test.
+	// The cluster will be nil or a blackhole cluster, which will be filtered out by perclient.go
+	translation := standalone.TranslateEnvoy(ctx, krtOpts, cli, commoncol, extensions, *settings, v, ucc, extraSyncs...)
 
 	results := make(map[types.NamespacedName]ActualTestResult)
 
@@ -725,56 +690,26 @@ func (tc TestCase) Run(
 		}
 	}
 
-	for _, gw := range commoncol.GatewayIndex.Gateways.List() {
-		xdsSnap, reportsMap := translator.TranslateGateway(krt.TestingDummyContext{}, ctx, gw)
-
+	for _, gwTranslation := range translation.Gateways {
 		// Backend policies (e.g. BackendConfigPolicy) use a different reporting pipeline than gateway policies.
 		// Gateway policies (HTTPListenerPolicy, TrafficPolicy) are reported during gateway translation via the
 		// standard reporter mechanism. Backend policies are processed differently - they don't use the reporter
 		// during translation, instead their reports are generated separately by GenerateBackendPolicyReport().
 		// We need to merge both report types to capture all policy statuses for golden file testing.
-		var backendIRs []*ir.BackendObjectIR
-		for _, col := range commoncol.BackendIndex.BackendsWithPolicyRequiringStatus() {
-			backendIRs = append(backendIRs, col.List()...)
-		}
-		backendPolicyReports := proxy_syncer.GenerateBackendPolicyReport(backendIRs)
-
-		// Merge gateway reports with backend policy reports
-		mergedReports := reportsMap
-		maps.Copy(mergedReports.Policies, backendPolicyReports.Policies)
+		mergedReports := gwTranslation.Reports
+		maps.Copy(mergedReports.Policies, translation.BackendPolicyReports.Policies)
 
 		gwNN := types.NamespacedName{
-			Namespace: gw.Namespace,
-			Name:      gw.Name,
+			Namespace: gwTranslation.Gateway.Namespace,
+			Name:      gwTranslation.Gateway.Name,
 		}
-		actual := ActualTestResult{
-			Proxy:        xdsSnap,
+		results[gwNN] = ActualTestResult{
+			Proxy:        gwTranslation.Result,
 			ReportsMap:   mergedReports,
 			Gateways:     gatewayMap,
 			ListenerSets: listenerSetMap,
+			Clusters:     translation.Clusters,
 		}
-		results[gwNN] = actual
-
-		ctx := context.Background()
-		t := translator.GetBackendTranslator()
-		ucc := ir.NewUniqlyConnectedClient("test", "test", nil, ir.PodLocality{})
-		var clusters []*envoyclusterv3.Cluster
-		for _, col := range commoncol.BackendIndex.BackendsWithPolicy() {
-			for _, backend := range col.List() {
-				cluster, err := t.TranslateBackend(ctx, krt.TestingDummyContext{}, ucc, backend)
-				if err != nil {
-					// In strict mode, backend validation errors are expected and should not fail the test
-					// The cluster will be nil or a blackhole cluster, which will be filtered out by perclient.go
-					// Note: These errors are expected when xDS validation is enabled in strict mode
-				}
-				if cluster != nil {
-					clusters = append(clusters, cluster)
-				}
-			}
-		}
-		r := results[gwNN]
-		r.Clusters = clusters
-		results[gwNN] = r
 	}
 
 	return results, nil